@@ -131,6 +131,9 @@ The first return value is the SuiteConfig which controls aspects of how the suit
 the second return value is the ReporterConfig which controls aspects of how Ginkgo's default
 reporter emits output.
 
+Once RunSpecs has been called the returned ReporterConfig is the configuration actually in effect - i.e. with any
+CIOverrides applied.  The same configuration is recorded in the suite's Report as Report.ReporterConfig.
+
 Mutating the returned configurations has no effect.  To reconfigure Ginkgo programmatically you need
 to pass in your mutated copies into RunSpecs().
 
@@ -140,6 +143,16 @@ func GinkgoConfiguration() (types.SuiteConfig, types.ReporterConfig) {
 	return suiteConfig, reporterConfig
 }
 
+/*
+ValidateConfig validates a suite and reporter configuration - typically ones obtained from GinkgoConfiguration() and then modified.
+
+It returns nil if the configuration is sound and a types.ConfigValidationError enumerating every problem it finds otherwise.  RunSpecs performs the same validation (along with validation of any go test flags) but prints the problems and exits.
+Call ValidateConfig before RunSpecs if you embed Ginkgo and would rather handle configuration problems yourself.
+*/
+func ValidateConfig(suiteConfig types.SuiteConfig, reporterConfig types.ReporterConfig) error {
+	return types.ValidateConfig(suiteConfig, reporterConfig)
+}
+
 /*
 GinkgoRandomSeed returns the seed used to randomize spec execution order.  It is
 useful for seeding your own pseudorandom number generators to ensure
@@ -186,6 +199,25 @@ func GinkgoLabelFilter() string {
 	return suiteConfig.LabelFilter
 }
 
+/*
+ReproducibleFlags returns the ginkgo CLI flags that reproduce the current run - the random seed, the number of parallel processes, and any other setting (filters, --randomize-all, --fail-fast, etc.) that differs from its default.  This is useful when filing bug reports:
+
+	var _ = ReportAfterSuite("reproduce", func(report Report) {
+		if !report.SuiteSucceeded {
+			fmt.Println("reproduce with: ginkgo " + strings.Join(ReproducibleFlags(), " "))
+		}
+	})
+
+Run with --print-reproducible-flags to have Ginkgo's console reporter print the flags when the suite ends.
+
+You can learn more about ReproducibleFlags here: https://onsi.github.io/ginkgo/#reproducing-a-run
+*/
+func ReproducibleFlags() []string {
+	flags, err := types.GenerateReproducibleFlags(GinkgoConfiguration())
+	exitIfErr(err)
+	return flags
+}
+
 /*
 PauseOutputInterception() pauses Ginkgo's output interception.  This is only relevant
 when running in parallel and output to stdout/stderr is being intercepted.  You generally
@@ -215,6 +247,18 @@ func ResumeOutputInterception() {
 	outputInterceptor.ResumeIntercepting()
 }
 
+/*
+OutputIsCaptured returns true if Ginkgo is currently intercepting stdout/stderr output and attaching it to the running spec.
+
+Ginkgo only intercepts output when running in parallel (and not with --output-interceptor-mode=none).  Intercepted output is buffered
+and emitted alongside the spec's report.  Libraries can use OutputIsCaptured to, for example, adjust their output when they know it
+will be buffered.  OutputIsCaptured returns false outside of a running spec, in specs decorated with NoCapture, and while interception
+is paused via PauseOutputInterception().
+*/
+func OutputIsCaptured() bool {
+	return global.Suite.OutputIsCaptured()
+}
+
 /*
 RunSpecs is the entry point for the Ginkgo spec runner.
 
@@ -242,6 +286,10 @@ for setting these and orchestrating parallel specs across the parallel processes
 for more on how specs are parallelized in Ginkgo.
 
 You can also pass suite-level Label() decorators to RunSpecs.  The passed-in labels will apply to all specs in the suite.
+
+To tag the suite's report without affecting label filtering, pass SuiteLabels() to RunSpecs instead.
+
+Finally, you can pass a reporters.ReporterSet to RunSpecs to declaratively configure machine-readable reports and attach custom reporters.
 */
 func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	if suiteDidRun {
@@ -254,10 +302,15 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	}
 	defer global.PopClone()
 
-	suiteLabels := extractSuiteConfiguration(args)
+	suiteLabels, reportedSuiteLabels, reporterSets := extractSuiteConfiguration(args)
 
 	var reporter reporters.Reporter
-	if suiteConfig.ParallelTotal == 1 {
+	if suiteConfig.ParallelTotal == 1 && reporterConfig.JSONReportToStdout() {
+		// stdout is reserved for the JSON report
+		reporter = reporters.NewDefaultReporter(reporterConfig, formatter.ColorableStdErr)
+		outputInterceptor = internal.NoopOutputInterceptor{}
+		client = nil
+	} else if suiteConfig.ParallelTotal == 1 {
 		reporter = reporters.NewDefaultReporter(reporterConfig, formatter.ColorableStdOut)
 		outputInterceptor = internal.NoopOutputInterceptor{}
 		client = nil
@@ -279,7 +332,21 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 		defer client.Close()
 	}
 
+	customReporters := []reporters.Reporter{}
+	for _, reporterSet := range reporterSets {
+		customReporters = append(customReporters, reporterSet.Reporters...)
+	}
+	if crashReporter := reporters.NewCrashReporter(reporterConfig, formatter.ColorableStdErr); suiteConfig.ParallelTotal == 1 && crashReporter.WillGenerateReport() {
+		customReporters = append(customReporters, crashReporter)
+	}
+	if len(customReporters) > 0 {
+		reporter = reporters.NewCompositeReporter(append([]reporters.Reporter{reporter}, customReporters...)...)
+	}
+
 	writer := GinkgoWriter.(*internal.Writer)
+	if reporterConfig.JSONReportToStdout() {
+		writer.SetOutWriter(os.Stderr)
+	}
 	if reporterConfig.Verbosity().GTE(types.VerbosityLevelVerbose) && suiteConfig.ParallelTotal == 1 {
 		writer.SetMode(internal.WriterModeStreamAndBuffer)
 	} else {
@@ -289,15 +356,35 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	if reporterConfig.WillGenerateReport() {
 		registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig)
 	}
+	for _, reporterSet := range reporterSets {
+		if reporterSet.WillGenerateReport() || len(reporterSet.AggregatedReportReporters()) > 0 {
+			if reporterSet.JUnitReportConfig.CodeLocationFormatter == nil {
+				reporterSet.JUnitReportConfig.CodeLocationFormatter = reporterConfig.CodeLocationFormatter
+			}
+			if reporterSet.TeamcityReportConfig.CodeLocationFormatter == nil {
+				reporterSet.TeamcityReportConfig.CodeLocationFormatter = reporterConfig.CodeLocationFormatter
+			}
+			registerReportAfterSuiteNodeForReporterSet(reporterSet, reporterConfig.KeepANSIInCaptured)
+		}
+	}
 
 	err = global.Suite.BuildTree()
 	exitIfErr(err)
+	err = global.Suite.ValidateTree(suiteConfig)
+	exitIfErr(err)
+	if suiteConfig.TimingBaseline != "" && len(suiteConfig.SpecCosts) == 0 {
+		suiteConfig.SpecCosts, err = types.LoadSpecCosts(suiteConfig.TimingBaseline, description)
+		exitIfErr(err)
+	}
 	suitePath, err := os.Getwd()
 	exitIfErr(err)
 	suitePath, err = filepath.Abs(suitePath)
 	exitIfErr(err)
 
-	passed, hasFocusedTests := global.Suite.Run(description, suiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(client), client, internal.RegisterForProgressSignal, suiteConfig)
+	global.Suite.SetReporterConfig(reporterConfig)
+	global.Suite.SetRecordOutputSegments(reporterConfig.InterleaveOutput)
+	global.Suite.SetCaptureEnvironment(reporterConfig.EmitEnvironmentOnFailure, reporterConfig.EnvironmentAllowlist)
+	passed, hasFocusedTests := global.Suite.Run(description, suiteLabels, reportedSuiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(client), client, internal.RegisterForProgressSignal, suiteConfig)
 	outputInterceptor.Shutdown()
 
 	flagSet.ValidateDeprecations(deprecationTracker)
@@ -316,8 +403,27 @@ func RunSpecs(t GinkgoTestingT, description string, args ...interface{}) bool {
 	return passed
 }
 
-func extractSuiteConfiguration(args []interface{}) Labels {
+/*
+SuiteLabels tags the suite as a whole with the passed-in labels when passed to RunSpecs:
+
+	RunSpecs(t, "Books Suite", SuiteLabels("books", "integration"))
+
+Unlike Label() decorators passed to RunSpecs, SuiteLabels do not apply to the specs in the suite and do not participate in label filtering.  They only appear in the suite's Report (as Report.SuiteLabels), in the default reporter's suite header, and in any machine-readable reports.
+SuiteLabels are subject to the same validation rules as spec labels.
+
+You can learn more here: https://onsi.github.io/ginkgo/#spec-labels
+*/
+func SuiteLabels(labels ...string) SuiteLabelSet {
+	return SuiteLabelSet(labels)
+}
+
+// SuiteLabelSet is the type returned by SuiteLabels
+type SuiteLabelSet []string
+
+func extractSuiteConfiguration(args []interface{}) (Labels, Labels, []reporters.ReporterSet) {
 	suiteLabels := Labels{}
+	reportedSuiteLabels := Labels{}
+	reporterSets := []reporters.ReporterSet{}
 	configErrors := []error{}
 	for _, arg := range args {
 		switch arg := arg.(type) {
@@ -327,13 +433,30 @@ func extractSuiteConfiguration(args []interface{}) Labels {
 			reporterConfig = arg
 		case Labels:
 			suiteLabels = append(suiteLabels, arg...)
+		case SuiteLabelSet:
+			for _, label := range arg {
+				label, err := types.ValidateAndCleanupLabel(label, types.NewCodeLocation(2))
+				if err != nil {
+					configErrors = append(configErrors, err)
+					continue
+				}
+				reportedSuiteLabels = append(reportedSuiteLabels, label)
+			}
+		case reporters.ReporterSet:
+			reporterSets = append(reporterSets, arg)
 		default:
 			configErrors = append(configErrors, types.GinkgoErrors.UnknownTypePassedToRunSpecs(arg))
 		}
 	}
 	exitIfErrors(configErrors)
 
+	reporterConfig = reporterConfig.WithCIOverrides(types.DetectCI(os.LookupEnv))
+	if types.DetectGitLabCI(os.LookupEnv) {
+		reporterConfig.GitLabSections = true
+	}
+
 	configErrors = types.VetConfig(flagSet, suiteConfig, reporterConfig)
+	configErrors = append(configErrors, reporters.VetReporterSets(reporterConfig, reporterSets...)...)
 	if len(configErrors) > 0 {
 		fmt.Fprintf(formatter.ColorableStdErr, formatter.F("{{red}}Ginkgo detected configuration issues:{{/}}\n"))
 		for _, err := range configErrors {
@@ -342,7 +465,7 @@ func extractSuiteConfiguration(args []interface{}) Labels {
 		os.Exit(1)
 	}
 
-	return suiteLabels
+	return suiteLabels, reportedSuiteLabels, reporterSets
 }
 
 /*
@@ -356,7 +479,7 @@ func PreviewSpecs(description string, args ...any) Report {
 	}
 	defer global.PopClone()
 
-	suiteLabels := extractSuiteConfiguration(args)
+	suiteLabels, reportedSuiteLabels, _ := extractSuiteConfiguration(args)
 	priorDryRun, priorParallelTotal, priorParallelProcess := suiteConfig.DryRun, suiteConfig.ParallelTotal, suiteConfig.ParallelProcess
 	suiteConfig.DryRun, suiteConfig.ParallelTotal, suiteConfig.ParallelProcess = true, 1, 1
 	defer func() {
@@ -374,7 +497,8 @@ func PreviewSpecs(description string, args ...any) Report {
 	suitePath, err = filepath.Abs(suitePath)
 	exitIfErr(err)
 
-	global.Suite.Run(description, suiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(client), client, internal.RegisterForProgressSignal, suiteConfig)
+	global.Suite.SetReporterConfig(reporterConfig)
+	global.Suite.Run(description, suiteLabels, reportedSuiteLabels, suitePath, global.Failer, reporter, writer, outputInterceptor, interrupt_handler.NewInterruptHandler(client), client, internal.RegisterForProgressSignal, suiteConfig)
 
 	return global.Suite.GetPreviewReport()
 }
@@ -418,6 +542,32 @@ func Fail(message string, callerSkip ...int) {
 	panic(types.GinkgoErrors.UncaughtGinkgoPanic(cl))
 }
 
+/*
+FailSoft notifies Ginkgo that the current spec has failed but, unlike Fail, does not stop the running node.  This allows a single spec to
+check, and report, several problems in one run.
+
+The first failure recorded by a node is reported as the spec's failure.  Any subsequent failures - whether recorded by FailSoft, Fail, or a panic - are
+reported as additional failures on the spec.  The spec fails if FailSoft is called at least once.  Since FailSoft does not panic it is safe to call
+from goroutines launched by your spec, as long as they finish before the node does.
+
+You can call FailSoft in any Setup or Subject node closure.
+
+You can learn more here: https://onsi.github.io/ginkgo/#recording-multiple-failures-with-failsoft
+*/
+func FailSoft(message string, callerSkip ...int) {
+	skip := 0
+	if len(callerSkip) > 0 {
+		skip = callerSkip[0]
+	}
+
+	if !global.Suite.InRunPhase() {
+		// outside of a running node a soft failure has nothing to attach to - so we fail just as Fail would
+		Fail(message, skip+1)
+	}
+	cl := types.NewCodeLocationWithStackTrace(skip + 1)
+	global.Failer.FailSoft(message, cl)
+}
+
 /*
 AbortSuite instructs Ginkgo to fail the current spec and skip all subsequent specs, thereby aborting the suite.
 
@@ -436,6 +586,21 @@ func AbortSuite(message string, callerSkip ...int) {
 	panic(types.GinkgoErrors.UncaughtGinkgoPanic(cl))
 }
 
+/*
+FailSuite marks the suite as failed without failing the current node.  reason is appended to the suite's Report.SpecialSuiteFailureReasons and is included in the end-of-suite summary.
+
+FailSuite is intended to be called from AfterSuite and ReportAfterSuite nodes - for example, to fail the suite when a custom report reveals a problem.  Unlike Fail, FailSuite does not panic: the current node runs to completion.  Subsequent ReportAfterSuite nodes, and any reports generated by Ginkgo, will see the failure.
+
+You can learn more about FailSuite here: https://onsi.github.io/ginkgo/#failing-the-suite-with-failsuite
+*/
+func FailSuite(reason string) {
+	cl := types.NewCodeLocation(1)
+	err := global.Suite.FailSuite(reason, cl)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to mark the suite as failed:\n%s", err.Error()), 1)
+	}
+}
+
 /*
 ignorablePanic is used by Gomega to signal to GinkgoRecover that Goemga is handling
 the error associated with this panic.  It i used when Eventually/Consistently are passed a func(g Gomega) and the resulting function launches a goroutines that makes a failed assertion.  That failed assertion is registered by Gomega and then panics.  Ordinarily the panic is captured by Gomega.  In the case of a goroutine Gomega can't capture the panic - so we piggy back on GinkgoRecover so users have a single defer GinkgoRecover() pattern to follow.  To do that we need to tell Ginkgo to ignore this panic and not register it as a panic on the global Failer.
@@ -573,6 +738,55 @@ Specify is an alias for It - it can allow for more natural wording in some conte
 */
 var Specify, FSpecify, PSpecify, XSpecify = It, FIt, PIt, XIt
 
+/*
+SkipRegistration records a placeholder for specs that a container deliberately does not register - for example, specs that are only registered after a runtime check:
+
+	Describe("file permissions", func() {
+		if runtime.GOOS == "windows" {
+			SkipRegistration("POSIX file permissions are not supported on windows")
+			return
+		}
+		It("...", func() { ... })
+	})
+
+The placeholder is a spec, with the reason as its text, that is always reported as skipped with the message "Spec not registered: <reason>".  This documents the gap in the suite's reports instead of silently omitting the specs.
+
+SkipRegistration must be called while the spec tree is being constructed (i.e. at the top level or in a container) - not in a running spec.  To skip a spec while it runs use Skip.
+
+You can learn more about SkipRegistration here: https://onsi.github.io/ginkgo/#documenting-specs-that-are-not-registered
+*/
+func SkipRegistration(reason string) bool {
+	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, reason, func() {}, internal.RegistrationSkipReason(reason)))
+}
+
+/*
+GinkgoBenchmark is passed to the body of a Benchmark spec.  Use Iteration() to learn which iteration is running and StopTimer()/StartTimer() to exclude per-iteration setup from the recorded timings.
+*/
+type GinkgoBenchmark = internal.GinkgoBenchmark
+
+/*
+Benchmark nodes are Subject nodes that time repeated invocations of body.
+
+The body is invoked once per iteration and each invocation is timed.  By default the number of iterations is auto-scaled: Ginkgo keeps invoking body until one second has elapsed (configurable with the BenchmarkDuration decorator).  Use the BenchmarkIterations decorator to run a fixed number of iterations instead.
+
+Ginkgo computes the mean, standard deviation, and 95th percentile of the collected samples and records them on the SpecReport's BenchmarkStats.  If the BenchmarkMaxMean decorator is provided, the spec fails when the mean exceeds the threshold.
+
+Benchmark nodes are otherwise just like It nodes and accept the same decorators (e.g. Label, Serial, Focus).
+
+You can learn more at https://onsi.github.io/ginkgo/#benchmarking-specs
+*/
+func Benchmark(text string, body func(*GinkgoBenchmark), args ...interface{}) bool {
+	config, args := internal.ExtractBenchmarkConfig(args)
+	wrappedBody := func() {
+		stats := internal.RunBenchmark(body, config)
+		global.Suite.RecordBenchmarkStats(stats)
+		if config.MaxMean > 0 && stats.Mean > config.MaxMean {
+			global.Failer.Fail(fmt.Sprintf("Benchmark mean of %s exceeded the maximum allowed mean of %s\n%s", stats.Mean, config.MaxMean, stats), global.Suite.CurrentSpecReport().LeafNodeLocation)
+		}
+	}
+	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeIt, text, append([]interface{}{wrappedBody}, args...)...))
+}
+
 /*
 By allows you to better document complex Specs.
 
@@ -627,6 +841,24 @@ func AfterSuite(body interface{}, args ...interface{}) bool {
 	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterSuite, "", combinedArgs...))
 }
 
+/*
+AfterAllProcesses nodes are suite-level Setup nodes that run exactly once - on parallel process #1 - and only after every parallel process has finished running its specs.
+This makes them a good place to merge artifacts produced by each parallel process.  When running in series AfterAllProcesses runs once, after the AfterSuite node.
+
+Unlike AfterSuite (which runs on every process) you may register multiple AfterAllProcesses nodes.  They run in the order they are declared.
+AfterAllProcesses node closures always run, even if Ginkgo receives an interrupt signal (^C), in order to ensure cleanup occurs.
+
+AfterAllProcesses can take a func() body, or an interruptible func(SpecContext)/func(context.Context) body.
+
+You cannot nest any other Ginkgo nodes within an AfterAllProcesses node's closure.
+You can learn more here: https://onsi.github.io/ginkgo/#cleaning-up-after-all-processes-afterallprocesses
+*/
+func AfterAllProcesses(body interface{}, args ...interface{}) bool {
+	combinedArgs := []interface{}{body}
+	combinedArgs = append(combinedArgs, args...)
+	return pushNode(internal.NewNode(deprecationTracker, types.NodeTypeAfterAllProcesses, "", combinedArgs...))
+}
+
 /*
 SynchronizedBeforeSuite nodes allow you to perform some of the suite setup just once - on parallel process #1 - and then pass information
 from that setup to the rest of the suite setup on all processes.  This is useful for performing expensive or singleton setup once, then passing
@@ -816,6 +1048,61 @@ func DeferCleanup(args ...interface{}) {
 	pushNode(internal.NewCleanupNode(deprecationTracker, fail, args...))
 }
 
+/*
+RegisterSpecHooks registers a pair of functions that Ginkgo calls immediately before and after every spec.  This is useful for framework-level instrumentation (e.g. integrating with an APM tool).
+
+before is called with the spec's initial report just before the spec (including any ReportBeforeEach nodes) runs.  after is called with the spec's completed report just after the spec (including any ReportAfterEach nodes) has run.  Either function may be nil.
+Hooks are called for every spec, including specs that are skipped or pending - check the report's State if you only care about specs that actually ran.
+
+Unlike setup nodes, hooks are not part of the spec: they cannot fail the spec and should not block.  When running in parallel hooks are called on the process that runs the spec.
+Call RegisterSpecHooks before RunSpecs.
+
+You can learn more about RegisterSpecHooks here: https://onsi.github.io/ginkgo/#instrumenting-specs-with-spec-hooks
+*/
+func RegisterSpecHooks(before func(SpecReport), after func(SpecReport)) {
+	global.Suite.RegisterSpecHooks(before, after)
+}
+
+/*
+RegisterFaultInjector registers a pair of functions that Ginkgo runs around every spec whose labels match labelFilter.  This is useful for resilience testing - e.g. injecting latency or errors around specs labelled "chaos".
+
+labelFilter uses the same syntax as --label-filter and is matched against the spec's labels (including any suite-level labels).  before runs ahead of the spec's setup nodes and after runs once the spec's teardown nodes
+(including any DeferCleanup callbacks) have run.  Both are passed a SpecContext and either may be nil.
+
+Unlike spec hooks, fault injectors run as part of the spec: they behave like top-level BeforeEach and AfterEach nodes and so can fail, skip, or time out the spec.  If before fails the spec does not run, though after is still called.
+When several fault injectors match a spec their before functions run in registration order and their after functions run in reverse registration order.  Call RegisterFaultInjector before RunSpecs.
+
+You can learn more about RegisterFaultInjector here: https://onsi.github.io/ginkgo/#injecting-faults-around-specs
+*/
+func RegisterFaultInjector(labelFilter string, before func(SpecContext), after func(SpecContext)) {
+	exitIfErr(global.Suite.RegisterFaultInjector(labelFilter, before, after, types.NewCodeLocation(1)))
+}
+
+/*
+OnSpecRetry registers a callback that Ginkgo invokes each time a spec is about to be retried because of FlakeAttempts (either the decorator or the --flake-attempts flag).  This allows you to surface flakiness as it happens - for example, by notifying a dashboard - rather than waiting for the end of the suite.
+
+The callback receives the spec's report as it stands after the failed attempt, the number of the attempt that is about to run (2 for the first retry, 3 for the second, and so on), and the failure recorded by the attempt that just failed.
+When running in parallel the callback is invoked on the process that runs the spec.
+Call OnSpecRetry before RunSpecs.
+
+You can learn more about OnSpecRetry here: https://onsi.github.io/ginkgo/#being-notified-when-a-spec-is-retried
+*/
+func OnSpecRetry(callback func(report SpecReport, attempt int, failure types.Failure)) {
+	global.Suite.OnSpecRetry(callback)
+}
+
+/*
+SetFeatureChecker registers the function Ginkgo uses to decide whether specs decorated with RequireFeature can run.  The checker is passed the name of a feature and should return true if the feature is on.
+
+Ginkgo consults the checker before each decorated spec runs - once for each feature the spec requires - and skips the spec if any of its features is off.  If no checker has been registered every feature is considered off.
+When running in parallel the checker is called on the process that runs the spec and so must be registered on every process.  Call SetFeatureChecker before RunSpecs.  Calling it again replaces the previous checker.
+
+You can learn more about SetFeatureChecker here: https://onsi.github.io/ginkgo/#specs-that-require-features
+*/
+func SetFeatureChecker(checker func(feature string) bool) {
+	global.Suite.SetFeatureChecker(checker)
+}
+
 /*
 AttachProgressReporter allows you to register a function that will be called whenever Ginkgo generates a Progress Report.  The contents returned by the function will be included in the report.
 
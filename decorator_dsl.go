@@ -28,6 +28,43 @@ You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorat
 */
 type MustPassRepeatedly = internal.MustPassRepeatedly
 
+/*
+StabilityCheck(func(prev, curr types.SpecReport) error) is a decorator that tightens what it means for individual specs, or the specs in a container, decorated with MustPassRepeatedly to pass.
+Each time an attempt passes Ginkgo calls the StabilityCheck with the reports of the preceding attempt and of the current attempt.  The captured output in each report only includes the output emitted during that attempt.
+If the StabilityCheck returns an error the spec fails as unstable - even though both attempts passed.  StabilityCheck has no effect on specs that are not run repeatedly via MustPassRepeatedly or --must-pass-repeatedly.
+
+You can learn more here: https://onsi.github.io/ginkgo/#the-stabilitycheck-decorator
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type StabilityCheck = internal.StabilityCheck
+
+/*
+Repeat(uint N) is a decorator that runs individual specs or spec containers exactly `N` times, regardless of whether they pass or fail.  The spec fails if any iteration fails and the outcome of each iteration is recorded in SpecReport.RepeatStates.
+Use Repeat to stress-test specs for non-determinism.  It cannot be combined with FlakeAttempts or MustPassRepeatedly.
+
+You can learn more here: https://onsi.github.io/ginkgo/#the-repeat-decorator
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type Repeat = internal.Repeat
+
+/*
+ExpectedDuration(time.Duration) is a decorator that records how long individual specs, or the specs in a container, are expected to take.  The expectation is stored in SpecReport.ExpectedDuration.
+Ginkgo's default reporter flags specs whose RunTime deviates from the expectation by more than a factor of types.ExpectedDurationTolerance.  These specs only fail if you run with --fail-on-duration-deviation.
+
+You can learn more here: https://onsi.github.io/ginkgo/#the-expectedduration-decorator
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type ExpectedDuration = internal.ExpectedDuration
+
+/*
+CaptureStackIfSlowerThan(time.Duration) is a decorator that captures the spec's goroutine if individual specs, or the specs in a container, are still running after the passed-in duration.
+The stack is captured at the moment the duration elapses and is stored in SpecReport.SlowSpecStack when the spec completes.  Ginkgo's default reporter renders it when running with -v or -vv.
+
+You can learn more here: https://onsi.github.io/ginkgo/#capturing-the-stacks-of-slow-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+type CaptureStackIfSlowerThan = internal.CaptureStackIfSlowerThan
+
 /*
 Focus is a decorator that allows you to mark a spec or container as focused.  Identical to FIt and FDescribe.
 
@@ -99,6 +136,72 @@ You can learn more here: https://onsi.github.io/ginkgo/#spec-labels
 */
 type Labels = internal.Labels
 
+/*
+OnlyOn decorates specs and containers that should only run on particular platforms.  Each platform is either a GOOS ("linux") or a GOOS/GOARCH pair ("linux/arm64").
+When the current runtime.GOOS and runtime.GOARCH match none of the platforms Ginkgo skips the spec before any of its nodes run and records the reason in the spec's report.
+
+You can learn more here: https://onsi.github.io/ginkgo/#platform-specific-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+func OnlyOn(platforms ...string) OnlyOnPlatforms {
+	return OnlyOnPlatforms(platforms)
+}
+
+/*
+OnlyOnPlatforms are the type for OnlyOn decorators.  Use OnlyOn(...) to construct OnlyOnPlatforms.
+*/
+type OnlyOnPlatforms = internal.OnlyOnPlatforms
+
+/*
+SkipOn decorates specs and containers that should not run on particular platforms.  Each platform is either a GOOS ("windows") or a GOOS/GOARCH pair ("windows/arm64").
+When the current runtime.GOOS and runtime.GOARCH match any of the platforms Ginkgo skips the spec before any of its nodes run and records the reason in the spec's report.
+
+You can learn more here: https://onsi.github.io/ginkgo/#platform-specific-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+func SkipOn(platforms ...string) SkipOnPlatforms {
+	return SkipOnPlatforms(platforms)
+}
+
+/*
+SkipOnPlatforms are the type for SkipOn decorators.  Use SkipOn(...) to construct SkipOnPlatforms.
+*/
+type SkipOnPlatforms = internal.SkipOnPlatforms
+
+/*
+RequireFeature decorates specs and containers that should only run when each of the passed-in features is on.
+Before a decorated spec runs Ginkgo asks the function registered with SetFeatureChecker whether each feature is on.  If any is off Ginkgo skips the spec before any of its nodes run and records the reason in the spec's report.
+
+You can learn more here: https://onsi.github.io/ginkgo/#specs-that-require-features
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+func RequireFeature(features ...string) RequiredFeatures {
+	return RequiredFeatures(features)
+}
+
+/*
+RequiredFeatures are the type for RequireFeature decorators.  Use RequireFeature(...) to construct RequiredFeatures.
+*/
+type RequiredFeatures = internal.RequiredFeatures
+
+/*
+DependsOn decorates specs that should only run after, and only if, other specs in the same container have run and passed.  Each dependency is the text of a spec (i.e. the text passed to It) that shares the decorated spec's immediate container.
+
+Ginkgo runs a spec's dependencies before the spec, on the same process.  If any of them does not pass the spec is skipped and the reason is recorded in the spec's report.  Dependencies that form a cycle, or that don't match any spec, are reported as errors when the spec tree is built.
+DependsOn can only decorate subject nodes and cannot be used in Ordered containers.
+
+You can learn more here: https://onsi.github.io/ginkgo/#declaring-spec-dependencies
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+func DependsOn(specs ...string) SpecDependencies {
+	return SpecDependencies(specs)
+}
+
+/*
+SpecDependencies are the type for DependsOn decorators.  Use DependsOn(...) to construct SpecDependencies.
+*/
+type SpecDependencies = internal.SpecDependencies
+
 /*
 PollProgressAfter allows you to override the configured value for --poll-progress-after for a particular node.
 
@@ -136,8 +239,107 @@ Nodes that do not finish within a GracePeriod will be leaked and Ginkgo will pro
 */
 type GracePeriod = internal.GracePeriod
 
+/*
+WarnAt asks Ginkgo to emit an early-warning Progress Report once a node has used up the specified fraction of its time budget.  WarnAt(0.8), for example, emits a warning when the node has consumed 80% of the time remaining before its NodeTimeout, SpecTimeout, or the suite timeout (whichever is most stringent).  The fraction must be between 0 and 1 and WarnAt cannot decorate container nodes.
+
+The warning is purely informational - the node continues to run and only fails if it actually times out.
+You can learn more here: https://onsi.github.io/ginkgo/#warning-before-a-timeout-with-warnat
+*/
+type WarnAt = internal.WarnAt
+
+/*
+HookName names a BeforeEach or JustBeforeEach node so that other setup nodes can refer to it with OrderBefore or OrderAfter.
+*/
+type HookName = internal.HookName
+
+/*
+OrderBefore adjusts the order of a BeforeEach or JustBeforeEach node within the chain of setup nodes that runs for each spec.  The decorated node runs immediately before the node of the same type decorated with the matching HookName - even if that node is defined in an enclosing container.
+
+Setup nodes normally run outer-to-inner and most specs are written with that assumption in mind.  Reordering them can easily break those expectations so use OrderBefore sparingly.
+You can learn more here: https://onsi.github.io/ginkgo/#adjusting-the-order-of-setup-nodes
+*/
+type OrderBefore = internal.OrderBefore
+
+/*
+OrderAfter is the counterpart to OrderBefore.  The decorated BeforeEach or JustBeforeEach node runs immediately after the node of the same type decorated with the matching HookName.
+You can learn more here: https://onsi.github.io/ginkgo/#adjusting-the-order-of-setup-nodes
+*/
+type OrderAfter = internal.OrderAfter
+
+/*
+ReportPriority controls the order in which ReportAfterSuite nodes run.  ReportAfterSuite nodes run in descending order of priority and, within a priority, in declaration order (sorted by file name and line number).  The default priority is 0 - so ReportPriority(1) runs a node before any undecorated ReportAfterSuite nodes and ReportPriority(-1) runs it after them.
+
+ReportPriority can only decorate ReportAfterSuite nodes.
+You can learn more here: https://onsi.github.io/ginkgo/#ordering-reportaftersuite-nodes
+*/
+type ReportPriority = internal.ReportPriority
+
 /*
 SuppressProgressReporting is a decorator that allows you to disable progress reporting of a particular node.  This is useful if `ginkgo -v -progress` is generating too much noise; particularly
 if you have a `ReportAfterEach` node that is running for every skipped spec and is generating lots of progress reports.
 */
 const SuppressProgressReporting = internal.SuppressProgressReporting
+
+/*
+NoCapture is a decorator that disables output interception for the decorated spec (or, when applied to a container, for all specs in the container).
+
+When running in parallel Ginkgo intercepts stdout and stderr and only emits the captured output if the spec fails.  Output written by a NoCapture spec is, instead, written straight to the underlying stdout/stderr
+and the spec's SpecReport.CapturedStdOutErr will be empty.  GinkgoWriter output is unaffected.  This is useful when debugging a particular spec.
+
+You can learn more here: https://onsi.github.io/ginkgo/#disabling-output-capture-for-a-spec
+*/
+const NoCapture = internal.NoCapture
+
+/*
+RequiresNetwork is a decorator for specs (or, when applied to a container, all specs in the container) that need network access.
+
+Before the first such spec runs Ginkgo checks for connectivity by dialing --network-probe-address (proxy.golang.org:443 by default).  The result is reused for the rest of the suite.
+If the probe fails Ginkgo skips every spec decorated with RequiresNetwork before any of its nodes run and records the reason in the spec's report.  Pass --assume-online to disable the probe.
+
+You can learn more here: https://onsi.github.io/ginkgo/#specs-that-require-the-network
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+const RequiresNetwork = internal.RequiresNetwork
+
+/*
+Informational is a decorator for specs (or, when applied to a container, all specs in the container) that gather diagnostics or record observations and should never affect whether the suite passes.
+
+Informational specs run like any other spec and their outcome - including any failure or panic - is recorded in the spec's report (see SpecReport.IsInformational).  However a failing informational spec
+does not fail the suite, does not trigger --fail-fast, and is listed in a separate "Informational" section of the end-of-suite summary instead of alongside the suite's failures.  The exception is AbortSuite: an informational spec that aborts the suite still fails it.
+
+You can learn more here: https://onsi.github.io/ginkgo/#informational-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+const Informational = internal.Informational
+
+/*
+VerboseOutput is a decorator for specs (or, when applied to a container, all specs in the container) whose output you always want to see.
+
+When a VerboseOutput spec completes the DefaultReporter renders it as though -v had been set - including its timeline and any GinkgoWriter output - regardless of the verbosity the suite is running with.  Other specs continue to be rendered at the configured verbosity so, for example, a suite running with --succinct
+emits a single denoter for each passing spec and the full report for each spec decorated with VerboseOutput.  VerboseOutput specs are recorded in the spec's report (see SpecReport.IsVerbose).
+
+You can learn more here: https://onsi.github.io/ginkgo/#verbose-specs
+You can learn more about decorators here: https://onsi.github.io/ginkgo/#decorator-reference
+*/
+const VerboseOutput = internal.VerboseOutput
+
+/*
+BenchmarkIterations(uint N) is a decorator for Benchmark nodes that instructs Ginkgo to run the benchmark body exactly N times instead of auto-scaling the number of iterations.
+
+You can learn more here: https://onsi.github.io/ginkgo/#benchmarking-specs
+*/
+type BenchmarkIterations = internal.BenchmarkIterations
+
+/*
+BenchmarkDuration is a decorator for Benchmark nodes that controls how long Ginkgo keeps running an auto-scaled benchmark body.  It defaults to one second.
+
+You can learn more here: https://onsi.github.io/ginkgo/#benchmarking-specs
+*/
+type BenchmarkDuration = internal.BenchmarkDuration
+
+/*
+BenchmarkMaxMean is a decorator for Benchmark nodes that sets a regression threshold.  The Benchmark fails if the mean time per iteration exceeds the threshold.
+
+You can learn more here: https://onsi.github.io/ginkgo/#benchmarking-specs
+*/
+type BenchmarkMaxMean = internal.BenchmarkMaxMean
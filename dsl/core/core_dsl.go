@@ -23,21 +23,29 @@ type GinkgoTestingT = ginkgo.GinkgoTestingT
 type GinkgoTInterface = ginkgo.GinkgoTInterface
 type FullGinkgoTInterface = ginkgo.FullGinkgoTInterface
 type SpecContext = ginkgo.SpecContext
+type GinkgoBenchmark = ginkgo.GinkgoBenchmark
+type SuiteLabelSet = ginkgo.SuiteLabelSet
 
 var GinkgoWriter = ginkgo.GinkgoWriter
 var GinkgoLogr = ginkgo.GinkgoLogr
 var GinkgoConfiguration = ginkgo.GinkgoConfiguration
+var ValidateConfig = ginkgo.ValidateConfig
 var GinkgoRandomSeed = ginkgo.GinkgoRandomSeed
 var GinkgoParallelProcess = ginkgo.GinkgoParallelProcess
 var GinkgoHelper = ginkgo.GinkgoHelper
 var GinkgoLabelFilter = ginkgo.GinkgoLabelFilter
+var ReproducibleFlags = ginkgo.ReproducibleFlags
 var PauseOutputInterception = ginkgo.PauseOutputInterception
 var ResumeOutputInterception = ginkgo.ResumeOutputInterception
+var OutputIsCaptured = ginkgo.OutputIsCaptured
 var RunSpecs = ginkgo.RunSpecs
 var PreviewSpecs = ginkgo.PreviewSpecs
+var SuiteLabels = ginkgo.SuiteLabels
 var Skip = ginkgo.Skip
 var Fail = ginkgo.Fail
+var FailSoft = ginkgo.FailSoft
 var AbortSuite = ginkgo.AbortSuite
+var FailSuite = ginkgo.FailSuite
 var GinkgoRecover = ginkgo.GinkgoRecover
 var Describe = ginkgo.Describe
 var FDescribe = ginkgo.FDescribe
@@ -50,11 +58,14 @@ var FIt = ginkgo.FIt
 var PIt = ginkgo.PIt
 var XIt = PIt
 var Specify, FSpecify, PSpecify, XSpecify = It, FIt, PIt, XIt
+var SkipRegistration = ginkgo.SkipRegistration
+var Benchmark = ginkgo.Benchmark
 var By = ginkgo.By
 var BeforeSuite = ginkgo.BeforeSuite
 var AfterSuite = ginkgo.AfterSuite
 var SynchronizedBeforeSuite = ginkgo.SynchronizedBeforeSuite
 var SynchronizedAfterSuite = ginkgo.SynchronizedAfterSuite
+var AfterAllProcesses = ginkgo.AfterAllProcesses
 var BeforeEach = ginkgo.BeforeEach
 var JustBeforeEach = ginkgo.JustBeforeEach
 var AfterEach = ginkgo.AfterEach
@@ -64,3 +75,7 @@ var AfterAll = ginkgo.AfterAll
 var DeferCleanup = ginkgo.DeferCleanup
 var GinkgoT = ginkgo.GinkgoT
 var AttachProgressReporter = ginkgo.AttachProgressReporter
+var RegisterSpecHooks = ginkgo.RegisterSpecHooks
+var RegisterFaultInjector = ginkgo.RegisterFaultInjector
+var OnSpecRetry = ginkgo.OnSpecRetry
+var SetFeatureChecker = ginkgo.SetFeatureChecker
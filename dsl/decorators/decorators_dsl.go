@@ -19,12 +19,28 @@ import (
 type Offset = ginkgo.Offset
 type FlakeAttempts = ginkgo.FlakeAttempts
 type MustPassRepeatedly = ginkgo.MustPassRepeatedly
+type StabilityCheck = ginkgo.StabilityCheck
+type Repeat = ginkgo.Repeat
+type ExpectedDuration = ginkgo.ExpectedDuration
+type CaptureStackIfSlowerThan = ginkgo.CaptureStackIfSlowerThan
 type Labels = ginkgo.Labels
+type OnlyOnPlatforms = ginkgo.OnlyOnPlatforms
+type SkipOnPlatforms = ginkgo.SkipOnPlatforms
+type RequiredFeatures = ginkgo.RequiredFeatures
+type SpecDependencies = ginkgo.SpecDependencies
 type PollProgressAfter = ginkgo.PollProgressAfter
 type PollProgressInterval = ginkgo.PollProgressInterval
 type NodeTimeout = ginkgo.NodeTimeout
 type SpecTimeout = ginkgo.SpecTimeout
 type GracePeriod = ginkgo.GracePeriod
+type WarnAt = ginkgo.WarnAt
+type HookName = ginkgo.HookName
+type OrderBefore = ginkgo.OrderBefore
+type OrderAfter = ginkgo.OrderAfter
+type ReportPriority = ginkgo.ReportPriority
+type BenchmarkIterations = ginkgo.BenchmarkIterations
+type BenchmarkDuration = ginkgo.BenchmarkDuration
+type BenchmarkMaxMean = ginkgo.BenchmarkMaxMean
 
 const Focus = ginkgo.Focus
 const Pending = ginkgo.Pending
@@ -33,5 +49,13 @@ const Ordered = ginkgo.Ordered
 const ContinueOnFailure = ginkgo.ContinueOnFailure
 const OncePerOrdered = ginkgo.OncePerOrdered
 const SuppressProgressReporting = ginkgo.SuppressProgressReporting
+const NoCapture = ginkgo.NoCapture
+const RequiresNetwork = ginkgo.RequiresNetwork
+const Informational = ginkgo.Informational
+const VerboseOutput = ginkgo.VerboseOutput
 
 var Label = ginkgo.Label
+var OnlyOn = ginkgo.OnlyOn
+var SkipOn = ginkgo.SkipOn
+var RequireFeature = ginkgo.RequireFeature
+var DependsOn = ginkgo.DependsOn
@@ -18,12 +18,25 @@ import (
 
 type Report = ginkgo.Report
 type SpecReport = ginkgo.SpecReport
+type ReportEntry = ginkgo.ReportEntry
 type ReportEntryVisibility = ginkgo.ReportEntryVisibility
 
 const ReportEntryVisibilityAlways, ReportEntryVisibilityFailureOrVerbose, ReportEntryVisibilityNever = ginkgo.ReportEntryVisibilityAlways, ginkgo.ReportEntryVisibilityFailureOrVerbose, ginkgo.ReportEntryVisibilityNever
 
+type ReportEntrySeverity = ginkgo.ReportEntrySeverity
+
+const ReportEntrySeverityInfo, ReportEntrySeverityWarning, ReportEntrySeverityError = ginkgo.ReportEntrySeverityInfo, ginkgo.ReportEntrySeverityWarning, ginkgo.ReportEntrySeverityError
+
+type ReportEntryTarget = ginkgo.ReportEntryTarget
+
+const ReportEntryTargetNone, ReportEntryTargetJUnitProperty = ginkgo.ReportEntryTargetNone, ginkgo.ReportEntryTargetJUnitProperty
+
 var CurrentSpecReport = ginkgo.CurrentSpecReport
 var AddReportEntry = ginkgo.AddReportEntry
+var NestedReportEntry = ginkgo.NestedReportEntry
+var CollectValue = ginkgo.CollectValue
+var ExpectUnique = ginkgo.ExpectUnique
+var MatchGolden = ginkgo.MatchGolden
 
 var ReportBeforeEach = ginkgo.ReportBeforeEach
 var ReportAfterEach = ginkgo.ReportAfterEach
@@ -29,3 +29,4 @@ var Entry = ginkgo.Entry
 var FEntry = ginkgo.FEntry
 var PEntry = ginkgo.PEntry
 var XEntry = ginkgo.XEntry
+var TableFromFile = ginkgo.TableFromFile
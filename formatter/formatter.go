@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // ColorableStdOut and ColorableStdErr enable color output support on Windows
@@ -50,6 +51,22 @@ func NewWithNoColorBool(noColor bool) Formatter {
 	return New(ColorModeTerminal)
 }
 
+/*
+NewWithColorBools returns a Formatter for Ginkgo's --no-color and --force-color settings.  noColor takes precedence over forceColor.
+
+forceColor renders color tokens as ANSI escape codes even when the output is not a terminal (e.g. when it is piped to a CI system's log viewer).  Ginkgo does not currently
+inspect whether its output is a terminal so this is also what happens when neither is set - forceColor makes the choice explicit so it does not depend on that default.
+*/
+func NewWithColorBools(noColor bool, forceColor bool) Formatter {
+	if noColor {
+		return New(ColorModeNone)
+	}
+	if forceColor {
+		return New(ColorModeTerminal)
+	}
+	return NewWithNoColorBool(false)
+}
+
 func New(colorMode ColorMode) Formatter {
 	colorAliases := map[string]int{
 		"black":   0,
@@ -195,6 +212,40 @@ func (f Formatter) length(styled string) uint {
 	return n
 }
 
+/*
+Truncate shortens s to at most maxLength visible characters followed by an ellipsis.  s may contain {{color}} tokens: these do not count towards maxLength and are never cut.
+Tokens that appear after the point of truncation are preserved so that any styling s opens is still closed.  Truncate returns s unmodified if maxLength is not positive.
+*/
+func (f Formatter) Truncate(s string, maxLength int) string {
+	if maxLength <= 0 {
+		return s
+	}
+	tokens := f.styleRe.FindAllStringIndex(s, -1)
+	out := &strings.Builder{}
+	visible, truncated := 0, false
+	for i := 0; i < len(s); {
+		if len(tokens) > 0 && tokens[0][0] == i {
+			out.WriteString(s[tokens[0][0]:tokens[0][1]])
+			i = tokens[0][1]
+			tokens = tokens[1:]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if truncated {
+			continue
+		}
+		if visible == maxLength {
+			out.WriteString("...")
+			truncated = true
+			continue
+		}
+		out.WriteRune(r)
+		visible += 1
+	}
+	return out.String()
+}
+
 func (f Formatter) CycleJoin(elements []string, joiner string, cycle []string) string {
 	if len(elements) == 0 {
 		return ""
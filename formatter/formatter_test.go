@@ -119,6 +119,23 @@ var _ = Describe("Formatter", func() {
 		})
 	})
 
+	Describe("NewWithColorBools", func() {
+		It("renders the color information using terminal escape codes when forcing color", func() {
+			f = formatter.NewWithColorBools(false, true)
+			Ω(f.F("{{green}}{{bold}}hi there{{/}}")).Should(Equal("\x1b[38;5;10m\x1b[1mhi there\x1b[0m"))
+		})
+
+		It("lets noColor take precedence over forceColor", func() {
+			f = formatter.NewWithColorBools(true, true)
+			Ω(f.F("{{green}}{{bold}}hi there{{/}}")).Should(Equal("hi there"))
+		})
+
+		It("behaves like NewWithNoColorBool when not forcing color", func() {
+			Ω(formatter.NewWithColorBools(true, false).F("{{green}}hi there{{/}}")).Should(Equal(formatter.NewWithNoColorBool(true).F("{{green}}hi there{{/}}")))
+			Ω(formatter.NewWithColorBools(false, false).F("{{green}}hi there{{/}}")).Should(Equal(formatter.NewWithNoColorBool(false).F("{{green}}hi there{{/}}")))
+		})
+	})
+
 	Describe("F", func() {
 		It("transforms the color information and sprintfs", func() {
 			Ω(f.F("{{green}}hi there {{cyan}}%d {{yellow}}%s{{/}}", 3, "wise men")).Should(Equal("\x1b[38;5;10mhi there \x1b[38;5;14m3 \x1b[38;5;11mwise men\x1b[0m"))
@@ -184,4 +201,47 @@ var _ = Describe("Formatter", func() {
 			))
 		})
 	})
+
+	Describe("Truncate", func() {
+		It("leaves short strings, and strings when maxLength is not positive, alone", func() {
+			Ω(f.Truncate("hello", 5)).Should(Equal("hello"))
+			Ω(f.Truncate("hello", 0)).Should(Equal("hello"))
+			Ω(f.Truncate("hello", -1)).Should(Equal("hello"))
+		})
+
+		It("truncates long strings with an ellipsis", func() {
+			Ω(f.Truncate("hello world", 5)).Should(Equal("hello..."))
+			Ω(f.Truncate("héllo wörld", 7)).Should(Equal("héllo w..."))
+		})
+
+		It("does not count color tokens towards the length and never cuts inside them", func() {
+			Ω(f.Truncate("{{red}}hello{{/}} world", 5)).Should(Equal("{{red}}hello{{/}}..."))
+			Ω(f.Truncate("he{{bold}}llo world{{/}}", 4)).Should(Equal("he{{bold}}ll...{{/}}"))
+			Ω(f.Truncate("abc{{green}}", 2)).Should(Equal("ab...{{green}}"))
+		})
+
+		It("treats text that merely looks like a token as visible characters", func() {
+			Ω(f.Truncate("{{not-a-color}} world", 6)).Should(Equal("{{not-..."))
+		})
+	})
+
+	Describe("StripANSI", func() {
+		It("removes color, cursor, and hyperlink escape sequences", func() {
+			Ω(formatter.StripANSI(f.F("{{red}}{{bold}}hello{{/}} world"))).Should(Equal("hello world"))
+			Ω(formatter.StripANSI("\x1b[2K\x1b[1Aup\x1b]8;;https://example.com\x07link\x1b]8;;\x1b\\")).Should(Equal("uplink"))
+			Ω(formatter.StripANSI("plain")).Should(Equal("plain"))
+		})
+
+		It("maps offsets in the original string to offsets in the stripped string", func() {
+			original := "ab\x1b[31mcd\x1b[0mef"
+			stripped, mapOffset := formatter.StripANSIWithOffsets(original)
+			Ω(stripped).Should(Equal("abcdef"))
+			Ω(mapOffset(0)).Should(Equal(0))
+			Ω(mapOffset(2)).Should(Equal(2))
+			Ω(mapOffset(4)).Should(Equal(2))
+			Ω(mapOffset(7)).Should(Equal(2))
+			Ω(mapOffset(8)).Should(Equal(3))
+			Ω(mapOffset(len(original))).Should(Equal(len(stripped)))
+		})
+	})
 })
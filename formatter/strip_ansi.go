@@ -0,0 +1,36 @@
+package formatter
+
+import "regexp"
+
+// matches CSI sequences (e.g. colors and cursor movement), OSC sequences (e.g. hyperlinks and window titles), and two-character escape sequences
+var ansiEscapeRegExp = regexp.MustCompile("\x1b(?:\\[[0-?]*[ -/]*[@-~]|\\][^\x07\x1b]*(?:\x07|\x1b\\\\)|[@-Z\\\\-_])")
+
+// StripANSI removes any ANSI escape sequences from s
+func StripANSI(s string) string {
+	return ansiEscapeRegExp.ReplaceAllString(s, "")
+}
+
+/*
+StripANSIWithOffsets removes any ANSI escape sequences from s and returns a function that maps byte offsets in s to the corresponding offsets in the stripped string.
+Offsets that fall inside an escape sequence map to the start of the (removed) sequence.
+*/
+func StripANSIWithOffsets(s string) (string, func(int) int) {
+	matches := ansiEscapeRegExp.FindAllStringIndex(s, -1)
+	if len(matches) == 0 {
+		return s, func(offset int) int { return offset }
+	}
+	mapOffset := func(offset int) int {
+		removed := 0
+		for _, match := range matches {
+			if offset <= match[0] {
+				break
+			}
+			if offset < match[1] {
+				return match[0] - removed
+			}
+			removed += match[1] - match[0]
+		}
+		return offset - removed
+	}
+	return ansiEscapeRegExp.ReplaceAllString(s, ""), mapOffset
+}
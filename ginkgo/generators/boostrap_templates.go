@@ -6,12 +6,13 @@ import (
 	"testing"
 
 	{{.GinkgoImport}}
-	{{.GomegaImport}}
+	{{.GomegaImport}}{{if .JUnitReport}}
+	"github.com/onsi/ginkgo/v2/reporters"{{end}}
 )
 
 func Test{{.FormattedName}}(t *testing.T) {
 	{{.GomegaPackage}}RegisterFailHandler({{.GinkgoPackage}}Fail)
-	{{.GinkgoPackage}}RunSpecs(t, "{{.FormattedName}} Suite")
+	{{.GinkgoPackage}}RunSpecs(t, "{{.FormattedName}} Suite"{{if .Labels}}, {{.GinkgoPackage}}Label({{range $i, $label := .Labels}}{{if $i}}, {{end}}{{printf "%q" $label}}{{end}}){{end}}{{if .JUnitReport}}, reporters.ReporterSet{JUnitReport: "{{.JUnitReport}}"}{{end}})
 }
 `
 
@@ -21,13 +22,14 @@ import (
 	"testing"
 
 	{{.GinkgoImport}}
-	{{.GomegaImport}}
+	{{.GomegaImport}}{{if .JUnitReport}}
+	"github.com/onsi/ginkgo/v2/reporters"{{end}}
 	"github.com/sclevine/agouti"
 )
 
 func Test{{.FormattedName}}(t *testing.T) {
 	{{.GomegaPackage}}RegisterFailHandler({{.GinkgoPackage}}Fail)
-	{{.GinkgoPackage}}RunSpecs(t, "{{.FormattedName}} Suite")
+	{{.GinkgoPackage}}RunSpecs(t, "{{.FormattedName}} Suite"{{if .Labels}}, {{.GinkgoPackage}}Label({{range $i, $label := .Labels}}{{if $i}}, {{end}}{{printf "%q" $label}}{{end}}){{end}}{{if .JUnitReport}}, reporters.ReporterSet{JUnitReport: "{{.JUnitReport}}"}{{end}})
 }
 
 var agoutiDriver *agouti.WebDriver
@@ -23,6 +23,11 @@ func BuildBootstrapCommand() command.Command {
 				Usage: "If set, bootstrap will generate a bootstrap test file that does not dot-import ginkgo and gomega"},
 			{Name: "internal", KeyPath: "Internal",
 				Usage: "If set, bootstrap will generate a bootstrap test file that uses the regular package name (i.e. `package X`, not `package X_test`)"},
+			{Name: "labels", KeyPath: "Labels",
+				UsageArgument: "labels",
+				Usage:         "If specified, bootstrap will generate a bootstrap test file that passes the given comma-separated labels to RunSpecs as suite labels (i.e. `--labels unit,integration` will add `Label(\"unit\", \"integration\")`)"},
+			{Name: "with-junit", KeyPath: "WithJUnit",
+				Usage: "If set, bootstrap will generate a bootstrap test file that configures RunSpecs to generate a JUnit report named junit_report.xml in the suite's directory"},
 			{Name: "template", KeyPath: "CustomTemplate",
 				UsageArgument: "template-file",
 				Usage:         "If specified, generate will use the contents of the file passed as the bootstrap template"},
@@ -61,6 +66,8 @@ type bootstrapData struct {
 	GomegaImport  string
 	GinkgoPackage string
 	GomegaPackage string
+	Labels        []string
+	JUnitReport   string
 	CustomData    map[string]any
 }
 
@@ -75,6 +82,11 @@ func generateBootstrap(conf GeneratorsConfig) {
 		GomegaImport:  `. "github.com/onsi/gomega"`,
 		GinkgoPackage: "",
 		GomegaPackage: "",
+		Labels:        parseLabels(conf.Labels),
+	}
+
+	if conf.WithJUnit {
+		data.JUnitReport = "junit_report.xml"
 	}
 
 	if conf.NoDot {
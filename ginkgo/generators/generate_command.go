@@ -26,6 +26,9 @@ func BuildGenerateCommand() command.Command {
 				Usage: "If set, generate will create a test file that does not dot-import ginkgo and gomega"},
 			{Name: "internal", KeyPath: "Internal",
 				Usage: "If set, generate will create a test file that uses the regular package name (i.e. `package X`, not `package X_test`)"},
+			{Name: "labels", KeyPath: "Labels",
+				UsageArgument: "labels",
+				Usage:         "If specified, generate will create a test file whose top-level container is decorated with the given comma-separated labels (i.e. `--labels unit,integration` will add `Label(\"unit\", \"integration\")`)"},
 			{Name: "template", KeyPath: "CustomTemplate",
 				UsageArgument: "template-file",
 				Usage:         "If specified, generate will use the contents of the file passed as the test file template"},
@@ -72,6 +75,7 @@ type specData struct {
 	GomegaImport  string
 	GinkgoPackage string
 	GomegaPackage string
+	Labels        []string
 	CustomData    map[string]any
 }
 
@@ -107,6 +111,7 @@ func generateTestFileForSubject(subject string, conf GeneratorsConfig) {
 		GomegaImport:  `. "github.com/onsi/gomega"`,
 		GinkgoPackage: "",
 		GomegaPackage: "",
+		Labels:        parseLabels(conf.Labels),
 	}
 
 	if conf.NoDot {
@@ -10,7 +10,7 @@ import (
 	{{if .ImportPackage}}"{{.PackageImportPath}}"{{end}}
 )
 
-var _ = {{.GinkgoPackage}}Describe("{{.Subject}}", func() {
+var _ = {{.GinkgoPackage}}Describe("{{.Subject}}"{{if .Labels}}, {{.GinkgoPackage}}Label({{range $i, $label := .Labels}}{{if $i}}, {{end}}{{printf "%q" $label}}{{end}}){{end}}, func() {
 
 })
 `
@@ -27,7 +27,7 @@ import (
 	{{if .ImportPackage}}"{{.PackageImportPath}}"{{end}}
 )
 
-var _ = {{.GinkgoPackage}}Describe("{{.Subject}}", func() {
+var _ = {{.GinkgoPackage}}Describe("{{.Subject}}"{{if .Labels}}, {{.GinkgoPackage}}Label({{range $i, $label := .Labels}}{{if $i}}, {{end}}{{printf "%q" $label}}{{end}}){{end}}, func() {
 	var page *agouti.Page
 
 	{{.GinkgoPackage}}BeforeEach(func() {
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/onsi/ginkgo/v2/ginkgo/command"
+	"github.com/onsi/ginkgo/v2/types"
 )
 
 type GeneratorsConfig struct {
@@ -16,6 +17,8 @@ type GeneratorsConfig struct {
 	CustomTemplate          string
 	CustomTemplateData      string
 	Tags                    string
+	Labels                  string
+	WithJUnit               bool
 }
 
 func getPackageAndFormattedName() (string, string, string) {
@@ -65,6 +68,22 @@ func determinePackageName(name string, internal bool) string {
 	return name + "_test"
 }
 
+// parseLabels splits the comma-separated labels passed via --labels, aborting if any of them is not a valid label
+func parseLabels(labels string) []string {
+	if labels == "" {
+		return nil
+	}
+	out := []string{}
+	for _, label := range strings.Split(labels, ",") {
+		cleanLabel, err := types.ValidateAndCleanupLabel(label, types.CodeLocation{})
+		if err != nil {
+			command.AbortWith("Invalid label {{bold}}%q{{/}} passed to --labels.  Labels cannot be empty or contain any of the characters '&|!,()/'", label)
+		}
+		out = append(out, cleanLabel)
+	}
+	return out
+}
+
 // getBuildTags returns the resultant string to be added.
 // If the input string is not empty, then returns a `//go:build {}` string,
 // otherwise returns an empty string.
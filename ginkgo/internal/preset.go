@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"os"
+	"sort"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Preset captures a named set of filters that can be applied with ginkgo --preset=NAME
+type Preset struct {
+	LabelFilter  string   `yaml:"label-filter"`
+	FocusStrings []string `yaml:"focus"`
+	SkipStrings  []string `yaml:"skip"`
+	FocusFiles   []string `yaml:"focus-file"`
+	SkipFiles    []string `yaml:"skip-file"`
+}
+
+// Presets maps preset names to Presets
+type Presets map[string]Preset
+
+// Names returns the names of the presets, sorted alphabetically
+func (p Presets) Names() []string {
+	names := []string{}
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+LoadPresets reads the named presets defined in the YAML file at path.  The file is expected to look like:
+
+	presets:
+	  smoke:
+	    label-filter: smoke && !slow
+	  nightly:
+	    label-filter: integration
+	    skip: ["flaky"]
+*/
+func LoadPresets(path string) (Presets, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, types.GinkgoErrors.FailedToLoadPresets(path, err)
+	}
+	file := struct {
+		Presets Presets `yaml:"presets"`
+	}{}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, types.GinkgoErrors.FailedToLoadPresets(path, err)
+	}
+	return file.Presets, nil
+}
+
+// ApplyPreset merges the filters defined by the preset named name into suiteConfig.  Any filter explicitly set on the command line (i.e. in flagSet) takes precedence over the preset.
+func ApplyPreset(flagSet types.GinkgoFlagSet, presets Presets, name string, presetsPath string, suiteConfig types.SuiteConfig) (types.SuiteConfig, error) {
+	preset, ok := presets[name]
+	if !ok {
+		return suiteConfig, types.GinkgoErrors.UnknownPreset(name, presetsPath, presets.Names())
+	}
+	if preset.LabelFilter != "" && !flagSet.WasSet("label-filter") {
+		suiteConfig.LabelFilter = preset.LabelFilter
+	}
+	if len(preset.FocusStrings) > 0 && !flagSet.WasSet("focus") {
+		suiteConfig.FocusStrings = preset.FocusStrings
+	}
+	if len(preset.SkipStrings) > 0 && !flagSet.WasSet("skip") {
+		suiteConfig.SkipStrings = preset.SkipStrings
+	}
+	if len(preset.FocusFiles) > 0 && !flagSet.WasSet("focus-file") {
+		suiteConfig.FocusFiles = preset.FocusFiles
+	}
+	if len(preset.SkipFiles) > 0 && !flagSet.WasSet("skip-file") {
+		suiteConfig.SkipFiles = preset.SkipFiles
+	}
+	return suiteConfig, nil
+}
+
+// LoadAndApplyPreset loads the presets defined in presetsPath and applies the preset named name to suiteConfig.  If name is empty suiteConfig is returned unmodified.
+func LoadAndApplyPreset(flagSet types.GinkgoFlagSet, name string, presetsPath string, suiteConfig types.SuiteConfig) (types.SuiteConfig, error) {
+	if name == "" {
+		return suiteConfig, nil
+	}
+	presets, err := LoadPresets(presetsPath)
+	if err != nil {
+		return suiteConfig, err
+	}
+	return ApplyPreset(flagSet, presets, name, presetsPath, suiteConfig)
+}
@@ -0,0 +1,100 @@
+package internal_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/ginkgo/internal"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Presets", func() {
+	var presetsPath string
+	var suiteConfig types.SuiteConfig
+	var flagSet types.GinkgoFlagSet
+
+	parse := func(args ...string) {
+		var err error
+		suiteConfig = types.NewDefaultSuiteConfig()
+		reporterConfig := types.NewDefaultReporterConfig()
+		cliConfig := types.NewDefaultCLIConfig()
+		goFlagsConfig := types.NewDefaultGoFlagsConfig()
+		flagSet, err = types.BuildRunCommandFlagSet(&suiteConfig, &reporterConfig, &cliConfig, &goFlagsConfig)
+		Ω(err).ShouldNot(HaveOccurred())
+		_, err = flagSet.Parse(args)
+		Ω(err).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		presetsPath = filepath.Join(GinkgoT().TempDir(), types.PRESETS_FILE)
+		Ω(os.WriteFile(presetsPath, []byte(`presets:
+  smoke:
+    label-filter: smoke && !slow
+  nightly:
+    label-filter: integration
+    focus: ["checkout"]
+    skip: ["flaky", "quarantined"]
+    focus-file: ["cart_test.go"]
+    skip-file: ["legacy_test.go"]
+`), 0644)).Should(Succeed())
+	})
+
+	Describe("LoadPresets", func() {
+		It("loads the named presets", func() {
+			presets, err := internal.LoadPresets(presetsPath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(presets.Names()).Should(Equal([]string{"nightly", "smoke"}))
+			Ω(presets["smoke"]).Should(Equal(internal.Preset{LabelFilter: "smoke && !slow"}))
+		})
+
+		It("errors when the file does not exist", func() {
+			_, err := internal.LoadPresets(filepath.Join(filepath.Dir(presetsPath), "missing.yaml"))
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Failed to load presets"))
+		})
+
+		It("errors when the file is not valid YAML", func() {
+			Ω(os.WriteFile(presetsPath, []byte("presets: [this is not\n"), 0644)).Should(Succeed())
+			_, err := internal.LoadPresets(presetsPath)
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Failed to load presets"))
+		})
+	})
+
+	Describe("LoadAndApplyPreset", func() {
+		It("does nothing when no preset is requested", func() {
+			parse()
+			conf, err := internal.LoadAndApplyPreset(flagSet, "", "/does/not/exist", suiteConfig)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(conf).Should(Equal(suiteConfig))
+		})
+
+		It("applies the preset's filters", func() {
+			parse("--preset=nightly")
+			conf, err := internal.LoadAndApplyPreset(flagSet, "nightly", presetsPath, suiteConfig)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(conf.LabelFilter).Should(Equal("integration"))
+			Ω(conf.FocusStrings).Should(Equal([]string{"checkout"}))
+			Ω(conf.SkipStrings).Should(Equal([]string{"flaky", "quarantined"}))
+			Ω(conf.FocusFiles).Should(Equal([]string{"cart_test.go"}))
+			Ω(conf.SkipFiles).Should(Equal([]string{"legacy_test.go"}))
+		})
+
+		It("lets explicitly set flags override the preset", func() {
+			parse("--preset=nightly", "--label-filter=unit", "--skip=slow")
+			conf, err := internal.LoadAndApplyPreset(flagSet, "nightly", presetsPath, suiteConfig)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(conf.LabelFilter).Should(Equal("unit"))
+			Ω(conf.SkipStrings).Should(Equal([]string{"slow"}))
+			Ω(conf.FocusStrings).Should(Equal([]string{"checkout"}))
+		})
+
+		It("errors clearly when the preset is unknown", func() {
+			parse("--preset=weekly")
+			_, err := internal.LoadAndApplyPreset(flagSet, "weekly", presetsPath, suiteConfig)
+			Ω(err).Should(Equal(types.GinkgoErrors.UnknownPreset("weekly", presetsPath, []string{"nightly", "smoke"})))
+		})
+	})
+})
@@ -129,11 +129,7 @@ func FinalizeProfilesAndReportsForSuites(suites TestSuites, cliConfig types.CLIC
 			for _, suite := range reportableSuites {
 				reports = append(reports, AbsPathForGeneratedAsset(format.ReportName, suite, cliConfig, 0))
 			}
-			dst := format.ReportName
-			if cliConfig.OutputDir != "" {
-				dst = filepath.Join(cliConfig.OutputDir, format.ReportName)
-			}
-			mergeMessages, err := format.MergeFunc(reports, dst)
+			mergeMessages, err := format.MergeFunc(reports, MergedReportPath(format.ReportName, cliConfig))
 			messages = append(messages, mergeMessages...)
 			if err != nil {
 				return messages, err
@@ -144,6 +140,14 @@ func FinalizeProfilesAndReportsForSuites(suites TestSuites, cliConfig types.CLIC
 	return messages, nil
 }
 
+// MergedReportPath returns the path at which the per-suite reports named reportName are merged into a single report
+func MergedReportPath(reportName string, cliConfig types.CLIConfig) string {
+	if cliConfig.OutputDir != "" {
+		return filepath.Join(cliConfig.OutputDir, reportName)
+	}
+	return reportName
+}
+
 //loads each profile, combines them, deletes them, stores them in destination
 func MergeAndCleanupCoverProfiles(profiles []string, destination string) error {
 	combined := &bytes.Buffer{}
@@ -27,7 +27,18 @@ func RunCompiledSuite(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterC
 		return suite
 	}
 
-	if suite.IsGinkgo && cliConfig.ComputedProcs() > 1 {
+	// the suite runs in its package directory so the timing baseline must be resolved relative to the directory ginkgo was invoked in
+	if ginkgoConfig.TimingBaseline != "" {
+		ginkgoConfig.TimingBaseline, _ = filepath.Abs(ginkgoConfig.TimingBaseline)
+	}
+
+	// the test binary never isolates specs itself - the CLI does so by invoking it once per spec
+	isolateSpecs := ginkgoConfig.IsolateSpecsInSubprocess && !ginkgoConfig.DryRun
+	ginkgoConfig.IsolateSpecsInSubprocess = false
+
+	if suite.IsGinkgo && isolateSpecs {
+		suite = runIsolated(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
+	} else if suite.IsGinkgo && cliConfig.ComputedProcs() > 1 {
 		suite = runParallel(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
 	} else if suite.IsGinkgo {
 		suite = runSerial(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
@@ -113,6 +124,12 @@ func runSerial(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig t
 	if reporterConfig.TeamcityReport != "" {
 		reporterConfig.TeamcityReport = AbsPathForGeneratedAsset(reporterConfig.TeamcityReport, suite, cliConfig, 0)
 	}
+	if reporterConfig.SpecManifest != "" {
+		reporterConfig.SpecManifest = AbsPathForGeneratedAsset(reporterConfig.SpecManifest, suite, cliConfig, 0)
+	}
+	if reporterConfig.PlanOutput != "" {
+		reporterConfig.PlanOutput = AbsPathForGeneratedAsset(reporterConfig.PlanOutput, suite, cliConfig, 0)
+	}
 
 	args, err := types.GenerateGinkgoTestRunArgs(ginkgoConfig, reporterConfig, goFlagsConfig)
 	command.AbortIfError("Failed to generate test run arguments", err)
@@ -171,7 +188,11 @@ func runParallel(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig
 
 	procResults := make(chan procResult)
 
-	server, err := parallel_support.NewServer(numProcs, reporters.NewDefaultReporter(reporterConfig, formatter.ColorableStdOut))
+	serverReporterConfig := reporterConfig
+	if types.DetectGitLabCI(os.LookupEnv) {
+		serverReporterConfig.GitLabSections = true
+	}
+	server, err := parallel_support.NewServer(numProcs, reporters.NewDefaultReporter(serverReporterConfig, formatter.ColorableStdOut))
 	command.AbortIfError("Failed to start parallel spec server", err)
 	server.Start()
 	defer server.Close()
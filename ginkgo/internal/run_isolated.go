@@ -0,0 +1,331 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/formatter"
+	"github.com/onsi/ginkgo/v2/ginkgo/command"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+runIsolated runs each spec in its own invocation of the suite's test binary so that a spec that crashes the process only fails that spec.
+
+The suite is first run with --dry-run to find the specs that will run, in the order they will run.  Each spec (or, for specs in Ordered containers, each run of
+adjacent Ordered specs) is then run by re-invoking the test binary with --focus-spec-id.  The subprocess reports its outcome by writing a JSON report which the CLI
+renders and aggregates.  Each subprocess runs the suite-level nodes but they are only reported once for each distinct outcome.
+
+Subprocesses report through their JSON report rather than through the parallel support server: the server coordinates a fixed set of processes that run
+concurrently and treats a process that exits early as fatal to the whole run, whereas isolated subprocesses run one after another and are expected to crash.
+The JSON report, together with the CrashReporter's partial report, survives a crash and identifies the spec that was running.  If the subprocess crashes the CrashReporter's partial report identifies the spec that was running, which is reported as aborted along with
+the output the subprocess emitted.  Specs whose subprocess exits without reporting on them at all are reported as failed.
+*/
+func runIsolated(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig types.ReporterConfig, cliConfig types.CLIConfig, goFlagsConfig types.GoFlagsConfig, additionalArgs []string) TestSuite {
+	tmpDir, err := os.MkdirTemp("", "ginkgo-isolated")
+	command.AbortIfError("Failed to create a temporary directory for isolated specs", err)
+	defer os.RemoveAll(tmpDir)
+
+	plannedReport, plan, hasProgrammaticFocus, output, err := planIsolatedSpecs(suite, ginkgoConfig, reporterConfig, tmpDir, additionalArgs)
+	if err != nil {
+		fmt.Fprint(formatter.ColorableStdErr, formatter.F("{{bold}}{{red}}Ginkgo failed to discover the specs to run in isolation:{{/}} %s\n", err.Error()))
+		fmt.Fprintln(os.Stderr, formatter.Fi(1, "%s", output))
+		suite.State = TestSuiteStateFailed
+		return suite
+	}
+	groups := groupIsolatedSpecs(plan)
+	if len(groups) == 0 {
+		// there's nothing to isolate - let the suite run (and report on) its empty set of specs as usual
+		return runSerial(suite, ginkgoConfig, reporterConfig, cliConfig, goFlagsConfig, additionalArgs)
+	}
+
+	if reporterConfig.JSONReport != "" {
+		reporterConfig.JSONReport = AbsPathForGeneratedAsset(reporterConfig.JSONReport, suite, cliConfig, 0)
+	}
+	if reporterConfig.JUnitReport != "" {
+		reporterConfig.JUnitReport = AbsPathForGeneratedAsset(reporterConfig.JUnitReport, suite, cliConfig, 0)
+	}
+	if reporterConfig.TeamcityReport != "" {
+		reporterConfig.TeamcityReport = AbsPathForGeneratedAsset(reporterConfig.TeamcityReport, suite, cliConfig, 0)
+	}
+
+	consoleReporterConfig := reporterConfig
+	if types.DetectGitLabCI(os.LookupEnv) {
+		consoleReporterConfig.GitLabSections = true
+	}
+	reporter := reporters.NewDefaultReporter(consoleReporterConfig, formatter.ColorableStdOut)
+
+	report := plannedReport
+	report.SuiteConfig.DryRun, report.SuiteConfig.IsolateSpecsInSubprocess = false, true
+	report.SuiteSucceeded = true
+	report.StartTime, report.EndTime = time.Now(), time.Now()
+	report.SpecReports = types.SpecReports{}
+	reporter.SuiteWillBegin(report)
+
+	// specs that will not run are reported up front
+	plannedSpecs := map[string]types.SpecReport{}
+	for _, specReport := range plannedReport.SpecReports {
+		plannedSpecs[specReport.ID] = specReport
+		if specReport.State.Is(types.SpecStatePending | types.SpecStateSkipped) {
+			reporter.WillRun(specReport)
+			reporter.DidRun(specReport)
+			report.SpecReports = append(report.SpecReports, specReport)
+		}
+	}
+
+	profiles := map[string][]string{}
+	reportedSuiteLevelNodes := map[string]bool{}
+	for idx, group := range groups {
+		procGoFlagsConfig := goFlagsConfig
+		for _, profile := range []*string{&procGoFlagsConfig.CoverProfile, &procGoFlagsConfig.BlockProfile, &procGoFlagsConfig.CPUProfile, &procGoFlagsConfig.MemProfile, &procGoFlagsConfig.MutexProfile} {
+			if *profile != "" {
+				original := *profile
+				*profile = AbsPathForGeneratedAsset(original, suite, cliConfig, idx+1)
+				profiles[original] = append(profiles[original], *profile)
+			}
+		}
+
+		groupReport, hasFocus, crashOutput := runIsolatedGroup(suite, ginkgoConfig, reporterConfig, procGoFlagsConfig, additionalArgs, plannedReport, group, filepath.Join(tmpDir, fmt.Sprintf("report-%d.json", idx+1)))
+		hasProgrammaticFocus = hasProgrammaticFocus || hasFocus
+		groupReport.SpecReports = withoutRepeatedSuiteLevelReports(groupReport.SpecReports, reportedSuiteLevelNodes)
+		for _, specReport := range groupReport.SpecReports {
+			reporter.WillRun(specReport)
+			reporter.DidRun(specReport)
+		}
+		if crashOutput != "" {
+			// the default reporter only emits captured stdout/stderr for specs that ran in parallel so we emit the crashed subprocess's output ourselves
+			fmt.Fprint(formatter.ColorableStdOut, formatter.F("{{bold}}Output from the subprocess that crashed:{{/}}\n"))
+			fmt.Fprintln(formatter.ColorableStdOut, formatter.Fi(1, "%s", crashOutput))
+		}
+		report = report.Add(groupReport)
+
+		if ginkgoConfig.FailFast && !groupReport.SuiteSucceeded {
+			for _, remaining := range groups[idx+1:] {
+				for _, spec := range remaining {
+					specReport := plannedSpecs[spec]
+					specReport.State = types.SpecStateSkipped
+					reporter.WillRun(specReport)
+					reporter.DidRun(specReport)
+					report.SpecReports = append(report.SpecReports, specReport)
+				}
+			}
+			break
+		}
+	}
+
+	report.EndTime = time.Now()
+	report.RunTime = report.EndTime.Sub(report.StartTime)
+	reporter.SuiteDidEnd(report)
+	generateIsolatedReports(report, reporterConfig)
+
+	suite.HasProgrammaticFocus = hasProgrammaticFocus
+	if report.SuiteSucceeded {
+		suite.State = TestSuiteStatePassed
+	} else {
+		suite.State = TestSuiteStateFailed
+	}
+
+	if goFlagsConfig.Cover {
+		if suite.HasProgrammaticFocus {
+			fmt.Fprintln(os.Stdout, "coverage: no coverfile was generated because specs are programmatically focused")
+		} else {
+			coverProfile := AbsPathForGeneratedAsset(goFlagsConfig.CoverProfile, suite, cliConfig, 0)
+			err := MergeAndCleanupCoverProfiles(profiles[goFlagsConfig.CoverProfile], coverProfile)
+			command.AbortIfError("Failed to combine cover profiles", err)
+		}
+	}
+	for _, profile := range []string{goFlagsConfig.BlockProfile, goFlagsConfig.CPUProfile, goFlagsConfig.MemProfile, goFlagsConfig.MutexProfile} {
+		if profile == "" || suite.HasProgrammaticFocus {
+			continue
+		}
+		err := MergeProfiles(profiles[profile], AbsPathForGeneratedAsset(profile, suite, cliConfig, 0))
+		command.AbortIfError("Failed to combine profiles", err)
+	}
+
+	return suite
+}
+
+// planIsolatedSpecs runs the suite with --dry-run and returns its report, which lists every spec, along with the plan of the specs that will run, in the order they will run.
+// The plan is needed as, unlike the JSON report, it records which specs are in Ordered containers.
+func planIsolatedSpecs(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig types.ReporterConfig, tmpDir string, additionalArgs []string) (types.Report, types.SpecPlan, bool, string, error) {
+	ginkgoConfig.DryRun = true
+	planReporterConfig := isolatedReporterConfig(reporterConfig, filepath.Join(tmpDir, "dry-run.json"))
+	planReporterConfig.PlanOutput = filepath.Join(tmpDir, "plan.json")
+
+	args, err := types.GenerateGinkgoTestRunArgs(ginkgoConfig, planReporterConfig, types.NewDefaultGoFlagsConfig())
+	command.AbortIfError("Failed to generate test run arguments", err)
+	args = append([]string{"--test.timeout=0"}, args...)
+	args = append(args, additionalArgs...)
+
+	cmd, buf := buildAndStartCommand(suite, args, false)
+	cmd.Wait()
+	exitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
+	if exitStatus != 0 && exitStatus != types.GINKGO_FOCUS_EXIT_CODE {
+		return types.Report{}, types.SpecPlan{}, false, buf.String(), fmt.Errorf("the dry run exited with status %d", exitStatus)
+	}
+	report, err := loadIsolatedReport(planReporterConfig.JSONReport)
+	if err != nil {
+		return types.Report{}, types.SpecPlan{}, false, buf.String(), err
+	}
+	plan := types.SpecPlan{}
+	data, err := os.ReadFile(planReporterConfig.PlanOutput)
+	if err == nil {
+		err = json.Unmarshal(data, &plan)
+	}
+	if err != nil {
+		return types.Report{}, types.SpecPlan{}, false, buf.String(), err
+	}
+	return report, plan, exitStatus == types.GINKGO_FOCUS_EXIT_CODE, buf.String(), nil
+}
+
+// groupIsolatedSpecs groups the IDs of the specs in plan by the subprocess they will run in.
+// Adjacent specs in the same Ordered container run together as they depend on one another.
+func groupIsolatedSpecs(plan types.SpecPlan) [][]string {
+	groups := [][]string{}
+	lastOrderedContainerID := uint(0)
+	for _, spec := range plan.Specs {
+		if spec.OrderedContainerID != 0 && spec.OrderedContainerID == lastOrderedContainerID {
+			groups[len(groups)-1] = append(groups[len(groups)-1], spec.ID)
+		} else {
+			groups = append(groups, []string{spec.ID})
+		}
+		lastOrderedContainerID = spec.OrderedContainerID
+	}
+	return groups
+}
+
+// runIsolatedGroup runs the specs with the passed-in IDs in a subprocess and returns a report that includes the specs and any suite-level nodes the subprocess ran.
+// If the subprocess exits without reporting on the specs they are reported as failed.  If the subprocess crashed its output is returned so that it can be emitted.
+func runIsolatedGroup(suite TestSuite, ginkgoConfig types.SuiteConfig, reporterConfig types.ReporterConfig, goFlagsConfig types.GoFlagsConfig, additionalArgs []string, plannedReport types.Report, ids []string, reportPath string) (types.Report, bool, string) {
+	ginkgoConfig.FocusSpecIDs = ids
+	// the dry run has already checked that enough specs will run
+	ginkgoConfig.MinSpecsToRun = 0
+	args, err := types.GenerateGinkgoTestRunArgs(ginkgoConfig, isolatedReporterConfig(reporterConfig, reportPath), goFlagsConfig)
+	command.AbortIfError("Failed to generate test run arguments", err)
+	args = append([]string{"--test.timeout=0"}, args...)
+	args = append(args, additionalArgs...)
+
+	startTime := time.Now()
+	cmd, buf := buildAndStartCommand(suite, args, false)
+	cmd.Wait()
+	endTime := time.Now()
+	exitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
+
+	inGroup := map[string]bool{}
+	for _, id := range ids {
+		inGroup[id] = true
+	}
+
+	out := types.Report{SuiteSucceeded: true, StartTime: startTime, EndTime: endTime}
+	crashed := false
+	reported := map[string]bool{}
+	if childReport, err := loadIsolatedReport(reportPath); err == nil {
+		out.SuiteSucceeded = childReport.SuiteSucceeded
+		out.SpecialSuiteFailureReasons = childReport.SpecialSuiteFailureReasons
+		out.FlakyReports = childReport.FlakyReports
+		for _, specReport := range childReport.SpecReports {
+			if !specReport.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) && !inGroup[specReport.ID] {
+				continue
+			}
+			if specReport.Failure.Message == reporters.CRASH_FAILURE_MESSAGE {
+				// the subprocess crashed while this spec was running and only got as far as writing the CrashReporter's partial report
+				specReport.StartTime, specReport.EndTime, specReport.RunTime = startTime, endTime, endTime.Sub(startTime)
+				specReport.CapturedStdOutErr = buf.String()
+				crashed = true
+			}
+			out.SpecReports = append(out.SpecReports, specReport)
+			reported[specReport.ID] = true
+		}
+	}
+
+	for _, specReport := range plannedReport.SpecReports {
+		if !inGroup[specReport.ID] || reported[specReport.ID] {
+			continue
+		}
+		specReport.State = types.SpecStateFailed
+		specReport.StartTime, specReport.EndTime, specReport.RunTime = startTime, endTime, endTime.Sub(startTime)
+		specReport.Failure = types.Failure{
+			Message:             fmt.Sprintf("The subprocess running this spec exited with status %d before reporting the spec's outcome.  The spec (or a node that ran alongside it) likely crashed the process - see the captured output for details.", exitStatus),
+			Location:            specReport.LeafNodeLocation,
+			FailureNodeContext:  types.FailureNodeIsLeafNode,
+			FailureNodeType:     types.NodeTypeIt,
+			FailureNodeLocation: specReport.LeafNodeLocation,
+		}
+		specReport.CapturedStdOutErr = buf.String()
+		out.SpecReports = append(out.SpecReports, specReport)
+		out.SuiteSucceeded = false
+		crashed = true
+	}
+
+	if !crashed {
+		return out, exitStatus == types.GINKGO_FOCUS_EXIT_CODE, ""
+	}
+	return out, exitStatus == types.GINKGO_FOCUS_EXIT_CODE, buf.String()
+}
+
+// withoutRepeatedSuiteLevelReports drops the reports of suite-level nodes that an earlier subprocess has already reported with the same outcome.
+// Every subprocess runs the suite-level nodes so, without this, the aggregated report would include a copy of (say) the BeforeSuite for every subprocess.
+func withoutRepeatedSuiteLevelReports(specReports types.SpecReports, reported map[string]bool) types.SpecReports {
+	out := types.SpecReports{}
+	for _, specReport := range specReports {
+		if specReport.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) {
+			key := fmt.Sprintf("%s|%s|%s|%s", specReport.LeafNodeType, specReport.LeafNodeText, specReport.LeafNodeLocation, specReport.State)
+			if reported[key] {
+				continue
+			}
+			reported[key] = true
+		}
+		out = append(out, specReport)
+	}
+	return out
+}
+
+// isolatedReporterConfig returns the reporter configuration for a subprocess.  The subprocess writes a JSON report to reportPath and only emits failures to the console: its console output is only shown if it crashes.
+func isolatedReporterConfig(reporterConfig types.ReporterConfig, reportPath string) types.ReporterConfig {
+	reporterConfig.NoColor = true
+	reporterConfig.Succinct, reporterConfig.Verbose, reporterConfig.VeryVerbose, reporterConfig.FailuresOnly = false, false, false, true
+	reporterConfig.SuppressSuiteHeader = true
+	reporterConfig.GitLabSections = false
+	reporterConfig.SpecCountSummary, reporterConfig.ReportFlakes, reporterConfig.ListPendingSpecs = false, false, false
+	reporterConfig.JSONReport = reportPath
+	// the partial report identifies the spec that was running if the subprocess crashes
+	reporterConfig.PartialReportsOnCrash = true
+	reporterConfig.JUnitReport, reporterConfig.TeamcityReport, reporterConfig.SpecManifest, reporterConfig.PlanOutput = "", "", "", ""
+	return reporterConfig
+}
+
+func loadIsolatedReport(path string) (types.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return types.Report{}, err
+	}
+	reports := []types.Report{}
+	if err := json.Unmarshal(data, &reports); err != nil {
+		return types.Report{}, err
+	}
+	if len(reports) != 1 {
+		return types.Report{}, fmt.Errorf("expected %s to contain one report but found %d", path, len(reports))
+	}
+	return reports[0], nil
+}
+
+// generateIsolatedReports writes the aggregated report to the machine-readable reports requested by reporterConfig
+func generateIsolatedReports(report types.Report, reporterConfig types.ReporterConfig) {
+	if !reporterConfig.KeepANSIInCaptured {
+		report = reporters.StripANSIFromCapturedOutput(report)
+	}
+	if reporterConfig.JSONReport != "" {
+		command.AbortIfError("Failed to generate JSON report", reporters.GenerateJSONReport(report, reporterConfig.JSONReport))
+	}
+	if reporterConfig.JUnitReport != "" {
+		command.AbortIfError("Failed to generate JUnit report", reporters.GenerateJUnitReport(report, reporterConfig.JUnitReport))
+	}
+	if reporterConfig.TeamcityReport != "" {
+		command.AbortIfError("Failed to generate Teamcity report", reporters.GenerateTeamcityReport(report, reporterConfig.TeamcityReport))
+	}
+}
@@ -0,0 +1,84 @@
+package listslow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/formatter"
+	"github.com/onsi/ginkgo/v2/ginkgo/command"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+type listSlowConfig struct {
+	Threshold time.Duration
+	JSON      bool
+	NoColor   bool
+}
+
+func BuildListSlowCommand() command.Command {
+	conf := listSlowConfig{
+		Threshold: time.Second,
+	}
+	flags, err := types.NewGinkgoFlagSet(
+		types.GinkgoFlags{
+			{Name: "threshold", KeyPath: "Threshold",
+				Usage:             "Only specs that took longer than this to run are listed.",
+				UsageDefaultValue: conf.Threshold.String(),
+			},
+			{Name: "json", KeyPath: "JSON",
+				Usage: "If set, emit the list of slow specs as JSON."},
+			{Name: "no-color", KeyPath: "NoColor",
+				Usage: "If set, suppress color output."},
+		},
+		&conf,
+		types.GinkgoFlagSections{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return command.Command{
+		Name:          "list-slow",
+		Usage:         "ginkgo list-slow <FLAGS> <REPORT>",
+		ShortDoc:      "List the slow specs in a JSON report generated by --json-report",
+		Documentation: "Lists the specs in the report that took longer than --threshold to run, sorted from slowest to fastest.",
+		DocLink:       "finding-slow-specs",
+		Flags:         flags,
+		Command: func(args []string, _ []string) {
+			listSlowSpecs(args, conf)
+		},
+	}
+}
+
+func listSlowSpecs(args []string, conf listSlowConfig) {
+	if len(args) != 1 {
+		command.AbortWithUsage("list-slow expects exactly one argument")
+	}
+	data, err := os.ReadFile(args[0])
+	command.AbortIfError("Failed to read report:", err)
+	reports := []types.Report{}
+	command.AbortIfError(fmt.Sprintf("Failed to decode %s:", args[0]), json.Unmarshal(data, &reports))
+
+	slowSpecs := reporters.ListSlowSpecs(reports, conf.Threshold)
+	if conf.JSON {
+		data, err := json.MarshalIndent(slowSpecs, "", "  ")
+		command.AbortIfError("Failed to encode slow specs:", err)
+		fmt.Println(string(data))
+		return
+	}
+	fmt.Print(renderSlowSpecs(slowSpecs, conf.Threshold, formatter.NewWithNoColorBool(conf.NoColor)))
+}
+
+func renderSlowSpecs(slowSpecs []types.SlowSpec, threshold time.Duration, f formatter.Formatter) string {
+	if len(slowSpecs) == 0 {
+		return f.F("{{gray}}No specs took longer than %s{{/}}\n", threshold)
+	}
+	out := f.F("{{bold}}Specs that took longer than %s{{/}} {{gray}}(%d){{/}}\n", threshold, len(slowSpecs))
+	for _, slowSpec := range slowSpecs {
+		out += f.Fi(1, "{{yellow}}%s{{/}} %s {{gray}}[%s] %s{{/}}\n", slowSpec.RunTime.Round(time.Millisecond), slowSpec.FullText, slowSpec.SuiteDescription, slowSpec.LeafNodeLocation)
+	}
+	return out
+}
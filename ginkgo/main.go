@@ -8,7 +8,9 @@ import (
 	"github.com/onsi/ginkgo/v2/ginkgo/command"
 	"github.com/onsi/ginkgo/v2/ginkgo/generators"
 	"github.com/onsi/ginkgo/v2/ginkgo/labels"
+	"github.com/onsi/ginkgo/v2/ginkgo/listslow"
 	"github.com/onsi/ginkgo/v2/ginkgo/outline"
+	"github.com/onsi/ginkgo/v2/ginkgo/reportdiff"
 	"github.com/onsi/ginkgo/v2/ginkgo/run"
 	"github.com/onsi/ginkgo/v2/ginkgo/unfocus"
 	"github.com/onsi/ginkgo/v2/ginkgo/watch"
@@ -24,7 +26,9 @@ func GenerateCommands() []command.Command {
 		generators.BuildBootstrapCommand(),
 		generators.BuildGenerateCommand(),
 		labels.BuildLabelsCommand(),
+		listslow.BuildListSlowCommand(),
 		outline.BuildOutlineCommand(),
+		reportdiff.BuildReportDiffCommand(),
 		unfocus.BuildUnfocusCommand(),
 		BuildVersionCommand(),
 	}
@@ -188,6 +188,8 @@ func ginkgoNodeFromCallExpr(fset *token.FileSet, ce *ast.CallExpr, ginkgoPackage
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
 	case "SynchronizedAfterSuite", "SynchronizedBeforeSuite":
 		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
+	case "AfterAllProcesses":
+		return &n, ginkgoPackageName != nil && *ginkgoPackageName == packageName
 	default:
 		return nil, false
 	}
@@ -0,0 +1,143 @@
+package reportdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/formatter"
+	"github.com/onsi/ginkgo/v2/ginkgo/command"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+type reportDiffConfig struct {
+	Format                  string
+	TimingThreshold         time.Duration
+	TimingThresholdFraction float64
+	NoColor                 bool
+}
+
+func BuildReportDiffCommand() command.Command {
+	conf := reportDiffConfig{
+		Format: "text",
+	}
+	flags, err := types.NewGinkgoFlagSet(
+		types.GinkgoFlags{
+			{Name: "format", KeyPath: "Format",
+				Usage:             "Format of the diff",
+				UsageArgument:     "one of 'text' or 'json'",
+				UsageDefaultValue: conf.Format,
+			},
+			{Name: "timing-threshold", KeyPath: "TimingThreshold",
+				Usage: "If set, specs whose run time changed by at least this much are listed as having changed timing."},
+			{Name: "timing-threshold-fraction", KeyPath: "TimingThresholdFraction",
+				Usage: "If set, specs whose run time changed by at least this fraction of their old run time (e.g. 0.5 for 50%) are listed as having changed timing.  When combined with --timing-threshold a spec must exceed both thresholds."},
+			{Name: "no-color", KeyPath: "NoColor",
+				Usage: "If set, suppress color output in the text diff."},
+		},
+		&conf,
+		types.GinkgoFlagSections{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return command.Command{
+		Name:          "report-diff",
+		Usage:         "ginkgo report-diff <FLAGS> <OLD-REPORT> <NEW-REPORT>",
+		ShortDoc:      "Compare two JSON reports generated by --json-report",
+		Documentation: "Lists the specs that are newly failing, newly passing, added, removed, and (if a timing threshold is set) whose timing changed.",
+		DocLink:       "comparing-reports",
+		Flags:         flags,
+		Command: func(args []string, _ []string) {
+			diffReports(args, conf)
+		},
+	}
+}
+
+func diffReports(args []string, conf reportDiffConfig) {
+	if len(args) != 2 {
+		command.AbortWithUsage("report-diff expects exactly two arguments")
+	}
+	oldReports := loadReports(args[0])
+	newReports := loadReports(args[1])
+	diffConfig := reporters.ReportDiffConfig{
+		TimingThreshold:         conf.TimingThreshold,
+		TimingThresholdFraction: conf.TimingThresholdFraction,
+	}
+
+	// suites are matched by description - suites that only appear in one of the reports are diffed against an empty report
+	diffs := []types.ReportDiff{}
+	matched := map[string]bool{}
+	for _, newReport := range newReports {
+		oldReport := types.Report{}
+		for _, candidate := range oldReports {
+			if candidate.SuiteDescription == newReport.SuiteDescription {
+				oldReport = candidate
+				break
+			}
+		}
+		matched[newReport.SuiteDescription] = true
+		diffs = append(diffs, reporters.DiffReports(oldReport, newReport, diffConfig))
+	}
+	for _, oldReport := range oldReports {
+		if !matched[oldReport.SuiteDescription] {
+			diffs = append(diffs, reporters.DiffReports(oldReport, types.Report{}, diffConfig))
+		}
+	}
+
+	switch conf.Format {
+	case "text":
+		fmt.Print(renderDiffs(diffs, formatter.NewWithNoColorBool(conf.NoColor)))
+	case "json":
+		data, err := json.MarshalIndent(diffs, "", "  ")
+		command.AbortIfError("Failed to encode diff:", err)
+		fmt.Println(string(data))
+	default:
+		command.AbortWith("Format %s not accepted", conf.Format)
+	}
+}
+
+func loadReports(path string) []types.Report {
+	data, err := os.ReadFile(path)
+	command.AbortIfError("Failed to read report:", err)
+	reports := []types.Report{}
+	command.AbortIfError(fmt.Sprintf("Failed to decode %s:", path), json.Unmarshal(data, &reports))
+	return reports
+}
+
+func renderDiffs(diffs []types.ReportDiff, f formatter.Formatter) string {
+	out := ""
+	for _, diff := range diffs {
+		out += f.F("{{bold}}%s{{/}}\n", diff.SuiteDescription)
+		if !diff.HasChanges() {
+			out += f.Fi(1, "{{gray}}No differences{{/}}\n")
+			continue
+		}
+		out += renderCategory(f, "{{red}}Newly Failing{{/}}", diff.NewlyFailing, func(d types.SpecDiff) string { return d.OldState.String() + " → " + d.NewState.String() })
+		out += renderCategory(f, "{{green}}Newly Passing{{/}}", diff.NewlyPassing, func(d types.SpecDiff) string { return d.OldState.String() + " → " + d.NewState.String() })
+		out += renderCategory(f, "{{cyan}}Added{{/}}", diff.Added, func(d types.SpecDiff) string { return d.NewState.String() })
+		out += renderCategory(f, "{{orange}}Removed{{/}}", diff.Removed, func(d types.SpecDiff) string { return d.OldState.String() })
+		out += renderCategory(f, "{{magenta}}Timing Changed{{/}}", diff.TimingChanged, func(d types.SpecDiff) string {
+			sign := "+"
+			if d.RunTimeChange() < 0 {
+				sign = ""
+			}
+			return fmt.Sprintf("%s → %s (%s%s)", d.OldRunTime.Round(time.Millisecond), d.NewRunTime.Round(time.Millisecond), sign, d.RunTimeChange().Round(time.Millisecond))
+		})
+	}
+	return out
+}
+
+func renderCategory(f formatter.Formatter, heading string, specDiffs []types.SpecDiff, detail func(types.SpecDiff) string) string {
+	if len(specDiffs) == 0 {
+		return ""
+	}
+	out := f.Fi(1, heading+" {{gray}}(%d){{/}}\n", len(specDiffs))
+	for _, specDiff := range specDiffs {
+		out += f.Fi(2, "%s {{gray}}%s{{/}}\n", specDiff.FullText, detail(specDiff))
+	}
+	return out
+}
@@ -37,7 +37,24 @@ func BuildRunCommand() command.Command {
 		Command: func(args []string, additionalArgs []string) {
 			var errors []error
 			cliConfig, goFlagsConfig, errors = types.VetAndInitializeCLIAndGoConfig(cliConfig, goFlagsConfig)
+			if reporterConfig.JSONReportToStdout() && cliConfig.KeepSeparateReports {
+				errors = append(errors, types.GinkgoErrors.JSONReportToStdoutWithKeepSeparateReports())
+			}
+			if suiteConfig.IsolateSpecsInSubprocess && cliConfig.ComputedProcs() > 1 {
+				errors = append(errors, types.GinkgoErrors.IsolateSpecsInSubprocessInParallel())
+			}
 			command.AbortIfErrors("Ginkgo detected configuration issues:", errors)
+			suiteConfig, err = internal.LoadAndApplyPreset(flags, cliConfig.Preset, types.PRESETS_FILE, suiteConfig)
+			command.AbortIfError("Ginkgo failed to apply the preset:", err)
+			if cliConfig.OnlyPreviouslyFlaked {
+				focusStrings, err := types.LoadPreviouslyFlakedFocusStrings(cliConfig.Input)
+				command.AbortIfError("Ginkgo failed to find the previously flaked specs:", err)
+				if len(focusStrings) == 0 {
+					fmt.Printf("No specs flaked in %s - there is nothing to run\n", cliConfig.Input)
+					command.Abort(command.AbortDetails{})
+				}
+				suiteConfig.FocusStrings = append(suiteConfig.FocusStrings, focusStrings...)
+			}
 
 			runner := &SpecRunner{
 				cliConfig:      cliConfig,
@@ -65,6 +82,11 @@ type SpecRunner struct {
 }
 
 func (r *SpecRunner) RunSpecs(args []string, additionalArgs []string) {
+	var jsonReportStdout *os.File
+	if r.reporterConfig.JSONReportToStdout() {
+		jsonReportStdout = r.routeOutputToStderrForJSONReport()
+	}
+
 	suites := internal.FindSuites(args, r.cliConfig, true)
 	skippedSuites := suites.WithState(internal.TestSuiteStateSkippedByFilter)
 	suites = suites.WithoutState(internal.TestSuiteStateSkippedByFilter)
@@ -86,7 +108,7 @@ func (r *SpecRunner) RunSpecs(args []string, additionalArgs []string) {
 		command.AbortWith("Found no test suites")
 	}
 
-	if len(suites) > 1 && !r.flags.WasSet("succinct") && r.reporterConfig.Verbosity().LT(types.VerbosityLevelVerbose) {
+	if len(suites) > 1 && !r.flags.WasSet("succinct") && !r.reporterConfig.FailuresOnly && r.reporterConfig.Verbosity().LT(types.VerbosityLevelVerbose) {
 		r.reporterConfig.Succinct = true
 	}
 
@@ -177,6 +199,9 @@ OUTER_LOOP:
 	for _, message := range messages {
 		fmt.Println(message)
 	}
+	if jsonReportStdout != nil {
+		command.AbortIfError("could not write JSON report to stdout:", r.writeJSONReportTo(jsonReportStdout))
+	}
 
 	fmt.Printf("\nGinkgo ran %d %s in %s\n", len(suites), internal.PluralizedWord("suite", "suites", len(suites)), time.Since(t))
 
@@ -193,7 +218,7 @@ OUTER_LOOP:
 		fmt.Fprintln(formatter.ColorableStdOut, "")
 		if len(suites) > 1 && suites.CountWithState(internal.TestSuiteStateFailureStates...) > 0 {
 			fmt.Fprintln(formatter.ColorableStdOut,
-				internal.FailedSuitesReport(suites, formatter.NewWithNoColorBool(r.reporterConfig.NoColor)))
+				internal.FailedSuitesReport(suites, formatter.NewWithColorBools(r.reporterConfig.NoColor, r.reporterConfig.ForceColor)))
 		}
 		fmt.Printf("Test Suite Failed\n")
 		command.Abort(command.AbortDetails{ExitCode: 1})
@@ -230,3 +255,27 @@ func orcMessage(iteration int) string {
 		return "No, seriously... you can probably stop now.\n"
 	}
 }
+
+/*
+routeOutputToStderrForJSONReport supports --json-report=-.  It sends all of Ginkgo's human-readable output (including the output of the suites it runs) to stderr and points the JSON report at an intermediate file that is written to stdout once all suites have run.
+
+It returns the original stdout.
+*/
+func (r *SpecRunner) routeOutputToStderrForJSONReport() *os.File {
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	formatter.ColorableStdOut = formatter.ColorableStdErr
+	r.reporterConfig.JSONReport = fmt.Sprintf("ginkgo-stdout-report-%d.json", os.Getpid())
+	return stdout
+}
+
+func (r *SpecRunner) writeJSONReportTo(stdout *os.File) error {
+	path := internal.MergedReportPath(r.reporterConfig.JSONReport, r.cliConfig)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	os.Remove(path)
+	_, err = stdout.Write(data)
+	return err
+}
@@ -36,6 +36,8 @@ func BuildWatchCommand() command.Command {
 			var errors []error
 			cliConfig, goFlagsConfig, errors = types.VetAndInitializeCLIAndGoConfig(cliConfig, goFlagsConfig)
 			command.AbortIfErrors("Ginkgo detected configuration issues:", errors)
+			suiteConfig, err = internal.LoadAndApplyPreset(flags, cliConfig.Preset, types.PRESETS_FILE, suiteConfig)
+			command.AbortIfError("Ginkgo failed to apply the preset:", err)
 
 			watcher := &SpecWatcher{
 				cliConfig:      cliConfig,
@@ -167,7 +169,7 @@ func (w *SpecWatcher) compileAndRun(suite internal.TestSuite, additionalArgs []s
 }
 
 func (w *SpecWatcher) computeSuccinctMode(numSuites int) {
-	if w.reporterConfig.Verbosity().GTE(types.VerbosityLevelVerbose) {
+	if w.reporterConfig.Verbosity().GTE(types.VerbosityLevelVerbose) || w.reporterConfig.FailuresOnly {
 		w.reporterConfig.Succinct = false
 		return
 	}
@@ -0,0 +1,52 @@
+package after_all_processes_fixture_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAfterAllProcessesFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AfterAllProcessesFixture Suite")
+}
+
+var _ = AfterSuite(func() {
+	// make the non-primary processes finish well after process #1
+	if GinkgoParallelProcess() > 1 {
+		time.Sleep(200 * time.Millisecond)
+	}
+	Ω(os.MkdirAll("artifacts", 0755)).Should(Succeed())
+	Ω(os.WriteFile(filepath.Join("artifacts", fmt.Sprintf("proc-%d.txt", GinkgoParallelProcess())), []byte(fmt.Sprintf("artifact from proc %d", GinkgoParallelProcess())), 0644)).Should(Succeed())
+})
+
+var _ = AfterAllProcesses(func() {
+	matches, err := filepath.Glob(filepath.Join("artifacts", "proc-*.txt"))
+	Ω(err).ShouldNot(HaveOccurred())
+	sort.Strings(matches)
+	merged := []string{}
+	for _, match := range matches {
+		content, err := os.ReadFile(match)
+		Ω(err).ShouldNot(HaveOccurred())
+		merged = append(merged, string(content))
+	}
+	f, err := os.OpenFile("merged.txt", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	Ω(err).ShouldNot(HaveOccurred())
+	defer f.Close()
+	fmt.Fprintf(f, "proc %d merged: %s\n", GinkgoParallelProcess(), strings.Join(merged, ", "))
+	fmt.Printf("AFTER_ALL_PROCESSES_%d\n", GinkgoParallelProcess())
+})
+
+var _ = Describe("AfterAllProcesses", func() {
+	It("A", func() {})
+	It("B", func() {})
+	It("C", func() {})
+	It("D", func() {})
+})
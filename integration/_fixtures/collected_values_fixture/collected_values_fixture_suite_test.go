@@ -0,0 +1,38 @@
+package collected_values_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCollectedValuesFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CollectedValuesFixture Suite")
+}
+
+var _ = Describe("servers", func() {
+	It("A", func() {
+		CollectValue("ports", 8080)
+		CollectValue("ids", "a")
+	})
+
+	It("B", func() {
+		CollectValue("ports", 8081)
+		CollectValue("ids", "b")
+	})
+
+	It("C", func() {
+		CollectValue("ports", 8080)
+		CollectValue("ids", "c")
+	})
+})
+
+var _ = ReportAfterSuite("ports are unique", func(report Report) {
+	ExpectUnique("ports")
+})
+
+var _ = ReportAfterSuite("ids are unique", func(report Report) {
+	ExpectUnique("ids")
+})
@@ -0,0 +1,31 @@
+package combo_reporter_fixture_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/reporters"
+	. "github.com/onsi/gomega"
+)
+
+func TestComboReporterFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	humanOut, err := os.Create(fmt.Sprintf("combo-%d.out", GinkgoParallelProcess()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer humanOut.Close()
+	_, conf := GinkgoConfiguration()
+	RunSpecs(t, "ComboReporterFixture Suite", reporters.ReporterSet{
+		Reporters: []reporters.Reporter{reporters.NewComboReporter(humanOut, "combo.json", conf)},
+	})
+}
+
+var _ = Describe("combo reporter", func() {
+	for i := 0; i < 8; i++ {
+		It(fmt.Sprintf("passes %d", i), func() {})
+	}
+	It("fails", func() { Fail("boom") })
+})
@@ -0,0 +1,27 @@
+package golden_fixture_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGoldenFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GoldenFixture Suite")
+}
+
+var _ = Describe("golden output", func() {
+	AfterEach(func() {
+		MatchGolden(filepath.Join("testdata", "greeting.golden"), `^time=`)
+	})
+
+	It("greets", func() {
+		GinkgoWriter.Printf("time=%d\n", time.Now().UnixNano())
+		GinkgoWriter.Println("hello")
+		GinkgoWriter.Println("world")
+	})
+})
@@ -0,0 +1,61 @@
+package isolated_specs_fixture_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIsolatedSpecsFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IsolatedSpecsFixture Suite")
+}
+
+var _ = BeforeSuite(func() {})
+
+var _ = AfterSuite(func() {})
+
+var _ = Describe("isolated specs", func() {
+	It("passes before the crashes", func() {})
+
+	It("exits", func() {
+		fmt.Println("about to exit")
+		os.Exit(3)
+	})
+
+	It("panics in a goroutine", func() {
+		done := make(chan interface{})
+		go func() {
+			panic("kaboom")
+		}()
+		<-done
+	})
+
+	It("passes after the crashes", func() {})
+
+	It("fails", func() {
+		Fail("a normal failure")
+	})
+
+	Describe("a crashing ordered container", Ordered, func() {
+		It("exits in an ordered container", func() {
+			os.Exit(3)
+		})
+	})
+
+	Describe("an ordered container", Ordered, func() {
+		var state int
+		It("sets up state", func() {
+			state = 1
+		})
+
+		It("relies on the state set up by the previous spec", func() {
+			Ω(state).Should(Equal(1))
+		})
+	})
+
+	PIt("is pending", func() {})
+})
@@ -0,0 +1,26 @@
+package no_capture_fixture_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNoCaptureFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NoCaptureFixture Suite")
+}
+
+var _ = Describe("output", Serial, func() {
+	It("is captured", func() {
+		fmt.Println("INTERCEPTED OUTPUT")
+		AddReportEntry("output-is-captured", OutputIsCaptured())
+	})
+
+	It("is not captured", NoCapture, func() {
+		fmt.Println("STRAIGHT TO STDOUT")
+		AddReportEntry("output-is-captured", OutputIsCaptured())
+	})
+})
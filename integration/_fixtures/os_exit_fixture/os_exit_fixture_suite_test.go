@@ -0,0 +1,24 @@
+package os_exit_fixture_test
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOsExitFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OsExitFixture Suite")
+}
+
+var _ = Describe("top-level container", func() {
+	It("runs and passes", func() {})
+	It("exits", func() {
+		os.Exit(3)
+	})
+	It("never runs", func() {
+		Fail("SHOULD NOT SEE THIS")
+	})
+})
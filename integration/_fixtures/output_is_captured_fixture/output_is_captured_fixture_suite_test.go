@@ -0,0 +1,17 @@
+package output_is_captured_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOutputIsCapturedFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OutputIsCapturedFixture Suite")
+}
+
+var _ = It("reports whether output is captured", func() {
+	AddReportEntry("output-is-captured", OutputIsCaptured())
+})
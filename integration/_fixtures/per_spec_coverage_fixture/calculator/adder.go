@@ -0,0 +1,5 @@
+package calculator
+
+func Add(a, b int) int {
+	return a + b
+}
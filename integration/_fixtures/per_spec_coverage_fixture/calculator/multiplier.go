@@ -0,0 +1,5 @@
+package calculator
+
+func Multiply(a, b int) int {
+	return a * b
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/onsi/ginkgo/v2/integration/_fixtures/per_spec_coverage_fixture/calculator"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PerSpecCoverageFixture", func() {
+	It("adds", func() {
+		Ω(calculator.Add(2, 3)).Should(Equal(5))
+	})
+
+	It("multiplies", func() {
+		Ω(calculator.Multiply(2, 3)).Should(Equal(6))
+	})
+})
+
+// failer lets the specs run from a binary built with go build -cover instead of go test
+type failer struct {
+	failed bool
+}
+
+func (f *failer) Fail() {
+	f.failed = true
+}
+
+func main() {
+	flag.Parse()
+	RegisterFailHandler(Fail)
+	f := &failer{}
+	RunSpecs(f, "PerSpecCoverageFixture Suite")
+	if f.failed {
+		os.Exit(1)
+	}
+}
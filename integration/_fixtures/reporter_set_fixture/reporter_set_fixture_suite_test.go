@@ -0,0 +1,42 @@
+package reporter_set_fixture_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+type countingReporter struct {
+	reporters.NoopReporter
+	didRun int
+}
+
+func (r *countingReporter) DidRun(report types.SpecReport) {
+	if report.LeafNodeType.Is(types.NodeTypeIt) {
+		r.didRun += 1
+	}
+}
+
+func (r *countingReporter) SuiteDidEnd(report types.Report) {
+	os.WriteFile(fmt.Sprintf("custom-reporter-%d.out", GinkgoParallelProcess()), []byte(fmt.Sprintf("%d", r.didRun)), 0666)
+}
+
+func TestReporterSetFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReporterSetFixture Suite", reporters.ReporterSet{
+		JSONReport:  "out.json",
+		JUnitReport: "out.xml",
+		Reporters:   []reporters.Reporter{&countingReporter{}},
+	})
+}
+
+var _ = Describe("reporter sets", func() {
+	It("passes", func() {})
+	It("fails", func() { Fail("boom") })
+	PIt("is pending", func() {})
+})
@@ -28,6 +28,7 @@ var _ = ReportBeforeSuite(func(report Report) {
 })
 
 var _ = BeforeSuite(func() {
+	GinkgoWriter.Println("creating the report files")
 	var err error
 	beforeEachReport, err = os.Create("report-before-each.out")
 	Ω(err).ShouldNot(HaveOccurred())
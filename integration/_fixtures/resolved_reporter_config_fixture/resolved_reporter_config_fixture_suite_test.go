@@ -0,0 +1,27 @@
+package resolved_reporter_config_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+func TestResolvedReporterConfigFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	suiteConfig, reporterConfig := GinkgoConfiguration()
+	reporterConfig.CIOverrides = &types.ReporterConfig{Succinct: true}
+	RunSpecs(t, "ResolvedReporterConfigFixture Suite", suiteConfig, reporterConfig)
+}
+
+var _ = It("sees the resolved reporter config", func() {
+	_, reporterConfig := GinkgoConfiguration()
+	Ω(reporterConfig.Verbosity()).Should(Equal(types.VerbosityLevelSuccinct))
+	Ω(reporterConfig.CIOverrides).Should(BeNil())
+})
+
+var _ = ReportAfterSuite("the report records the resolved reporter config", func(report Report) {
+	Ω(report.ReporterConfig.Verbosity()).Should(Equal(types.VerbosityLevelSuccinct))
+	Ω(report.ReporterConfig.JSONReport).Should(HaveSuffix("out.json"))
+})
@@ -0,0 +1,13 @@
+package spec_manifest_fixture_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSpecManifestFixture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SpecManifestFixture Suite")
+}
@@ -0,0 +1,33 @@
+package spec_manifest_fixture_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func generatedEntries() []TableEntry {
+	entries := []TableEntry{}
+	for i := 1; i <= 3; i++ {
+		entries = append(entries, Entry(fmt.Sprintf("adds %d + %d", i, i), i, i, 2*i))
+	}
+	return entries
+}
+
+var _ = Describe("arithmetic", Label("math"), func() {
+	It("can add", func() {
+		Ω(1 + 1).Should(Equal(2))
+	})
+
+	It("can divide", Pending, func() {
+		Ω(4 / 2).Should(Equal(2))
+	})
+
+	DescribeTable("generated additions",
+		func(a, b, c int) {
+			Ω(a + b).Should(Equal(c))
+		},
+		generatedEntries(),
+	)
+})
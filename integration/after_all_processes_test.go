@@ -0,0 +1,43 @@
+package integration_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("AfterAllProcesses", func() {
+	BeforeEach(func() {
+		fm.MountFixture("after_all_processes")
+	})
+
+	It("runs once, on process #1, after every process has finished", func() {
+		session := startGinkgo(fm.PathTo("after_all_processes"), "--no-color", "--procs=3", "--json-report=out.json")
+		Eventually(session).Should(gexec.Exit(0))
+
+		merged, err := os.ReadFile(fm.PathTo("after_all_processes", "merged.txt"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(merged)).Should(Equal("proc 1 merged: artifact from proc 1, artifact from proc 2, artifact from proc 3\n"))
+
+		report := fm.LoadJSONReports("after_all_processes", "out.json")[0]
+		afterAllProcesses := report.SpecReports.WithLeafNodeType(types.NodeTypeAfterAllProcesses)
+		Ω(afterAllProcesses).Should(HaveLen(1))
+		Ω(afterAllProcesses[0].State).Should(Equal(types.SpecStatePassed))
+		Ω(afterAllProcesses[0].ParallelProcess).Should(Equal(1))
+		Ω(afterAllProcesses[0].CapturedStdOutErr).Should(ContainSubstring("AFTER_ALL_PROCESSES_1"))
+	})
+
+	It("runs once after the suite when running in series", func() {
+		session := startGinkgo(fm.PathTo("after_all_processes"), "--no-color")
+		Eventually(session).Should(gexec.Exit(0))
+
+		merged, err := os.ReadFile(fm.PathTo("after_all_processes", "merged.txt"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(merged)).Should(Equal("proc 1 merged: artifact from proc 1\n"))
+		Ω(session).Should(gbytes.Say("AFTER_ALL_PROCESSES_1"))
+	})
+})
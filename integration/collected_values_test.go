@@ -0,0 +1,41 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Collecting values and checking that they are unique", func() {
+	BeforeEach(func() {
+		fm.MountFixture("collected_values")
+	})
+
+	DescribeTable("fails the suite when duplicate values were collected, even when the duplicates were collected on different processes",
+		func(args ...string) {
+			session := startGinkgo(fm.PathTo("collected_values"), append([]string{"--no-color", "--json-report=out.json"}, args...)...)
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(string(session.Out.Contents())).Should(ContainSubstring(`Expected the values collected under "ports" to be unique but found duplicates:`))
+			Ω(string(session.Out.Contents())).Should(ContainSubstring("8080 was collected 2 times:"))
+			Ω(string(session.Out.Contents())).ShouldNot(ContainSubstring("8081 was collected"))
+
+			report := fm.LoadJSONReports("collected_values", "out.json")[0]
+			Ω(report.SuiteSucceeded).Should(BeFalse())
+			Ω(report.CollectedValues("ports")).Should(HaveLen(3))
+			Ω(report.CollectedValues("ids")).Should(HaveLen(3))
+
+			reports := Reports(report.SpecReports)
+			Ω(reports.Find("A").CollectedValues).Should(ConsistOf(
+				HaveField("Value", "8080"),
+				HaveField("Value", "a"),
+			))
+			Ω(reports.Find("ports are unique")).Should(HaveFailed())
+			Ω(reports.Find("ports are unique").LeafNodeType).Should(Equal(types.NodeTypeReportAfterSuite))
+			Ω(reports.Find("ids are unique")).Should(HavePassed())
+		},
+		Entry("in series"),
+		Entry("in parallel", "--procs=3"),
+	)
+})
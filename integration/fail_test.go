@@ -45,6 +45,26 @@ var _ = Describe("Failing Specs", func() {
 		})
 	})
 
+	Describe("when configured to emit the suite environment on failure", func() {
+		It("emits the environment, including the resolved parallelism, when the suite fails", func() {
+			fm.MountFixture("fail")
+			session := startGinkgo(fm.PathTo("fail"), "--no-color", "--procs=2", "--emit-environment-on-failure")
+			Eventually(session).Should(gexec.Exit(1))
+			output := string(session.Out.Contents())
+
+			Ω(output).Should(ContainSubstring("Suite Environment"))
+			Ω(output).Should(MatchRegexp(`GOMAXPROCS: \d+ \| CPUs: \d+`))
+			Ω(output).Should(ContainSubstring("Parallel Processes: 2"))
+		})
+
+		It("does not emit the environment when the suite passes", func() {
+			fm.MountFixture("passing_ginkgo_tests")
+			session := startGinkgo(fm.PathTo("passing_ginkgo_tests"), "--no-color", "--procs=2", "--emit-environment-on-failure")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(string(session.Out.Contents())).ShouldNot(ContainSubstring("Suite Environment"))
+		})
+	})
+
 	Describe("when the tests are incorrectly structured", func() {
 		BeforeEach(func() {
 			fm.MountFixture("malformed")
@@ -1,12 +1,15 @@
 package integration_test
 
 import (
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/gexec"
 
 	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
 )
 
 var _ = Describe("Filter", func() {
@@ -87,6 +90,41 @@ var _ = Describe("Filter", func() {
 		Ω(session).Should(gbytes.Say("Invalid File Filter"))
 	})
 
+	Describe("Running only previously flaked specs", func() {
+		It("runs only the specs that needed more than one attempt in the previous report", func() {
+			previous, err := json.Marshal([]types.Report{{
+				SuiteDescription: "FilterFixture Suite",
+				SpecReports: types.SpecReports{
+					{ContainerHierarchyTexts: []string{"WidgetA"}, LeafNodeText: "dog", NumAttempts: 2, MaxFlakeAttempts: 2, State: types.SpecStatePassed},
+					{ContainerHierarchyTexts: []string{"WidgetA"}, LeafNodeText: "dog fish", NumAttempts: 1, State: types.SpecStatePassed},
+					{ContainerHierarchyTexts: []string{"WidgetA"}, LeafNodeText: "a spec that no longer exists", NumAttempts: 3, MaxFlakeAttempts: 3, State: types.SpecStateFailed},
+				},
+			}})
+			Ω(err).ShouldNot(HaveOccurred())
+			fm.WriteFile("filter", "previous.json", string(previous))
+
+			session := startGinkgo(fm.PathTo("filter"), "--only-previously-flaked", "--input=previous.json", "--json-report=report.json")
+			Eventually(session).Should(gexec.Exit(0))
+			specs := Reports(fm.LoadJSONReports("filter", "report.json")[0].SpecReports)
+			Ω(specs.WithState(types.SpecStatePassed)).Should(HaveLen(1))
+			Ω(specs.FindByFullText("WidgetA dog")).Should(HavePassed())
+			Ω(specs.FindByFullText("WidgetA dog fish")).Should(HaveBeenSkipped())
+		})
+
+		It("exits without running anything when no specs flaked", func() {
+			fm.WriteFile("filter", "previous.json", `[{"SuiteDescription": "FilterFixture Suite", "SpecReports": []}]`)
+			session := startGinkgo(fm.PathTo("filter"), "--only-previously-flaked", "--input=previous.json")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say("No specs flaked in previous.json"))
+		})
+
+		It("errors if --input is missing", func() {
+			session := startGinkgo(fm.PathTo("filter"), "--only-previously-flaked")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("--only-previously-flaked requires --input"))
+		})
+	})
+
 	Describe("Listing labels", func() {
 		BeforeEach(func() {
 			fm.MountFixture("labels")
@@ -0,0 +1,34 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Matching output against golden files", func() {
+	BeforeEach(func() {
+		fm.MountFixture("golden")
+	})
+
+	It("fails when the golden file is missing or differs, and rewrites it with --update-golden", func() {
+		session := startGinkgo(fm.PathTo("golden"), "--no-color")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say("does not exist.  Run with --update-golden to create it."))
+
+		session = startGinkgo(fm.PathTo("golden"), "--no-color", "--update-golden")
+		Eventually(session).Should(gexec.Exit(0))
+		Ω(fm.ContentOf("golden", "testdata/greeting.golden")).Should(MatchRegexp(`^time=\d+\nhello\nworld\n$`))
+
+		session = startGinkgo(fm.PathTo("golden"), "--no-color")
+		Eventually(session).Should(gexec.Exit(0))
+
+		fm.WriteFile("golden", "testdata/greeting.golden", "hello\r\nthere\r\n")
+		session = startGinkgo(fm.PathTo("golden"), "--no-color")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say(`First difference is at line 2 of the golden file \(line 3 of the output\):`))
+		Ω(session).Should(gbytes.Say(`expected: "there"`))
+		Ω(session).Should(gbytes.Say(`actual:   "world"`))
+	})
+})
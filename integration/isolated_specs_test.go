@@ -0,0 +1,78 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("Isolating specs in subprocesses", func() {
+	BeforeEach(func() {
+		fm.MountFixture("isolated_specs")
+	})
+
+	Describe("when specs crash the process", func() {
+		var session *gexec.Session
+		BeforeEach(func() {
+			session = startGinkgo(fm.PathTo("isolated_specs"), "--no-color", "--isolate-specs-in-subprocess", "--json-report=out.json")
+			Eventually(session).Should(gexec.Exit(1))
+		})
+
+		It("reports the crashing specs as aborted, along with their output, and runs the remaining specs", func() {
+			report := fm.LoadJSONReports("isolated_specs", "out.json")[0]
+			Ω(report.SuiteSucceeded).Should(BeFalse())
+			Ω(report.SuiteConfig.IsolateSpecsInSubprocess).Should(BeTrue())
+			Ω(report.SpecialSuiteFailureReasons).Should(ContainElement(reporters.CRASH_FAILURE_REASON))
+
+			specs := Reports(report.SpecReports.WithLeafNodeType(types.NodeTypeIt))
+			Ω(specs).Should(HaveLen(9))
+			Ω(specs.Find("passes before the crashes")).Should(HavePassed())
+			Ω(specs.Find("exits")).Should(HaveAborted(reporters.CRASH_FAILURE_MESSAGE))
+			Ω(specs.Find("exits").CapturedStdOutErr).Should(ContainSubstring("about to exit"))
+			Ω(specs.Find("panics in a goroutine")).Should(HaveAborted(reporters.CRASH_FAILURE_MESSAGE))
+			Ω(specs.Find("panics in a goroutine").CapturedStdOutErr).Should(ContainSubstring("panic: kaboom"))
+			Ω(specs.Find("passes after the crashes")).Should(HavePassed())
+			Ω(specs.Find("fails")).Should(HaveFailed("a normal failure"))
+			Ω(specs.Find("exits in an ordered container")).Should(HaveAborted(reporters.CRASH_FAILURE_MESSAGE))
+			Ω(specs.Find("sets up state")).Should(HavePassed())
+			Ω(specs.Find("relies on the state set up by the previous spec")).Should(HavePassed())
+			Ω(specs.Find("is pending")).Should(BePending())
+
+			Ω(report.SpecReports.WithLeafNodeType(types.NodeTypeBeforeSuite)).Should(HaveLen(1))
+			Ω(report.SpecReports.WithLeafNodeType(types.NodeTypeAfterSuite)).Should(HaveLen(1))
+		})
+
+		It("emits the outcome of every spec", func() {
+			output := string(session.Out.Contents())
+			Ω(output).Should(ContainSubstring("Running Suite: IsolatedSpecsFixture Suite"))
+			Ω(output).Should(ContainSubstring("[ABORTED] " + reporters.CRASH_FAILURE_MESSAGE))
+			Ω(output).Should(ContainSubstring("Output from the subprocess that crashed:"))
+			Ω(output).Should(ContainSubstring("panic: kaboom"))
+			Ω(output).Should(ContainSubstring("[FAIL] isolated specs [It] fails"))
+			Ω(output).Should(MatchRegexp(`Ran 8 of 9 Specs in \d+\.\d+ seconds`))
+			Ω(output).Should(ContainSubstring("4 Passed | 4 Failed | 1 Pending | 0 Skipped"))
+		})
+	})
+
+	It("only runs the specs that match the suite's filters", func() {
+		session := startGinkgo(fm.PathTo("isolated_specs"), "--no-color", "--isolate-specs-in-subprocess", "--focus=passes", "--json-report=out.json")
+		Eventually(session).Should(gexec.Exit(0))
+
+		specs := Reports(fm.LoadJSONReports("isolated_specs", "out.json")[0].SpecReports)
+		Ω(specs.Find("passes before the crashes")).Should(HavePassed())
+		Ω(specs.Find("passes after the crashes")).Should(HavePassed())
+		Ω(specs.Find("exits")).Should(HaveBeenSkipped())
+		Ω(specs.Find("panics in a goroutine")).Should(HaveBeenSkipped())
+	})
+
+	It("cannot be combined with running in parallel", func() {
+		session := startGinkgo(fm.PathTo("isolated_specs"), "--no-color", "--isolate-specs-in-subprocess", "--procs=2")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session.Err).Should(gbytes.Say("Ginkgo only isolates specs in subprocesses in serial mode."))
+	})
+})
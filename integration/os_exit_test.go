@@ -0,0 +1,40 @@
+package integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("When a spec calls os.Exit", func() {
+	var session *gexec.Session
+	BeforeEach(func() {
+		fm.MountFixture("os_exit")
+		session = startGinkgo(fm.PathTo("os_exit"), "--no-color", "--json-report=out.json", "--junit-report=out.xml", "--partial-reports-on-crash")
+		Eventually(session).Should(gexec.Exit(1))
+	})
+
+	It("does not run any subsequent specs", func() {
+		Ω(string(session.Out.Contents())).ShouldNot(ContainSubstring("SHOULD NOT SEE THIS"))
+	})
+
+	It("writes a partial report that identifies the spec that was running", func() {
+		report := fm.LoadJSONReports("os_exit", "out.json")[0]
+		Ω(report.SuiteSucceeded).Should(BeFalse())
+		Ω(report.SpecialSuiteFailureReasons).Should(ContainElement(reporters.CRASH_FAILURE_REASON))
+		Ω(report.SpecReports).Should(HaveLen(1))
+		Ω(report.SpecReports[0]).Should(HaveAborted(reporters.CRASH_FAILURE_MESSAGE))
+		Ω(report.SpecReports[0].LeafNodeText).Should(Equal("exits"))
+
+		junitSuites := fm.LoadJUnitReport("os_exit", "out.xml")
+		cases := junitSuites.TestSuites[0].TestCases
+		Ω(cases).Should(HaveLen(1))
+		Ω(cases[0].Name).Should(Equal("[It] top-level container exits"))
+		Ω(cases[0].Status).Should(Equal(types.SpecStateAborted.String()))
+		Ω(cases[0].Failure.Message).Should(Equal(reporters.CRASH_FAILURE_MESSAGE))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/gexec"
@@ -50,6 +51,48 @@ var _ = Describe("OutputInterceptor", func() {
 		})
 	})
 
+	Context("disabling capture for a spec with NoCapture", func() {
+		BeforeEach(func() {
+			fm.MountFixture("no_capture")
+		})
+
+		It("writes the NoCapture spec's output straight to stdout", func() {
+			sess := startGinkgo(fm.PathTo("no_capture"), "--no-color", "--procs=2", "--json-report=report.json")
+			Eventually(sess).Should(gexec.Exit(0))
+
+			output := string(sess.Out.Contents())
+			Ω(output).Should(ContainSubstring("STRAIGHT TO STDOUT"))
+
+			specs := Reports(fm.LoadJSONReports("no_capture", "report.json")[0].SpecReports)
+			Ω(specs.Find("is captured").CapturedStdOutErr).Should(Equal("INTERCEPTED OUTPUT\n"))
+			Ω(specs.Find("is captured").ReportEntries[0].GetRawValue()).Should(BeTrue())
+			Ω(specs.Find("is not captured").CapturedStdOutErr).Should(BeEmpty())
+			Ω(specs.Find("is not captured").ReportEntries[0].GetRawValue()).Should(BeFalse())
+		})
+	})
+
+	Context("checking whether output is captured", func() {
+		BeforeEach(func() {
+			fm.MountFixture("output_is_captured")
+		})
+
+		outputIsCaptured := func(args ...string) bool {
+			sess := startGinkgo(fm.PathTo("output_is_captured"), append([]string{"--no-color", "--json-report=report.json"}, args...)...)
+			Eventually(sess).Should(gexec.Exit(0))
+			report := fm.LoadJSONReports("output_is_captured", "report.json")[0]
+			return report.SpecReports[0].ReportEntries[0].GetRawValue().(bool)
+		}
+
+		It("is true when output is being captured", func() {
+			Ω(outputIsCaptured("--procs=2")).Should(BeTrue())
+		})
+
+		It("is false when output is not being captured", func() {
+			Ω(outputIsCaptured()).Should(BeFalse())
+			Ω(outputIsCaptured("--procs=2", "--output-interceptor-mode=none")).Should(BeFalse())
+		})
+	})
+
 	Context("ensuring Ginkgo does not hang when a child process does not exit: https://github.com/onsi/ginkgo/issues/1191", func() {
 		BeforeEach(func() {
 			fm.MountFixture("interceptor_sleep")
@@ -208,6 +208,50 @@ var _ = Describe("Profiling Specs", func() {
 		})
 	})
 
+	Describe("recording per-spec coverage", func() {
+		BeforeEach(func() {
+			fm.MountFixture("per_spec_coverage")
+		})
+
+		Context("when the specs run in a binary built with go build -cover", func() {
+			BeforeEach(func() {
+				cmd := exec.Command("go", "build", "-cover", "-covermode=atomic", "-coverpkg=.,./calculator", "-o", "per_spec_coverage")
+				cmd.Dir = fm.PathTo("per_spec_coverage")
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Ω(err).ShouldNot(HaveOccurred())
+				Eventually(session).Should(gexec.Exit(0))
+			})
+
+			It("records the files covered by each spec", func() {
+				cmd := exec.Command("./per_spec_coverage", "--ginkgo.no-color", "--ginkgo.per-spec-coverage", "--ginkgo.json-report=report.json")
+				cmd.Dir = fm.PathTo("per_spec_coverage")
+				cmd.Env = append(os.Environ(), "GOCOVERDIR="+fm.PathTo("per_spec_coverage"))
+				session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+				Ω(err).ShouldNot(HaveOccurred())
+				Eventually(session).Should(gexec.Exit(0))
+
+				report := fm.LoadJSONReports("per_spec_coverage", "report.json")[0]
+				coveredFiles := map[string][]string{}
+				for _, specReport := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+					coveredFiles[specReport.LeafNodeText] = specReport.CoveredFiles
+				}
+				pkg := fm.PackageNameFor("per_spec_coverage")
+				Ω(coveredFiles).Should(Equal(map[string][]string{
+					"adds":       {pkg + "/calculator/adder.go", pkg + "/main.go"},
+					"multiplies": {pkg + "/calculator/multiplier.go", pkg + "/main.go"},
+				}))
+			})
+		})
+
+		Context("when the coverage runtime does not expose coverage data mid-run", func() {
+			It("fails the suite", func() {
+				session := startGinkgo(fm.PathTo("per_spec_coverage"), "--no-color", "--covermode=atomic", "--per-spec-coverage")
+				Eventually(session).Should(gexec.Exit(1))
+				Ω(session.Out).Should(gbytes.Say("Failed to record per-spec coverage"))
+			})
+		})
+	})
+
 	Describe("measuring cpu, memory, block, and mutex profiles", func() {
 		BeforeEach(func() {
 			fm.MountFixture("profile")
@@ -1,8 +1,10 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -173,7 +175,8 @@ var _ = Describe("Reporting", func() {
 			Ω(specReports.Find("times out and fails during cleanup").AdditionalFailures[0].Failure.FailureNodeType).Should(Equal(types.NodeTypeCleanupAfterEach))
 			Ω(specReports.FindByLeafNodeType(types.NodeTypeReportBeforeSuite)).Should(HavePassed())
 			Ω(specReports.Find("my report")).Should(HaveFailed("fail!", types.FailureNodeIsLeafNode, types.NodeTypeReportAfterSuite))
-			Ω(specReports.FindByLeafNodeType(types.NodeTypeBeforeSuite)).Should(HavePassed())
+			Ω(specReports.FindByLeafNodeType(types.NodeTypeBeforeSuite)).Should(HavePassed(CapturedGinkgoWriterOutput("creating the report files\n")))
+			Ω(specReports.FindByLeafNodeType(types.NodeTypeBeforeSuite).RunTime).ShouldNot(BeZero())
 			Ω(specReports.FindByLeafNodeType(types.NodeTypeCleanupAfterSuite)).Should(HavePassed())
 
 			//check that progress reporst are correctly embedded
@@ -451,6 +454,49 @@ var _ = Describe("Reporting", func() {
 			})
 		})
 
+		Context("with -json-report=-", func() {
+			loadStdoutReports := func(session *gexec.Session) []types.Report {
+				reports := []types.Report{}
+				Ω(json.Unmarshal(session.Out.Contents(), &reports)).Should(Succeed())
+				return reports
+			}
+
+			It("writes the single unified json report to stdout and everything else to stderr", func() {
+				session := startGinkgo(fm.PathTo("reporting"), "--no-color", "-r", "--keep-going", "--procs=2", "--json-report=-", "-seed=17")
+				Eventually(session).Should(gexec.Exit(1))
+
+				reports := loadStdoutReports(session)
+				Ω(reports).Should(HaveLen(3))
+				checkJSONReport(reports[0])
+				checkJSONFailedCompilationReport(reports[1])
+				checkJSONSubpackageReport(reports[2])
+
+				Ω(session.Err).Should(gbytes.Say("Ginkgo ran 4 suites"))
+				Ω(session.Err).Should(gbytes.Say("Test Suite Failed"))
+				Ω(fm.PathTo("reporting", "-")).ShouldNot(BeAnExistingFile())
+				matches, err := filepath.Glob(fm.PathTo("reporting", "ginkgo-stdout-report-*"))
+				Ω(err).ShouldNot(HaveOccurred())
+				Ω(matches).Should(BeEmpty())
+			})
+
+			It("keeps the output of serial suites out of stdout", func() {
+				session := startGinkgo(fm.PathTo("reporting"), "--no-color", "--json-report=-", "-seed=17")
+				Eventually(session).Should(gexec.Exit(1))
+
+				reports := loadStdoutReports(session)
+				Ω(reports).Should(HaveLen(1))
+				Ω(reports[0].SuiteDescription).Should(Equal("ReportingFixture Suite"))
+				Ω(reports[0].SpecReports).ShouldNot(BeEmpty())
+				Ω(session.Err).Should(gbytes.Say("ReportingFixture Suite"))
+			})
+
+			It("refuses to run with -keep-separate-reports", func() {
+				session := startGinkgo(fm.PathTo("reporting"), "--no-color", "--json-report=-", "--keep-separate-reports")
+				Eventually(session).Should(gexec.Exit(1))
+				Ω(session.Err).Should(gbytes.Say("--json-report=- and --keep-separate-reports are both set"))
+			})
+		})
+
 		Context("when keep-going is not set and a suite fails", func() {
 			BeforeEach(func() {
 				session := startGinkgo(fm.PathTo("reporting"), "--no-color", "-r", "--procs=2", "--json-report=out.json", "--junit-report=out.xml", "--teamcity-report=out.tc", "-coverprofile=cover.out", "-cpuprofile=cpu.out", "-seed=17", "--output-dir=./reports")
@@ -468,3 +514,47 @@ var _ = Describe("Reporting", func() {
 		})
 	})
 })
+
+var _ = Describe("Reporting with a ReporterSet", func() {
+	BeforeEach(func() {
+		fm.MountFixture("reporter_set")
+	})
+
+	It("generates every report in the set and forwards events to custom reporters", func() {
+		session := startGinkgo(fm.PathTo("reporter_set"), "--no-color")
+		Eventually(session).Should(gexec.Exit(1))
+
+		report := fm.LoadJSONReports("reporter_set", "out.json")[0]
+		specReports := Reports(report.SpecReports)
+		Ω(specReports.Find("passes")).Should(HavePassed())
+		Ω(specReports.Find("fails")).Should(HaveFailed("boom"))
+		Ω(specReports.Find("is pending")).Should(BePending())
+
+		junitReport := fm.LoadJUnitReport("reporter_set", "out.xml")
+		Ω(junitReport.TestSuites).Should(HaveLen(1))
+		Ω(junitReport.TestSuites[0].Tests).Should(Equal(3))
+		Ω(junitReport.TestSuites[0].Failures).Should(Equal(1))
+
+		Ω(fm.ContentOf("reporter_set", "custom-reporter-1.out")).Should(Equal("3"))
+	})
+})
+
+var _ = Describe("Reporting with a ComboReporter", func() {
+	BeforeEach(func() {
+		fm.MountFixture("combo_reporter")
+	})
+
+	It("writes a single JSON report and summary aggregated across parallel processes", func() {
+		session := startGinkgo(fm.PathTo("combo_reporter"), "--no-color", "--procs=2")
+		Eventually(session).Should(gexec.Exit(1))
+
+		report := fm.LoadJSONReports("combo_reporter", "combo.json")[0]
+		specReports := Reports(report.SpecReports.WithLeafNodeType(types.NodeTypeIt))
+		Ω(specReports).Should(HaveLen(9))
+		Ω(specReports.Find("fails")).Should(HaveFailed("boom"))
+
+		Ω(fm.ContentOf("combo_reporter", "combo-1.out")).Should(ContainSubstring("Ran 9 of 9 Specs"))
+		Ω(fm.ContentOf("combo_reporter", "combo-1.out")).Should(ContainSubstring("8 Passed | 1 Failed"))
+		Ω(fm.ContentOf("combo_reporter", "combo-2.out")).ShouldNot(ContainSubstring("Ran "))
+	})
+})
@@ -0,0 +1,33 @@
+package integration_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("The reporter config in effect", func() {
+	BeforeEach(func() {
+		fm.MountFixture("resolved_reporter_config")
+	})
+
+	DescribeTable("is available via GinkgoConfiguration and is recorded in the report",
+		func(args ...string) {
+			cmd := ginkgoCommand(fm.PathTo("resolved_reporter_config"), append([]string{"--no-color", "-v", "--json-report=out.json"}, args...)...)
+			cmd.Env = append(os.Environ(), "GINKGO_CI=true")
+			session, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Ω(err).ShouldNot(HaveOccurred())
+			Eventually(session).Should(gexec.Exit(0))
+
+			report := fm.LoadJSONReports("resolved_reporter_config", "out.json")[0]
+			Ω(report.ReporterConfig.Verbosity()).Should(Equal(types.VerbosityLevelSuccinct))
+			Ω(report.ReporterConfig.NoColor).Should(BeTrue())
+			Ω(report.ReporterConfig.CIOverrides).Should(BeNil())
+		},
+		Entry("in series"),
+		Entry("in parallel", "--procs=2"),
+	)
+})
@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Emitting a spec manifest", func() {
+	BeforeEach(func() {
+		fm.MountFixture("spec_manifest")
+	})
+
+	It("writes a manifest of every spec in the runtime spec tree, including table entries generated at runtime", func() {
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--dry-run", "--emit-spec-manifest=manifest.json")
+		Eventually(session).Should(gexec.Exit(0))
+
+		data, err := os.ReadFile(fm.PathTo("spec_manifest", "manifest.json"))
+		Ω(err).ShouldNot(HaveOccurred())
+		var manifest types.SpecManifest
+		Ω(json.Unmarshal(data, &manifest)).Should(Succeed())
+
+		Ω(manifest.SuiteDescription).Should(Equal("SpecManifestFixture Suite"))
+		texts := []string{}
+		for _, spec := range manifest.Specs {
+			texts = append(texts, spec.FullText)
+			Ω(spec.ID).ShouldNot(BeEmpty())
+			Ω(spec.Labels).Should(Equal([]string{"math"}))
+			Ω(spec.Location.FileName).Should(HaveSuffix("spec_manifest_fixture_test.go"))
+			Ω(spec.Pending).Should(Equal(spec.LeafNodeText == "can divide"))
+			Ω(spec.WillRun).Should(Equal(spec.LeafNodeText != "can divide"))
+		}
+		Ω(texts).Should(ConsistOf(
+			"arithmetic can add",
+			"arithmetic can divide",
+			"arithmetic generated additions adds 1 + 1",
+			"arithmetic generated additions adds 2 + 2",
+			"arithmetic generated additions adds 3 + 3",
+		))
+
+		By("generating the same IDs on subsequent runs")
+		session = startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--dry-run", "--emit-spec-manifest=manifest.json", "--seed=17", "--randomize-all")
+		Eventually(session).Should(gexec.Exit(0))
+		data, err = os.ReadFile(fm.PathTo("spec_manifest", "manifest.json"))
+		Ω(err).ShouldNot(HaveOccurred())
+		var rerun types.SpecManifest
+		Ω(json.Unmarshal(data, &rerun)).Should(Succeed())
+		Ω(rerun.Specs).Should(Equal(manifest.Specs))
+
+		By("finding specs that the AST-based outline misses")
+		session = startGinkgo(fm.PathTo("spec_manifest"), "outline", "--format=json", "spec_manifest_fixture_test.go")
+		Eventually(session).Should(gexec.Exit(0))
+		Ω(string(session.Out.Contents())).ShouldNot(ContainSubstring("adds 2 + 2"))
+	})
+
+	It("requires --dry-run", func() {
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--emit-spec-manifest=manifest.json")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say("--emit-spec-manifest requires --dry-run"))
+		Ω(fm.PathTo("spec_manifest", "manifest.json")).ShouldNot(BeAnExistingFile())
+	})
+})
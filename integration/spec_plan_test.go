@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Emitting a spec plan", func() {
+	BeforeEach(func() {
+		fm.MountFixture("spec_manifest")
+	})
+
+	loadPlan := func() types.SpecPlan {
+		data, err := os.ReadFile(fm.PathTo("spec_manifest", "plan.json"))
+		Ω(err).ShouldNot(HaveOccurred())
+		var plan types.SpecPlan
+		Ω(json.Unmarshal(data, &plan)).Should(Succeed())
+		return plan
+	}
+
+	It("lists the specs that will run, in order, honoring the filters", func() {
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--dry-run", "--plan-output=plan.json", "--focus=generated", "--skip=2 \\+ 2")
+		Eventually(session).Should(gexec.Exit(0))
+
+		plan := loadPlan()
+		Ω(plan.SuiteDescription).Should(Equal("SpecManifestFixture Suite"))
+		Ω(plan.EstimatedDuration).Should(BeZero())
+		texts := []string{}
+		for _, spec := range plan.Specs {
+			texts = append(texts, spec.FullText)
+			Ω(spec.ID).ShouldNot(BeEmpty())
+			Ω(spec.Labels).Should(Equal([]string{"math"}))
+			Ω(spec.Location.FileName).Should(HaveSuffix("spec_manifest_fixture_test.go"))
+			Ω(spec.EstimatedDuration).Should(BeZero())
+		}
+		Ω(texts).Should(Equal([]string{
+			"arithmetic generated additions adds 1 + 1",
+			"arithmetic generated additions adds 3 + 3",
+		}))
+
+		By("using IDs that --focus-spec-id accepts")
+		session = startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--focus-spec-id="+plan.Specs[1].ID, "--json-report=report.json")
+		Eventually(session).Should(gexec.Exit(0))
+		reports := fm.LoadJSONReports("spec_manifest", "report.json")
+		ran := reports[0].SpecReports.WithLeafNodeType(types.NodeTypeIt).WithState(types.SpecStatePassed)
+		Ω(ran).Should(HaveLen(1))
+		Ω(ran[0].FullText()).Should(Equal("arithmetic generated additions adds 3 + 3"))
+	})
+
+	It("includes estimated durations when given a timing baseline", func() {
+		baseline, err := json.Marshal([]types.Report{{
+			SuiteDescription: "SpecManifestFixture Suite",
+			SpecReports: types.SpecReports{
+				{ContainerHierarchyTexts: []string{"arithmetic"}, LeafNodeText: "can add", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, RunTime: 2 * time.Second},
+				{ContainerHierarchyTexts: []string{"arithmetic", "generated additions"}, LeafNodeText: "adds 1 + 1", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, RunTime: 3 * time.Second},
+			},
+		}})
+		Ω(err).ShouldNot(HaveOccurred())
+		fm.WriteFile("spec_manifest", "baseline.json", string(baseline))
+
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--dry-run", "--plan-output=plan.json", "--timing-baseline="+fm.AbsPathTo("spec_manifest", "baseline.json"))
+		Eventually(session).Should(gexec.Exit(0))
+
+		plan := loadPlan()
+		durations := map[string]time.Duration{}
+		for _, spec := range plan.Specs {
+			durations[spec.FullText] = spec.EstimatedDuration
+		}
+		Ω(durations).Should(Equal(map[string]time.Duration{
+			"arithmetic can add":                        2 * time.Second,
+			"arithmetic generated additions adds 1 + 1": 3 * time.Second,
+			"arithmetic generated additions adds 2 + 2": 0,
+			"arithmetic generated additions adds 3 + 3": 0,
+		}))
+		Ω(plan.EstimatedDuration).Should(Equal(5 * time.Second))
+	})
+
+	It("requires --dry-run", func() {
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--plan-output=plan.json")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say("--plan-output requires --dry-run"))
+		Ω(fm.PathTo("spec_manifest", "plan.json")).ShouldNot(BeAnExistingFile())
+	})
+
+	It("fails when the timing baseline cannot be loaded", func() {
+		session := startGinkgo(fm.PathTo("spec_manifest"), "--no-color", "--dry-run", "--plan-output=plan.json", "--timing-baseline=missing.json")
+		Eventually(session).Should(gexec.Exit(1))
+		Ω(session).Should(gbytes.Say("Failed to load timing baseline"))
+	})
+})
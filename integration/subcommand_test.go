@@ -1,12 +1,15 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/types"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 	"github.com/onsi/gomega/gexec"
 )
 
@@ -78,6 +81,37 @@ var _ = Describe("Subcommand", func() {
 			Eventually(session).Should(gexec.Exit(0))
 		})
 
+		It("should pass suite labels and a JUnit report to RunSpecs when told to", func() {
+			session := startGinkgo(fm.PathTo(pkg), "bootstrap", "--labels", "unit, integration", "--with-junit")
+			Eventually(session).Should(gexec.Exit(0))
+
+			content := fm.ContentOf(pkg, "foo_suite_test.go")
+			Ω(content).Should(ContainSubstring("\t" + `"github.com/onsi/ginkgo/v2/reporters"`))
+			Ω(content).Should(ContainSubstring(`RunSpecs(t, "Foo Suite", Label("unit", "integration"), reporters.ReporterSet{JUnitReport: "junit_report.xml"})`))
+
+			fm.WriteFile(pkg, "foo_test.go", "package foo_test\nimport . \"github.com/onsi/ginkgo/v2\"\nvar _ = It(\"works\", func() {})\n")
+			session = startGinkgo(fm.PathTo(pkg), "--label-filter=integration")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say("Ran 1 of 1 Specs"))
+			Ω(fm.PathTo(pkg, "junit_report.xml")).Should(BeAnExistingFile())
+		})
+
+		It("should pass suite labels to RunSpecs without dot-importing when told to", func() {
+			session := startGinkgo(fm.PathTo(pkg), "bootstrap", "--labels", "unit", "--nodot")
+			Eventually(session).Should(gexec.Exit(0))
+
+			content := fm.ContentOf(pkg, "foo_suite_test.go")
+			Ω(content).Should(ContainSubstring(`ginkgo.RunSpecs(t, "Foo Suite", ginkgo.Label("unit"))`))
+			Ω(content).ShouldNot(ContainSubstring("reporters"))
+		})
+
+		It("should refuse to generate a bootstrap file with invalid labels", func() {
+			session := startGinkgo(fm.PathTo(pkg), "bootstrap", "--labels", "unit,,integration")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("Invalid label"))
+			Ω(fm.PathTo(pkg, "foo_suite_test.go")).ShouldNot(BeAnExistingFile())
+		})
+
 		It("should generate a bootstrap file using a template when told to", func() {
 			fm.WriteFile(pkg, ".bootstrap", `package {{.Package}}
 
@@ -257,6 +291,16 @@ var _ = Describe("Subcommand", func() {
 			})
 		})
 
+		Context("with the labels argument", func() {
+			It("should decorate the top-level container with the labels", func() {
+				session := startGinkgo(fm.PathTo(pkg), "generate", "--labels", "unit,integration")
+				Eventually(session).Should(gexec.Exit(0))
+
+				content := fm.ContentOf(pkg, "foo_bar_test.go")
+				Ω(content).Should(ContainSubstring(`var _ = Describe("FooBar", Label("unit", "integration"), func() {`))
+			})
+		})
+
 		Context("with template argument", func() {
 			It("should generate a test file using a template", func() {
 				fm.WriteFile(pkg, ".generate", `package {{.Package}}
@@ -641,6 +685,117 @@ var _ = Describe("Subcommand", func() {
 		})
 	})
 
+	Describe("ginkgo report-diff", func() {
+		BeforeEach(func() {
+			fm.MkEmpty("reports")
+			writeReports := func(name string, reports []types.Report) {
+				data, err := json.Marshal(reports)
+				Ω(err).ShouldNot(HaveOccurred())
+				fm.WriteFile("reports", name, string(data))
+			}
+			spec := func(text string, state types.SpecState, runTime time.Duration) types.SpecReport {
+				return types.SpecReport{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: text, State: state, RunTime: runTime}
+			}
+			writeReports("old.json", []types.Report{
+				{SuiteDescription: "Checkout Suite", SpecReports: types.SpecReports{
+					spec("breaks", types.SpecStatePassed, time.Second),
+					spec("gets fixed", types.SpecStateFailed, time.Second),
+					spec("gets slower", types.SpecStatePassed, time.Second),
+					spec("is deleted", types.SpecStatePassed, time.Second),
+				}},
+				{SuiteDescription: "Unchanged Suite", SpecReports: types.SpecReports{spec("works", types.SpecStatePassed, time.Second)}},
+			})
+			writeReports("new.json", []types.Report{
+				{SuiteDescription: "Checkout Suite", SpecReports: types.SpecReports{
+					spec("breaks", types.SpecStateFailed, time.Second),
+					spec("gets fixed", types.SpecStatePassed, time.Second),
+					spec("gets slower", types.SpecStatePassed, 3*time.Second),
+					spec("is new", types.SpecStatePassed, time.Second),
+				}},
+				{SuiteDescription: "Unchanged Suite", SpecReports: types.SpecReports{spec("works", types.SpecStatePassed, time.Second)}},
+			})
+		})
+
+		It("lists each category of change for each suite", func() {
+			session := startGinkgo(fm.PathTo("reports"), "report-diff", "--no-color", "--timing-threshold=1s", "old.json", "new.json")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say(`Checkout Suite\n`))
+			Ω(session).Should(gbytes.Say(`Newly Failing \(1\)\n\s+cart breaks passed → failed`))
+			Ω(session).Should(gbytes.Say(`Newly Passing \(1\)\n\s+cart gets fixed failed → passed`))
+			Ω(session).Should(gbytes.Say(`Added \(1\)\n\s+cart is new passed`))
+			Ω(session).Should(gbytes.Say(`Removed \(1\)\n\s+cart is deleted passed`))
+			Ω(session).Should(gbytes.Say(`Timing Changed \(1\)\n\s+cart gets slower 1s → 3s \(\+2s\)`))
+			Ω(session).Should(gbytes.Say(`Unchanged Suite\n\s+No differences`))
+		})
+
+		It("can emit the diff as JSON", func() {
+			session := startGinkgo(fm.PathTo("reports"), "report-diff", "--format=json", "old.json", "new.json")
+			Eventually(session).Should(gexec.Exit(0))
+			diffs := []types.ReportDiff{}
+			Ω(json.Unmarshal(session.Out.Contents(), &diffs)).Should(Succeed())
+			Ω(diffs).Should(HaveLen(2))
+			Ω(diffs[0].NewlyFailing[0].FullText).Should(Equal("cart breaks"))
+			Ω(diffs[0].TimingChanged).Should(BeEmpty())
+			Ω(diffs[1].HasChanges()).Should(BeFalse())
+		})
+
+		It("errors when not given two reports", func() {
+			session := startGinkgo(fm.PathTo("reports"), "report-diff", "old.json")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("report-diff expects exactly two arguments"))
+		})
+	})
+
+	Describe("ginkgo list-slow", func() {
+		BeforeEach(func() {
+			fm.MkEmpty("reports")
+			spec := func(text string, state types.SpecState, runTime time.Duration) types.SpecReport {
+				return types.SpecReport{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: text, State: state, RunTime: runTime}
+			}
+			data, err := json.Marshal([]types.Report{
+				{SuiteDescription: "Checkout Suite", SpecReports: types.SpecReports{
+					spec("is quick", types.SpecStatePassed, 100*time.Millisecond),
+					spec("is slow", types.SpecStatePassed, 2*time.Second),
+					spec("is slowest", types.SpecStateFailed, 3500*time.Millisecond),
+				}},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			fm.WriteFile("reports", "report.json", string(data))
+		})
+
+		It("lists the specs that exceed the threshold, slowest first", func() {
+			session := startGinkgo(fm.PathTo("reports"), "list-slow", "--no-color", "--threshold=1s", "report.json")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say(`Specs that took longer than 1s \(2\)\n`))
+			Ω(session).Should(gbytes.Say(`3.5s cart is slowest \[Checkout Suite\]`))
+			Ω(session).Should(gbytes.Say(`2s cart is slow \[Checkout Suite\]`))
+			Ω(session.Out.Contents()).ShouldNot(ContainSubstring("is quick"))
+		})
+
+		It("can emit the list as JSON", func() {
+			session := startGinkgo(fm.PathTo("reports"), "list-slow", "--json", "--threshold=50ms", "report.json")
+			Eventually(session).Should(gexec.Exit(0))
+			slowSpecs := []types.SlowSpec{}
+			Ω(json.Unmarshal(session.Out.Contents(), &slowSpecs)).Should(Succeed())
+			Ω(slowSpecs).Should(HaveLen(3))
+			Ω(slowSpecs[0].FullText).Should(Equal("cart is slowest"))
+			Ω(slowSpecs[0].State).Should(Equal(types.SpecStateFailed))
+			Ω(slowSpecs[2].FullText).Should(Equal("cart is quick"))
+		})
+
+		It("lets the user know when no specs exceed the threshold", func() {
+			session := startGinkgo(fm.PathTo("reports"), "list-slow", "--no-color", "--threshold=1m", "report.json")
+			Eventually(session).Should(gexec.Exit(0))
+			Ω(session).Should(gbytes.Say(`No specs took longer than 1m0s`))
+		})
+
+		It("errors when not given a report", func() {
+			session := startGinkgo(fm.PathTo("reports"), "list-slow")
+			Eventually(session).Should(gexec.Exit(1))
+			Ω(session.Err).Should(gbytes.Say("list-slow expects exactly one argument"))
+		})
+	})
+
 	Describe("ginkgo help", func() {
 		It("should print out usage information", func() {
 			session := startGinkgo("", "help")
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package internal
+
+// availableMemory is not supported on this platform
+func availableMemory() uint64 {
+	return 0
+}
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+const DEFAULT_BENCHMARK_DURATION = time.Second
+const MAX_AUTOSCALED_BENCHMARK_ITERATIONS = 10000
+
+type BenchmarkIterations uint
+type BenchmarkDuration time.Duration
+type BenchmarkMaxMean time.Duration
+
+type BenchmarkConfig struct {
+	Iterations int
+	Duration   time.Duration
+	MaxMean    time.Duration
+}
+
+// ExtractBenchmarkConfig pulls the Benchmark-specific decorators out of args and returns the resulting BenchmarkConfig along with the remaining args
+func ExtractBenchmarkConfig(args []interface{}) (BenchmarkConfig, []interface{}) {
+	config := BenchmarkConfig{Duration: DEFAULT_BENCHMARK_DURATION}
+	remainingArgs := []interface{}{}
+	for _, arg := range args {
+		switch x := arg.(type) {
+		case BenchmarkIterations:
+			config.Iterations = int(x)
+		case BenchmarkDuration:
+			config.Duration = time.Duration(x)
+		case BenchmarkMaxMean:
+			config.MaxMean = time.Duration(x)
+		default:
+			remainingArgs = append(remainingArgs, arg)
+		}
+	}
+	return config, remainingArgs
+}
+
+/*
+GinkgoBenchmark is passed to the body of a Benchmark spec.  The body is invoked once per iteration and each invocation is timed independently.
+*/
+type GinkgoBenchmark struct {
+	iteration int
+	running   bool
+	start     time.Time
+	elapsed   time.Duration
+}
+
+// Iteration returns the index of the current iteration, starting at 0
+func (b *GinkgoBenchmark) Iteration() int {
+	return b.iteration
+}
+
+// StopTimer pauses timing of the current iteration.  Use it to exclude per-iteration setup from the recorded sample.
+func (b *GinkgoBenchmark) StopTimer() {
+	if b.running {
+		b.elapsed += time.Since(b.start)
+		b.running = false
+	}
+}
+
+// StartTimer resumes timing of the current iteration after a call to StopTimer
+func (b *GinkgoBenchmark) StartTimer() {
+	if !b.running {
+		b.start = time.Now()
+		b.running = true
+	}
+}
+
+func (b *GinkgoBenchmark) runIteration(iteration int, body func(*GinkgoBenchmark)) time.Duration {
+	b.iteration, b.elapsed, b.running = iteration, 0, false
+	b.StartTimer()
+	body(b)
+	b.StopTimer()
+	return b.elapsed
+}
+
+/*
+RunBenchmark invokes body repeatedly and returns the resulting statistics.
+
+If config.Iterations is set body is invoked exactly that many times.  Otherwise the number of iterations is auto-scaled: body is invoked until config.Duration has elapsed or MAX_AUTOSCALED_BENCHMARK_ITERATIONS samples have been collected.
+*/
+func RunBenchmark(body func(*GinkgoBenchmark), config BenchmarkConfig) types.BenchmarkStats {
+	b := &GinkgoBenchmark{}
+	samples := []time.Duration{}
+	if config.Iterations > 0 {
+		for i := 0; i < config.Iterations; i++ {
+			samples = append(samples, b.runIteration(i, body))
+		}
+		return types.NewBenchmarkStats(samples)
+	}
+
+	start := time.Now()
+	for i := 0; i < MAX_AUTOSCALED_BENCHMARK_ITERATIONS; i++ {
+		samples = append(samples, b.runIteration(i, body))
+		if time.Since(start) >= config.Duration {
+			break
+		}
+	}
+	return types.NewBenchmarkStats(samples)
+}
@@ -0,0 +1,19 @@
+package internal
+
+import "time"
+
+/*
+Clock is the source of the current time for the Suite.  The Suite uses it to timestamp spec and suite reports, timeline events, and progress reports and to compute spec run times and deadlines.
+
+The Suite uses RealClock by default.  Tests can substitute a fake Clock via Suite.SetClock to make timing-sensitive behavior deterministic.  Note that the timers that drive timeouts, grace periods, and progress polling always run in real time.
+*/
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock is a Clock backed by time.Now()
+type RealClock struct{}
+
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// CaptureSuiteEnvironment returns a SuiteEnvironment describing the current process.  Only the environment variables named in allowlist are recorded (types.DefaultEnvironmentAllowlist is used if allowlist is empty).
+func CaptureSuiteEnvironment(allowlist []string) types.SuiteEnvironment {
+	if len(allowlist) == 0 {
+		allowlist = types.DefaultEnvironmentAllowlist
+	}
+	environment := types.SuiteEnvironment{
+		GoVersion:       runtime.Version(),
+		GOOS:            runtime.GOOS,
+		GOARCH:          runtime.GOARCH,
+		GOMAXPROCS:      runtime.GOMAXPROCS(0),
+		NumCPU:          runtime.NumCPU(),
+		AvailableMemory: availableMemory(),
+	}
+	for _, name := range allowlist {
+		if value, ok := os.LookupEnv(name); ok {
+			if environment.EnvironmentVariables == nil {
+				environment.EnvironmentVariables = map[string]string{}
+			}
+			environment.EnvironmentVariables[name] = value
+		}
+	}
+	return environment
+}
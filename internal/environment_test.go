@@ -0,0 +1,42 @@
+package internal_test
+
+import (
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CaptureSuiteEnvironment", func() {
+	It("captures facts about the current process", func() {
+		environment := internal.CaptureSuiteEnvironment(nil)
+		Ω(environment.GoVersion).Should(Equal(runtime.Version()))
+		Ω(environment.GOOS).Should(Equal(runtime.GOOS))
+		Ω(environment.GOARCH).Should(Equal(runtime.GOARCH))
+		Ω(environment.GOMAXPROCS).Should(Equal(runtime.GOMAXPROCS(0)))
+		Ω(environment.NumCPU).Should(Equal(runtime.NumCPU()))
+		if runtime.GOOS == "linux" {
+			Ω(environment.AvailableMemory).Should(BeNumerically(">", 0))
+		}
+	})
+
+	It("only captures allowlisted environment variables", func() {
+		GinkgoT().Setenv("GINKGO_TEST_ALLOWED", "yes")
+		GinkgoT().Setenv("GINKGO_TEST_SECRET", "hunter2")
+		environment := internal.CaptureSuiteEnvironment([]string{"GINKGO_TEST_ALLOWED", "GINKGO_TEST_UNSET"})
+		Ω(environment.EnvironmentVariables).Should(Equal(map[string]string{"GINKGO_TEST_ALLOWED": "yes"}))
+	})
+
+	It("uses the default allowlist when none is provided", func() {
+		GinkgoT().Setenv("GINKGO_TEST_SECRET", "hunter2")
+		GinkgoT().Setenv("GOFLAGS", "-count=1")
+		environment := internal.CaptureSuiteEnvironment(nil)
+		Ω(environment.EnvironmentVariables).Should(HaveKeyWithValue("GOFLAGS", "-count=1"))
+		Ω(environment.EnvironmentVariables).ShouldNot(HaveKey("GINKGO_TEST_SECRET"))
+		for name := range environment.EnvironmentVariables {
+			Ω(types.DefaultEnvironmentAllowlist).Should(ContainElement(name))
+		}
+	})
+})
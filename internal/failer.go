@@ -11,6 +11,12 @@ type Failer struct {
 	lock    *sync.Mutex
 	failure types.Failure
 	state   types.SpecState
+
+	// once a failure has been recorded by FailSoft the node keeps running and any subsequent failures are accumulated in additionalFailures rather than dropped.
+	// hardFailed tracks whether the node has since ended with Fail, Skip, or AbortSuite - so that the panic those emit is not mistaken for a user panic.
+	softFailed         bool
+	hardFailed         bool
+	additionalFailures []types.AdditionalFailure
 }
 
 func NewFailer() *Failer {
@@ -36,17 +42,38 @@ func (f *Failer) Panic(location types.CodeLocation, forwardedPanic interface{})
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
+	failure := types.Failure{
+		Message:        "Test Panicked",
+		Location:       location,
+		ForwardedPanic: fmt.Sprintf("%v", forwardedPanic),
+		PanicValue:     types.NewPanicValue(forwardedPanic),
+	}
 	if f.state == types.SpecStatePassed {
 		f.state = types.SpecStatePanicked
+		f.failure = failure
+	} else if f.softFailed && !f.hardFailed {
+		f.additionalFailures = append(f.additionalFailures, types.AdditionalFailure{State: types.SpecStatePanicked, Failure: failure})
+	}
+}
+
+func (f *Failer) Fail(message string, location types.CodeLocation) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.state == types.SpecStatePassed {
+		f.state = types.SpecStateFailed
 		f.failure = types.Failure{
-			Message:        "Test Panicked",
-			Location:       location,
-			ForwardedPanic: fmt.Sprintf("%v", forwardedPanic),
+			Message:  message,
+			Location: location,
 		}
+	} else if f.softFailed && !f.hardFailed {
+		f.additionalFailures = append(f.additionalFailures, types.AdditionalFailure{State: types.SpecStateFailed, Failure: types.Failure{Message: message, Location: location}})
 	}
+	f.hardFailed = true
 }
 
-func (f *Failer) Fail(message string, location types.CodeLocation) {
+// FailSoft records a failure but, unlike Fail, is not followed by a panic and so does not end the running node.  The first failure recorded by the node is its failure, subsequent failures are accumulated as additional failures.
+func (f *Failer) FailSoft(message string, location types.CodeLocation) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
@@ -56,6 +83,9 @@ func (f *Failer) Fail(message string, location types.CodeLocation) {
 			Message:  message,
 			Location: location,
 		}
+		f.softFailed = true
+	} else if f.softFailed {
+		f.additionalFailures = append(f.additionalFailures, types.AdditionalFailure{State: types.SpecStateFailed, Failure: types.Failure{Message: message, Location: location}})
 	}
 }
 
@@ -63,6 +93,7 @@ func (f *Failer) Skip(message string, location types.CodeLocation) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
+	f.hardFailed = true
 	if f.state == types.SpecStatePassed {
 		f.state = types.SpecStateSkipped
 		f.failure = types.Failure{
@@ -76,24 +107,40 @@ func (f *Failer) AbortSuite(message string, location types.CodeLocation) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
+	abort := types.Failure{
+		Message:  message,
+		Location: location,
+	}
 	if f.state == types.SpecStatePassed {
 		f.state = types.SpecStateAborted
-		f.failure = types.Failure{
-			Message:  message,
-			Location: location,
-		}
+		f.failure = abort
+	} else if f.softFailed && !f.hardFailed {
+		// the suite must still abort so the abort takes precedence over the soft failures recorded so far
+		f.additionalFailures = append([]types.AdditionalFailure{{State: f.state, Failure: f.failure}}, f.additionalFailures...)
+		f.state = types.SpecStateAborted
+		f.failure = abort
 	}
+	f.hardFailed = true
 }
 
 func (f *Failer) Drain() (types.SpecState, types.Failure) {
+	outcome, failure, _ := f.DrainWithAdditionalFailures()
+	return outcome, failure
+}
+
+// DrainWithAdditionalFailures is like Drain but also returns the failures accumulated after a failure recorded by FailSoft
+func (f *Failer) DrainWithAdditionalFailures() (types.SpecState, types.Failure, []types.AdditionalFailure) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
 	failure := f.failure
 	outcome := f.state
+	additionalFailures := f.additionalFailures
 
 	f.state = types.SpecStatePassed
 	f.failure = types.Failure{}
+	f.softFailed, f.hardFailed = false, false
+	f.additionalFailures = nil
 
-	return outcome, failure
+	return outcome, failure, additionalFailures
 }
@@ -1,6 +1,9 @@
 package internal_test
 
 import (
+	"encoding/json"
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/internal"
 	"github.com/onsi/ginkgo/v2/types"
@@ -114,6 +117,59 @@ var _ = Describe("Failer", func() {
 		})
 	})
 
+	Describe("when told of a soft failure", func() {
+		BeforeEach(func() {
+			failer.FailSoft("something failed", clA)
+		})
+
+		It("records the failure", func() {
+			state, failure, additionalFailures := failer.DrainWithAdditionalFailures()
+			Ω(state).Should(Equal(types.SpecStateFailed))
+			Ω(failure).Should(Equal(types.Failure{Message: "something failed", Location: clA}))
+			Ω(additionalFailures).Should(BeEmpty())
+		})
+
+		It("accumulates subsequent failures, including the hard failure that ends the node, but not the panic that Fail emits", func() {
+			failer.FailSoft("something else failed", clB)
+			failer.Fail("something failed hard", clA)
+			failer.Panic(clA, "panic emitted by Fail")
+
+			state, failure, additionalFailures := failer.DrainWithAdditionalFailures()
+			Ω(state).Should(Equal(types.SpecStateFailed))
+			Ω(failure).Should(Equal(types.Failure{Message: "something failed", Location: clA}))
+			Ω(additionalFailures).Should(Equal([]types.AdditionalFailure{
+				{State: types.SpecStateFailed, Failure: types.Failure{Message: "something else failed", Location: clB}},
+				{State: types.SpecStateFailed, Failure: types.Failure{Message: "something failed hard", Location: clA}},
+			}))
+		})
+
+		It("records a subsequent panic", func() {
+			failer.Panic(clB, 17)
+			_, _, additionalFailures := failer.DrainWithAdditionalFailures()
+			Ω(additionalFailures).Should(HaveLen(1))
+			Ω(additionalFailures[0].State).Should(Equal(types.SpecStatePanicked))
+			Ω(additionalFailures[0].Failure.ForwardedPanic).Should(Equal("17"))
+		})
+
+		It("lets a subsequent abort take precedence", func() {
+			failer.AbortSuite("something aborted", clB)
+			state, failure, additionalFailures := failer.DrainWithAdditionalFailures()
+			Ω(state).Should(Equal(types.SpecStateAborted))
+			Ω(failure).Should(Equal(types.Failure{Message: "something aborted", Location: clB}))
+			Ω(additionalFailures).Should(Equal([]types.AdditionalFailure{
+				{State: types.SpecStateFailed, Failure: types.Failure{Message: "something failed", Location: clA}},
+			}))
+		})
+
+		It("resets when drained", func() {
+			failer.Drain()
+			failer.Fail("something failed", clA)
+			failer.Fail("something else failed", clB)
+			_, _, additionalFailures := failer.DrainWithAdditionalFailures()
+			Ω(additionalFailures).Should(BeEmpty())
+		})
+	})
+
 	Describe("when told to panic", func() {
 		BeforeEach(func() {
 			failer.Panic(clA, 17)
@@ -126,6 +182,7 @@ var _ = Describe("Failer", func() {
 				Message:        "Test Panicked",
 				Location:       clA,
 				ForwardedPanic: "17",
+				PanicValue:     &types.PanicValue{Type: "int", Message: "17", JSON: json.RawMessage("17")},
 			}))
 		})
 
@@ -139,11 +196,46 @@ var _ = Describe("Failer", func() {
 					Message:        "Test Panicked",
 					Location:       clA,
 					ForwardedPanic: "17",
+					PanicValue:     &types.PanicValue{Type: "int", Message: "17", JSON: json.RawMessage("17")},
 				}))
 			})
 		})
 	})
 
+	Describe("when told to panic with an error", func() {
+		It("records the error's type, message, JSON representation, and unwrapped chain alongside the forwarded panic", func() {
+			cause := &validationError{Field: "title", Reason: "is required"}
+			failer.Panic(clA, fmt.Errorf("saving book: %w", cause))
+
+			state, failure := failer.Drain()
+			Ω(state).Should(Equal(types.SpecStatePanicked))
+			Ω(failure.ForwardedPanic).Should(Equal("saving book: title is required"))
+			Ω(failure.PanicValue).Should(Equal(&types.PanicValue{
+				Type:       "*fmt.wrapError",
+				Message:    "saving book: title is required",
+				JSON:       json.RawMessage("{}"),
+				ErrorChain: []types.PanicValueError{{Type: "*internal_test.validationError", Message: "title is required"}},
+			}))
+		})
+
+		It("records the JSON representation of errors that can be encoded", func() {
+			failer.Panic(clA, &validationError{Field: "title", Reason: "is required"})
+
+			_, failure := failer.Drain()
+			Ω(failure.PanicValue.Type).Should(Equal("*internal_test.validationError"))
+			Ω(failure.PanicValue.JSON).Should(MatchJSON(`{"Field":"title","Reason":"is required"}`))
+			Ω(failure.PanicValue.ErrorChain).Should(BeEmpty())
+		})
+
+		It("leaves the JSON representation empty when the value cannot be encoded", func() {
+			failer.Panic(clA, func() {})
+
+			_, failure := failer.Drain()
+			Ω(failure.PanicValue.Type).Should(Equal("func()"))
+			Ω(failure.PanicValue.JSON).Should(BeEmpty())
+		})
+	})
+
 	Context("when drained", func() {
 		BeforeEach(func() {
 			failer.Fail("something failed", clA)
@@ -168,3 +260,12 @@ var _ = Describe("Failer", func() {
 		})
 	})
 })
+
+type validationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *validationError) Error() string {
+	return e.Field + " " + e.Reason
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"fmt"
+)
+
+// SetFeatureChecker registers the function Ginkgo consults to decide whether specs decorated with RequireFeature can run.  Registering a new checker replaces the previous one.
+func (suite *Suite) SetFeatureChecker(checker func(feature string) bool) {
+	suite.featureChecker = checker
+}
+
+/*
+featureSkipReason returns the reason a spec that requires features should be skipped - or "" if it should run.
+
+The feature checker is consulted each time a spec decorated with RequireFeature is about to run so that it can reflect changes in the environment.  Nothing is checked during a dry run.  If no checker has been registered every feature is considered off.
+*/
+func (suite *Suite) featureSkipReason(features []string) string {
+	if len(features) == 0 || suite.config.DryRun {
+		return ""
+	}
+	for _, feature := range features {
+		if suite.featureChecker == nil {
+			return fmt.Sprintf("Spec skipped because it requires feature \"%s\" and no feature checker has been registered.  Call SetFeatureChecker before RunSpecs.", feature)
+		}
+		if !suite.featureChecker(feature) {
+			return fmt.Sprintf("Spec skipped because it requires feature \"%s\" and the feature is off", feature)
+		}
+	}
+	return ""
+}
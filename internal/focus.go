@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"hash/fnv"
 	"regexp"
 	"strings"
 
@@ -106,6 +107,20 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteLabels Labels, suit
 		skipChecks = append(skipChecks, func(spec Spec) bool { return re.MatchString(description + " " + spec.Text()) })
 	}
 
+	if len(suiteConfig.FocusSpecIDs) > 0 {
+		// skip specs whose ID was not requested
+		focusSpecIDs := map[string]bool{}
+		for _, id := range suiteConfig.FocusSpecIDs {
+			focusSpecIDs[id] = true
+		}
+		skipChecks = append(skipChecks, func(spec Spec) bool { return !focusSpecIDs[spec.ID] })
+	}
+
+	if suiteConfig.ShardCount > 1 {
+		// skip specs that belong to other shards.  membership is independent of the other skip checks so it is equivalent to sharding the specs that remain after filtering
+		skipChecks = append(skipChecks, func(spec Spec) bool { return ShardForSpec(spec, suiteConfig.ShardCount) != suiteConfig.ShardIndex })
+	}
+
 	// skip specs if shouldSkip() is true.  note that we do nothing if shouldSkip() is false to avoid overwriting skip status established by the node's pending status
 	processedSpecs := Specs{}
 	for _, spec := range specs {
@@ -120,3 +135,19 @@ func ApplyFocusToSpecs(specs Specs, description string, suiteLabels Labels, suit
 
 	return processedSpecs, hasProgrammaticFocus
 }
+
+/*
+ShardForSpec returns the shard (in [0, shardCount)) that the spec belongs to.
+
+Membership is computed from a stable hash of the spec's container and subject texts so that a spec lands in the same shard across runs and machines.
+Specs in an Ordered container are hashed using the texts up to and including the outermost Ordered container so that the container's specs are never split across shards.
+*/
+func ShardForSpec(spec Spec, shardCount int) int {
+	nodes := spec.Nodes.WithType(types.NodeTypeContainer | types.NodeTypeIt)
+	if idx := nodes.IndexOfFirstNodeMarkedOrdered(); idx >= 0 {
+		nodes = nodes[:idx+1]
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.Join(nodes.Texts(), "\x00")))
+	return int(hash.Sum32() % uint32(shardCount))
+}
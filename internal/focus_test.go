@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -233,6 +235,25 @@ var _ = Describe("Focus", func() {
 			})
 		})
 
+		Context("when configured to focus spec IDs", func() {
+			BeforeEach(func() {
+				specs = Specs{
+					{Nodes: Nodes{N("dragon")}, ID: "a"},          //include because "a" is in FocusSpecIDs
+					{Nodes: Nodes{N("dragon")}, ID: "b"},          //skip because "b" is not in FocusSpecIDs
+					{Nodes: Nodes{N("dragon", Pending)}, ID: "c"}, //skip because spec is flagged pending
+					{Nodes: Nodes{N("dragon")}, ID: "d"},          //include because "d" is in FocusSpecIDs
+				}
+
+				conf.FocusSpecIDs = []string{"a", "c", "d"}
+			})
+
+			It("only runs the specs with matching IDs", func() {
+				specs, hasProgrammaticFocus := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+				Ω(harvestSkips(specs)).Should(Equal([]bool{false, true, true, false}))
+				Ω(hasProgrammaticFocus).Should(BeFalse())
+			})
+		})
+
 		Context("when configured with a label filter", func() {
 			BeforeEach(func() {
 				conf.LabelFilter = "(cat || cow) && !fish"
@@ -269,6 +290,53 @@ var _ = Describe("Focus", func() {
 			})
 		})
 
+		Context("when configured to shard specs", func() {
+			BeforeEach(func() {
+				specs = Specs{}
+				for i := 0; i < 30; i++ {
+					specs = append(specs, S(N(ntCon, "container"), N(fmt.Sprintf("spec %d", i))))
+				}
+				ordered := N(ntCon, "ordered container", Ordered)
+				for i := 0; i < 5; i++ {
+					specs = append(specs, S(N(ntCon, "container"), ordered, N(fmt.Sprintf("ordered spec %d", i))))
+				}
+				specs = append(specs, S(N(ntCon, "container"), N("pending spec", Pending)))
+				specs = append(specs, S(N(ntCon, "container"), N("unlabelled spec")))
+				conf.ShardCount = 3
+				conf.LabelFilter = "!fruit"
+			})
+
+			It("splits the specs that would otherwise run into disjoint shards that, together, cover every spec", func() {
+				runCounts := make([]int, len(specs))
+				for shardIndex := 0; shardIndex < 3; shardIndex++ {
+					conf.ShardIndex = shardIndex
+					shardedSpecs, _ := internal.ApplyFocusToSpecs(specs, description, suiteLabels, conf)
+					ranInShard := 0
+					for i, skip := range harvestSkips(shardedSpecs) {
+						if !skip {
+							runCounts[i] += 1
+							ranInShard += 1
+						}
+					}
+					Ω(ranInShard).Should(BeNumerically(">", 0), "shard %d should not be empty", shardIndex)
+				}
+				for i := range specs {
+					if specs[i].Nodes.HasNodeMarkedPending() {
+						Ω(runCounts[i]).Should(Equal(0))
+					} else {
+						Ω(runCounts[i]).Should(Equal(1), "spec %d should run in exactly one shard", i)
+					}
+				}
+			})
+
+			It("keeps the specs in an Ordered container in the same shard", func() {
+				shard := internal.ShardForSpec(specs[30], 3)
+				for i := 31; i < 35; i++ {
+					Ω(internal.ShardForSpec(specs[i], 3)).Should(Equal(shard))
+				}
+			})
+		})
+
 		Context("when configured with focus/skip files, focus/skip strings, and label filters", func() {
 			BeforeEach(func() {
 				specs = Specs{
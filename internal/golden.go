@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type goldenLine struct {
+	text   string
+	number int
+}
+
+/*
+MatchGolden compares output against the contents of the golden file at path and returns a description of the first difference, or an empty string if they match.
+
+Line endings are normalized before comparing and lines that match any of ignorePatterns (regular expressions) are dropped from both the output and the golden file - use these for volatile lines such as timestamps.
+
+If update is set the golden file (and any missing parent directories) is written with output instead and MatchGolden always reports a match.
+*/
+func MatchGolden(output string, path string, update bool, ignorePatterns []string) (string, error) {
+	ignore := []*regexp.Regexp{}
+	for _, pattern := range ignorePatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid ignore pattern %q: %w", pattern, err)
+		}
+		ignore = append(ignore, re)
+	}
+	output = normalizeGoldenLineEndings(output)
+
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return "", err
+		}
+		return "", os.WriteFile(path, []byte(output), 0644)
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Sprintf("Golden file %s does not exist.  Run with --update-golden to create it.", path), nil
+	} else if err != nil {
+		return "", err
+	}
+
+	expected, actual := goldenLines(normalizeGoldenLineEndings(string(content)), ignore), goldenLines(output, ignore)
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		if i < len(expected) && i < len(actual) && expected[i].text == actual[i].text {
+			continue
+		}
+		message := fmt.Sprintf("Output does not match golden file %s.  Run with --update-golden to update it.\n", path)
+		switch {
+		case i >= len(expected):
+			message += fmt.Sprintf("Output has unexpected additional lines, starting at line %d:\n  %q", actual[i].number, actual[i].text)
+		case i >= len(actual):
+			message += fmt.Sprintf("Output is missing lines, starting with line %d of the golden file:\n  %q", expected[i].number, expected[i].text)
+		default:
+			message += fmt.Sprintf("First difference is at line %d of the golden file (line %d of the output):\n  expected: %q\n  actual:   %q", expected[i].number, actual[i].number, expected[i].text, actual[i].text)
+		}
+		return message, nil
+	}
+	return "", nil
+}
+
+func normalizeGoldenLineEndings(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
+
+// goldenLines splits s into lines, dropping lines that match any of the ignore patterns.  A trailing newline does not produce an additional, empty, line.
+func goldenLines(s string, ignore []*regexp.Regexp) []goldenLine {
+	lines := []goldenLine{}
+	if s == "" {
+		return lines
+	}
+	for i, text := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		ignored := false
+		for _, re := range ignore {
+			if re.MatchString(text) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			lines = append(lines, goldenLine{text: text, number: i + 1})
+		}
+	}
+	return lines
+}
@@ -0,0 +1,89 @@
+package internal_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/internal"
+)
+
+var _ = Describe("MatchGolden", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "output.golden")
+	})
+
+	Context("when the output matches the golden file", func() {
+		It("reports a match, normalizing line endings", func() {
+			Ω(os.WriteFile(path, []byte("hello\r\nworld\r\n"), 0644)).Should(Succeed())
+			message, err := internal.MatchGolden("hello\nworld\n", path, false, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(BeEmpty())
+		})
+
+		It("ignores lines that match the ignore patterns", func() {
+			Ω(os.WriteFile(path, []byte("time=10:00\nhello\n"), 0644)).Should(Succeed())
+			message, err := internal.MatchGolden("time=11:30\nhello\ntime=11:31\n", path, false, []string{`^time=`})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(BeEmpty())
+		})
+	})
+
+	Context("when the output does not match the golden file", func() {
+		BeforeEach(func() {
+			Ω(os.WriteFile(path, []byte("time=10:00\nhello\nworld\n"), 0644)).Should(Succeed())
+		})
+
+		It("describes the first difference", func() {
+			message, err := internal.MatchGolden("time=11:30\nhello\nthere\n", path, false, []string{`^time=`})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(ContainSubstring("Output does not match golden file " + path))
+			Ω(message).Should(ContainSubstring("--update-golden"))
+			Ω(message).Should(ContainSubstring("First difference is at line 3 of the golden file (line 3 of the output):\n  expected: \"world\"\n  actual:   \"there\""))
+		})
+
+		It("describes missing lines", func() {
+			message, err := internal.MatchGolden("hello\n", path, false, []string{`^time=`})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(ContainSubstring("Output is missing lines, starting with line 3 of the golden file:\n  \"world\""))
+		})
+
+		It("describes additional lines", func() {
+			message, err := internal.MatchGolden("hello\nworld\nagain\n", path, false, []string{`^time=`})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(ContainSubstring("Output has unexpected additional lines, starting at line 3:\n  \"again\""))
+		})
+	})
+
+	Context("when the golden file does not exist", func() {
+		It("reports a mismatch that suggests creating it", func() {
+			message, err := internal.MatchGolden("hello\n", path, false, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(Equal("Golden file " + path + " does not exist.  Run with --update-golden to create it."))
+		})
+	})
+
+	Context("when updating", func() {
+		It("rewrites the golden file with the normalized output, creating any missing directories, and reports a match", func() {
+			path = filepath.Join(filepath.Dir(path), "testdata", "output.golden")
+			message, err := internal.MatchGolden("hello\r\nthere\r\n", path, true, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(BeEmpty())
+			Ω(os.ReadFile(path)).Should(Equal([]byte("hello\nthere\n")))
+
+			message, err = internal.MatchGolden("goodbye\n", path, true, nil)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(message).Should(BeEmpty())
+			Ω(os.ReadFile(path)).Should(Equal([]byte("goodbye\n")))
+		})
+	})
+
+	It("errors when an ignore pattern is invalid", func() {
+		_, err := internal.MatchGolden("hello\n", path, false, []string{"("})
+		Ω(err).Should(MatchError(ContainSubstring(`invalid ignore pattern "("`)))
+	})
+})
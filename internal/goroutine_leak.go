@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var goroutineLeakPollingInterval = 10 * time.Millisecond
+
+// goroutines managed by Ginkgo itself (e.g. the output interceptor's pipe factory, or nodes that Ginkgo has already reported as leaked after a timeout) are never considered leaks.
+// This is matched against the goroutine's entry and creator frames only: a goroutine launched by a spec that happens to be blocked inside Ginkgo (e.g. writing to the GinkgoWriter) is still a leak.
+var ginkgoInternalGoroutine = regexp.MustCompile(`^github\.com/onsi/ginkgo/v2/internal\.`)
+
+// goroutineLeakDetector snapshots the set of running goroutines before a spec and reports any new goroutines that are still running after the spec ends
+type goroutineLeakDetector struct {
+	settleTime time.Duration
+	allowlist  []*regexp.Regexp
+}
+
+func newGoroutineLeakDetector(settleTime time.Duration, allowlist []string) goroutineLeakDetector {
+	detector := goroutineLeakDetector{settleTime: settleTime}
+	for _, pattern := range allowlist {
+		// invalid patterns are caught by types.VetConfig
+		if re, err := regexp.Compile(pattern); err == nil {
+			detector.allowlist = append(detector.allowlist, re)
+		}
+	}
+	return detector
+}
+
+func (d goroutineLeakDetector) snapshot() map[uint64]bool {
+	goroutines, err := extractRunningGoroutines()
+	if err != nil {
+		return nil
+	}
+	out := map[uint64]bool{}
+	for _, goroutine := range goroutines {
+		out[goroutine.ID] = true
+	}
+	return out
+}
+
+// leakedGoroutines returns the goroutines that are not in the baseline snapshot and do not match the allowlist.
+// Goroutines are given up to settleTime to exit.
+func (d goroutineLeakDetector) leakedGoroutines(baseline map[uint64]bool) []types.Goroutine {
+	if baseline == nil {
+		return nil
+	}
+	deadline := time.Now().Add(d.settleTime)
+	for {
+		goroutines, err := extractRunningGoroutines()
+		if err != nil {
+			return nil
+		}
+		leaked := []types.Goroutine{}
+		for _, goroutine := range goroutines {
+			if !baseline[goroutine.ID] && !d.isAllowed(goroutine) {
+				leaked = append(leaked, goroutine)
+			}
+		}
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return leaked
+		}
+		time.Sleep(goroutineLeakPollingInterval)
+	}
+}
+
+func (d goroutineLeakDetector) isAllowed(goroutine types.Goroutine) bool {
+	// the last two frames are the function the goroutine was launched with and the 'created by' frame identifying the function that launched it
+	entryIdx := len(goroutine.Stack) - 2
+	if entryIdx < 0 {
+		entryIdx = 0
+	}
+	for _, functionCall := range goroutine.Stack[entryIdx:] {
+		if ginkgoInternalGoroutine.MatchString(functionCall.Function) {
+			return true
+		}
+	}
+	for _, re := range d.allowlist {
+		for _, functionCall := range goroutine.Stack {
+			if re.MatchString(functionCall.Function) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func goroutineLeakFailureMessage(leaked []types.Goroutine) string {
+	out := &strings.Builder{}
+	if len(leaked) == 1 {
+		fmt.Fprintf(out, "Spec leaked 1 goroutine:\n")
+	} else {
+		fmt.Fprintf(out, "Spec leaked %d goroutines:\n", len(leaked))
+	}
+	for _, goroutine := range leaked {
+		fmt.Fprintf(out, "\ngoroutine %d [%s]\n", goroutine.ID, goroutine.State)
+		for _, functionCall := range goroutine.Stack {
+			fmt.Fprintf(out, "  %s\n    %s:%d\n", functionCall.Function, functionCall.Filename, functionCall.Line)
+		}
+	}
+	return out.String()
+}
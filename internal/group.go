@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/onsi/ginkgo/v2/types"
@@ -94,6 +95,13 @@ type group struct {
 	runOncePairs   map[uint]runOncePairs
 	runOnceTracker map[runOncePair]types.SpecState
 
+	// skipReasons tracks specs that are skipped because they are placeholders registered with SkipRegistration, because they are decorated with OnlyOn or SkipOn and should not run on this platform, with RequiresNetwork while the network is unreachable, or with RequireFeature while the feature is off
+	skipReasons map[uint]string
+
+	// prerequisites tracks the specs each spec depends on via DependsOn, and specStates the outcome of each spec that has run - both are keyed by the spec's SubjectID
+	prerequisites map[uint]Specs
+	specStates    map[uint]types.SpecState
+
 	succeeded              bool
 	failedInARunOnceBefore bool
 	continueOnFailure      bool
@@ -104,6 +112,9 @@ func newGroup(suite *Suite) *group {
 		suite:                  suite,
 		runOncePairs:           map[uint]runOncePairs{},
 		runOnceTracker:         map[runOncePair]types.SpecState{},
+		skipReasons:            map[uint]string{},
+		prerequisites:          map[uint]Specs{},
+		specStates:             map[uint]types.SpecState{},
 		succeeded:              true,
 		failedInARunOnceBefore: false,
 		continueOnFailure:      false,
@@ -111,7 +122,17 @@ func newGroup(suite *Suite) *group {
 }
 
 func (g *group) initialReportForSpec(spec Spec) types.SpecReport {
+	report := specReportForSpec(spec)
+	report.ParallelProcess = g.suite.config.ParallelProcess
+	report.RunningInParallel = g.suite.isRunningInParallel()
+	report.Index = g.suite.numSpecsStarted
+	return report
+}
+
+// specReportForSpec returns a SpecReport that describes spec before it has run
+func specReportForSpec(spec Spec) types.SpecReport {
 	return types.SpecReport{
+		ID:                          spec.ID,
 		ContainerHierarchyTexts:     spec.Nodes.WithType(types.NodeTypeContainer).Texts(),
 		ContainerHierarchyLocations: spec.Nodes.WithType(types.NodeTypeContainer).CodeLocations(),
 		ContainerHierarchyLabels:    spec.Nodes.WithType(types.NodeTypeContainer).Labels(),
@@ -119,12 +140,35 @@ func (g *group) initialReportForSpec(spec Spec) types.SpecReport {
 		LeafNodeType:                types.NodeTypeIt,
 		LeafNodeText:                spec.FirstNodeWithType(types.NodeTypeIt).Text,
 		LeafNodeLabels:              []string(spec.FirstNodeWithType(types.NodeTypeIt).Labels),
-		ParallelProcess:             g.suite.config.ParallelProcess,
-		RunningInParallel:           g.suite.isRunningInParallel(),
 		IsSerial:                    spec.Nodes.HasNodeMarkedSerial(),
 		IsInOrderedContainer:        !spec.Nodes.FirstNodeMarkedOrdered().IsZero(),
+		OrderedContainerID:          spec.Nodes.FirstNodeMarkedOrdered().ID,
+		IsFocused:                   spec.Nodes.HasNodeMarkedFocus(),
+		IsInformational:             spec.Nodes.HasNodeMarkedInformational(),
+		IsVerbose:                   spec.Nodes.HasNodeMarkedVerbose(),
 		MaxFlakeAttempts:            spec.Nodes.GetMaxFlakeAttempts(),
 		MaxMustPassRepeatedly:       spec.Nodes.GetMaxMustPassRepeatedly(),
+		MaxRepeat:                   spec.Nodes.GetMaxRepeat(),
+		ExpectedDuration:            spec.Nodes.GetExpectedDuration(),
+	}
+}
+
+/*
+applyRepeatFailures sets the outcome of a spec decorated with Repeat.  If any iteration failed the spec fails with the first failure encountered (or, if the spec was ultimately interrupted or aborted, with that final failure).
+The remaining failures are recorded as additional failures.
+*/
+func (g *group) applyRepeatFailures(failures []types.AdditionalFailure) {
+	primary := 0
+	if g.suite.currentSpecReport.State.Is(types.SpecStateAborted | types.SpecStateInterrupted) {
+		primary = len(failures) - 1
+	}
+	g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = failures[primary].State, failures[primary].Failure
+	for i, af := range failures {
+		if i == primary {
+			continue
+		}
+		af.Failure.Message = fmt.Sprintf("Failure recorded during iteration %d:\n%s", af.Attempt, af.Failure.Message)
+		g.suite.currentSpecReport.AdditionalFailures = append(g.suite.currentSpecReport.AdditionalFailures, af)
 	}
 }
 
@@ -133,12 +177,15 @@ func (g *group) evaluateSkipStatus(spec Spec) (types.SpecState, types.Failure) {
 		return types.SpecStatePending, types.Failure{}
 	}
 	if spec.Skip {
+		if reason, ok := g.skipReasons[spec.SubjectID()]; ok {
+			return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt), reason)
+		}
 		return types.SpecStateSkipped, types.Failure{}
 	}
 	if g.suite.interruptHandler.Status().Interrupted() || g.suite.skipAll {
 		return types.SpecStateSkipped, types.Failure{}
 	}
-	if !g.suite.deadline.IsZero() && g.suite.deadline.Before(time.Now()) {
+	if !g.suite.deadline.IsZero() && g.suite.deadline.Before(g.suite.clock.Now()) {
 		return types.SpecStateSkipped, types.Failure{}
 	}
 	if !g.succeeded && !g.continueOnFailure {
@@ -149,6 +196,12 @@ func (g *group) evaluateSkipStatus(spec Spec) (types.SpecState, types.Failure) {
 		return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
 			"Spec skipped because a BeforeAll node failed")
 	}
+	for _, prerequisite := range g.prerequisites[spec.SubjectID()] {
+		if g.specStates[prerequisite.SubjectID()] != types.SpecStatePassed {
+			return types.SpecStateSkipped, g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt),
+				fmt.Sprintf("Spec skipped because it depends on \"%s\", which did not pass", prerequisite.FirstNodeWithType(types.NodeTypeIt).Text))
+		}
+	}
 	beforeOncePairs := g.runOncePairs[spec.SubjectID()].withType(types.NodeTypeBeforeAll | types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach)
 	for _, pair := range beforeOncePairs {
 		if g.runOnceTracker[pair].Is(types.SpecStateSkipped) {
@@ -180,15 +233,23 @@ func (g *group) attemptSpec(isFinalAttempt bool, spec Spec) bool {
 	failedInARunOnceBefore := false
 	pairs := g.runOncePairs[spec.SubjectID()]
 
-	nodes := spec.Nodes.WithType(types.NodeTypeBeforeAll)
-	nodes = append(nodes, spec.Nodes.WithType(types.NodeTypeBeforeEach)...).SortedByAscendingNestingLevel()
-	nodes = append(nodes, spec.Nodes.WithType(types.NodeTypeJustBeforeEach).SortedByAscendingNestingLevel()...)
+	injectors := g.suite.faultInjectorsFor(spec)
+	nodes := Nodes{}
+	for _, injector := range injectors {
+		if !injector.before.IsZero() {
+			nodes = append(nodes, injector.before)
+		}
+	}
+	nodes = append(nodes, spec.Nodes.WithType(types.NodeTypeBeforeAll)...)
+	nodes = append(nodes, spec.Nodes.WithType(types.NodeTypeBeforeEach)...).SortedByAscendingNestingLevel().WithOrderingHintsApplied()
+	nodes = append(nodes, spec.Nodes.WithType(types.NodeTypeJustBeforeEach).SortedByAscendingNestingLevel().WithOrderingHintsApplied()...)
 	nodes = append(nodes, spec.Nodes.FirstNodeWithType(types.NodeTypeIt))
 	terminatingNode, terminatingPair := Node{}, runOncePair{}
 
 	deadline := time.Time{}
-	if spec.SpecTimeout() > 0 {
-		deadline = time.Now().Add(spec.SpecTimeout())
+	g.suite.currentSpecTimeout = scaleTimeout(spec.SpecTimeout(), g.suite.timeoutMultiplier())
+	if g.suite.currentSpecTimeout > 0 {
+		deadline = g.suite.clock.Now().Add(g.suite.currentSpecTimeout)
 	}
 
 	for _, node := range nodes {
@@ -197,7 +258,7 @@ func (g *group) attemptSpec(isFinalAttempt bool, spec Spec) bool {
 			continue
 		}
 		g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = g.suite.runNode(node, deadline, spec.Nodes.BestTextFor(node))
-		g.suite.currentSpecReport.RunTime = time.Since(g.suite.currentSpecReport.StartTime)
+		g.suite.currentSpecReport.RunTime = g.suite.clock.Now().Sub(g.suite.currentSpecReport.StartTime)
 		if !oncePair.isZero() {
 			g.runOnceTracker[oncePair] = g.suite.currentSpecReport.State
 		}
@@ -283,45 +344,117 @@ func (g *group) attemptSpec(isFinalAttempt bool, spec Spec) bool {
 
 		for _, node := range nodes {
 			afterNodeWasRun[node.ID] = true
-			state, failure := g.suite.runNode(node, deadline, spec.Nodes.BestTextFor(node))
-			g.suite.currentSpecReport.RunTime = time.Since(g.suite.currentSpecReport.StartTime)
-			if g.suite.currentSpecReport.State == types.SpecStatePassed || state == types.SpecStateAborted {
-				g.suite.currentSpecReport.State = state
-				g.suite.currentSpecReport.Failure = failure
-			} else if state.Is(types.SpecStateFailureStates) {
-				g.suite.currentSpecReport.AdditionalFailures = append(g.suite.currentSpecReport.AdditionalFailures, types.AdditionalFailure{State: state, Failure: failure})
-			}
+			g.runAfterNode(node, deadline, spec.Nodes.BestTextFor(node))
 		}
 		includeDeferCleanups = true
 	}
 
+	// fault injectors unwind in reverse registration order.  injectors registered after one whose before function failed never ran and so are not unwound.
+	numInjectorsEntered := len(injectors)
+	for i, injector := range injectors {
+		if !terminatingNode.IsZero() && injector.before.ID == terminatingNode.ID {
+			numInjectorsEntered = i + 1
+			break
+		}
+	}
+	for i := numInjectorsEntered - 1; i >= 0; i-- {
+		if !injectors[i].after.IsZero() {
+			g.runAfterNode(injectors[i].after, deadline, "")
+		}
+	}
+
 	return failedInARunOnceBefore
 }
 
+// runAfterNode runs a teardown node and folds its outcome into the current spec report.  Failures in teardown nodes only replace the spec's failure if the spec was otherwise passing.
+func (g *group) runAfterNode(node Node, deadline time.Time, text string) {
+	numAdditionalFailures := len(g.suite.currentSpecReport.AdditionalFailures)
+	state, failure := g.suite.runNode(node, deadline, text)
+	g.suite.currentSpecReport.RunTime = g.suite.clock.Now().Sub(g.suite.currentSpecReport.StartTime)
+	if g.suite.currentSpecReport.State == types.SpecStatePassed || state == types.SpecStateAborted {
+		g.suite.currentSpecReport.State = state
+		g.suite.currentSpecReport.Failure = failure
+	} else if state.Is(types.SpecStateFailureStates) {
+		// the node's failure precedes any failures it recorded after calling FailSoft
+		additionalFailures := g.suite.currentSpecReport.AdditionalFailures
+		g.suite.currentSpecReport.AdditionalFailures = append(additionalFailures[:numAdditionalFailures:numAdditionalFailures], append([]types.AdditionalFailure{{State: state, Failure: failure}}, additionalFailures[numAdditionalFailures:]...)...)
+	}
+}
+
 func (g *group) run(specs Specs) {
 	g.specs = specs
-	g.continueOnFailure = specs[0].Nodes.FirstNodeMarkedOrdered().MarkedContinueOnFailure
-	for _, spec := range g.specs {
+	orderedContainer := specs[0].Nodes.FirstNodeMarkedOrdered()
+	// specs that are grouped because of DependsOn are otherwise independent: a failure only skips the specs that depend on the failed spec
+	g.continueOnFailure = orderedContainer.IsZero() || orderedContainer.MarkedContinueOnFailure
+	for idx, prerequisites := range specDependencies(specs) {
+		for _, prerequisite := range prerequisites {
+			g.prerequisites[specs[idx].SubjectID()] = append(g.prerequisites[specs[idx].SubjectID()], specs[prerequisite])
+		}
+	}
+	for idx, spec := range g.specs {
 		g.runOncePairs[spec.SubjectID()] = runOncePairsForSpec(spec)
+		// registration, platform, network, and feature skips are applied up front so that BeforeAll and AfterAll nodes treat these specs as if they had been filtered out
+		if spec.Skip {
+			continue
+		}
+		reason := spec.Nodes.RegistrationSkipReason()
+		if reason == "" {
+			reason = spec.Nodes.PlatformSkipReason(runtime.GOOS, runtime.GOARCH)
+		}
+		if reason == "" && spec.Nodes.HasNodeMarkedRequiresNetwork() {
+			reason = g.suite.networkSkipReason()
+		}
+		if reason == "" {
+			reason = g.suite.featureSkipReason(spec.Nodes.RequiredFeatures())
+		}
+		if reason != "" {
+			g.specs[idx].Skip = true
+			g.skipReasons[spec.SubjectID()] = reason
+		}
 	}
 
 	for _, spec := range g.specs {
 		g.suite.selectiveLock.Lock()
+		g.suite.numSpecsStarted += 1
 		g.suite.currentSpecReport = g.initialReportForSpec(spec)
 		g.suite.selectiveLock.Unlock()
 
 		g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = g.evaluateSkipStatus(spec)
 		g.suite.reporter.WillRun(g.suite.currentSpecReport)
+		g.suite.runSpecHooksBefore(g.suite.currentSpecReport)
 		g.suite.reportEach(spec, types.NodeTypeReportBeforeEach)
 
 		skip := g.suite.config.DryRun || g.suite.currentSpecReport.State.Is(types.SpecStateFailureStates|types.SpecStateSkipped|types.SpecStatePending)
 
-		g.suite.currentSpecReport.StartTime = time.Now()
+		g.suite.currentSpecReport.StartTime = g.suite.clock.Now()
 		failedInARunOnceBefore := false
 		if !skip {
+			peakRSSBefore := int64(0)
+			if g.suite.config.CaptureResourceUsage {
+				peakRSSBefore = PeakRSS()
+			}
+
+			if g.suite.perSpecCoverage != nil {
+				if err := g.suite.perSpecCoverage.reset(); err != nil {
+					g.suite.failPerSpecCoverage(err)
+				}
+			}
+
+			var goroutineBaseline map[uint64]bool
+			if g.suite.config.FailOnGoroutineLeak {
+				goroutineBaseline = g.suite.goroutineLeakDetector.snapshot()
+			}
+
+			slowSpecStackCapture := g.suite.startSlowSpecStackCapture(spec.Nodes.GetCaptureStackThreshold())
+
 			var maxAttempts = 1
+			var repeatFailures []types.AdditionalFailure
 
-			if g.suite.config.MustPassRepeatedly > 0 {
+			if g.suite.currentSpecReport.MaxRepeat > 0 {
+				// Repeat always runs the spec a fixed number of times and takes precedence over any retry configuration
+				maxAttempts = g.suite.currentSpecReport.MaxRepeat
+				g.suite.currentSpecReport.MaxFlakeAttempts, g.suite.currentSpecReport.MaxMustPassRepeatedly = 0, 0
+			} else if g.suite.config.MustPassRepeatedly > 0 {
 				maxAttempts = g.suite.config.MustPassRepeatedly
 				g.suite.currentSpecReport.MaxMustPassRepeatedly = maxAttempts
 			} else if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
@@ -333,10 +466,16 @@ func (g *group) run(specs Specs) {
 				maxAttempts = max(1, spec.FlakeAttempts())
 			}
 
+			stabilityCheck := spec.Nodes.GetStabilityCheck()
+			var previousAttemptReport types.SpecReport
+
 			for attempt := 0; attempt < maxAttempts; attempt++ {
 				g.suite.currentSpecReport.NumAttempts = attempt + 1
+				// only keep the values collected by the final attempt
+				g.suite.currentSpecReport.CollectedValues = nil
+				attemptOutputOffsets := g.suite.currentAttemptOutputOffsets()
 				g.suite.writer.Truncate()
-				g.suite.outputInterceptor.StartInterceptingOutput()
+				g.suite.startInterceptingOutputForSpec(spec)
 				if attempt > 0 {
 					if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
 						g.suite.handleSpecEvent(types.SpecEvent{SpecEventType: types.SpecEventSpecRepeat, Attempt: attempt})
@@ -344,15 +483,28 @@ func (g *group) run(specs Specs) {
 					if g.suite.currentSpecReport.MaxFlakeAttempts > 0 {
 						g.suite.handleSpecEvent(types.SpecEvent{SpecEventType: types.SpecEventSpecRetry, Attempt: attempt})
 					}
+					if g.suite.currentSpecReport.MaxRepeat > 0 {
+						g.suite.handleSpecEvent(types.SpecEvent{SpecEventType: types.SpecEventSpecIteration, Attempt: attempt, Message: g.suite.currentSpecReport.RepeatStates[attempt-1].String()})
+					}
 				}
 
 				failedInARunOnceBefore = g.attemptSpec(attempt == maxAttempts-1, spec)
 
-				g.suite.currentSpecReport.EndTime = time.Now()
+				g.suite.currentSpecReport.EndTime = g.suite.clock.Now()
 				g.suite.currentSpecReport.RunTime = g.suite.currentSpecReport.EndTime.Sub(g.suite.currentSpecReport.StartTime)
-				g.suite.currentSpecReport.CapturedGinkgoWriterOutput += string(g.suite.writer.Bytes())
-				g.suite.currentSpecReport.CapturedStdOutErr += g.suite.outputInterceptor.StopInterceptingAndReturnOutput()
-
+				g.suite.captureGinkgoWriterOutput()
+				g.suite.captureStdOutErr(g.suite.stopInterceptingOutputForSpec(spec))
+
+				if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 && stabilityCheck != nil && g.suite.currentSpecReport.State.Is(types.SpecStatePassed) {
+					attemptReport := g.suite.specReportForAttempt(attemptOutputOffsets)
+					if attempt > 0 {
+						if err := stabilityCheck(previousAttemptReport, attemptReport); err != nil {
+							g.suite.currentSpecReport.State = types.SpecStateFailed
+							g.suite.currentSpecReport.Failure = g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt), stabilityCheckFailureMessage(attemptReport, err))
+						}
+					}
+					previousAttemptReport = attemptReport
+				}
 				if g.suite.currentSpecReport.MaxMustPassRepeatedly > 0 {
 					if g.suite.currentSpecReport.State.Is(types.SpecStateFailureStates | types.SpecStateSkipped) {
 						break
@@ -362,16 +514,83 @@ func (g *group) run(specs Specs) {
 					if g.suite.currentSpecReport.State.Is(types.SpecStatePassed | types.SpecStateSkipped | types.SpecStateAborted | types.SpecStateInterrupted) {
 						break
 					} else if attempt < maxAttempts-1 {
-						af := types.AdditionalFailure{State: g.suite.currentSpecReport.State, Failure: g.suite.currentSpecReport.Failure}
+						af := types.AdditionalFailure{State: g.suite.currentSpecReport.State, Failure: g.suite.currentSpecReport.Failure, Attempt: attempt + 1}
 						af.Failure.Message = fmt.Sprintf("Failure recorded during attempt %d:\n%s", attempt+1, af.Failure.Message)
+						g.suite.reportAttempt(g.suite.currentSpecReport)
 						g.suite.currentSpecReport.AdditionalFailures = append(g.suite.currentSpecReport.AdditionalFailures, af)
+						g.suite.runSpecRetryCallbacks(g.suite.currentSpecReport, attempt+2, g.suite.currentSpecReport.Failure)
+					}
+				}
+				if g.suite.currentSpecReport.MaxRepeat > 0 {
+					state := g.suite.currentSpecReport.State
+					g.suite.currentSpecReport.RepeatStates = append(g.suite.currentSpecReport.RepeatStates, state)
+					if state.Is(types.SpecStateFailureStates) {
+						repeatFailures = append(repeatFailures, types.AdditionalFailure{State: state, Failure: g.suite.currentSpecReport.Failure, Attempt: attempt + 1})
+					}
+					if state.Is(types.SpecStateSkipped | types.SpecStateAborted | types.SpecStateInterrupted) {
+						break
 					}
 				}
 			}
+
+			if len(repeatFailures) > 0 {
+				g.applyRepeatFailures(repeatFailures)
+			}
+
+			g.suite.currentSpecReport.SlowSpecStack = slowSpecStackCapture.stop()
+
+			if g.suite.config.FailOnGoroutineLeak && !g.suite.currentSpecReport.State.Is(types.SpecStateInterrupted|types.SpecStateAborted) {
+				if leaked := g.suite.goroutineLeakDetector.leakedGoroutines(goroutineBaseline); len(leaked) > 0 {
+					failure := g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt), goroutineLeakFailureMessage(leaked))
+					if g.suite.currentSpecReport.State.Is(types.SpecStatePassed) {
+						g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = types.SpecStateFailed, failure
+					} else {
+						g.suite.currentSpecReport.AdditionalFailures = append(g.suite.currentSpecReport.AdditionalFailures, types.AdditionalFailure{State: types.SpecStateFailed, Failure: failure})
+					}
+				}
+			}
+
+			if g.suite.config.FailOnDurationDeviation && g.suite.currentSpecReport.State.Is(types.SpecStatePassed) && g.suite.currentSpecReport.DeviatesFromExpectedDuration() {
+				failure := g.suite.failureForLeafNodeWithMessage(spec.FirstNodeWithType(types.NodeTypeIt), fmt.Sprintf("Spec took %s, %s than its expected duration of %s", g.suite.currentSpecReport.RunTime, g.suite.currentSpecReport.DurationDeviation(), g.suite.currentSpecReport.ExpectedDuration))
+				g.suite.currentSpecReport.State, g.suite.currentSpecReport.Failure = types.SpecStateFailed, failure
+			}
+
+			if g.suite.perSpecCoverage != nil {
+				coveredFiles, err := g.suite.perSpecCoverage.coveredFiles()
+				if err != nil {
+					g.suite.failPerSpecCoverage(err)
+				} else {
+					g.suite.currentSpecReport.CoveredFiles = coveredFiles
+				}
+			}
+
+			if g.suite.perSpecLog != nil {
+				logFilePath, err := g.suite.perSpecLog.write(g.suite.currentSpecReport)
+				if err != nil {
+					g.suite.failPerSpecLog(err)
+				} else {
+					g.suite.currentSpecReport.LogFilePath = logFilePath
+				}
+			}
+
+			if g.suite.config.CaptureResourceUsage {
+				g.suite.currentSpecReport.PeakRSSDelta = -1
+				if peakRSSBefore >= 0 {
+					if peakRSSAfter := PeakRSS(); peakRSSAfter >= 0 {
+						g.suite.currentSpecReport.PeakRSSDelta = peakRSSAfter - peakRSSBefore
+					}
+				}
+			}
+
+			if g.suite.config.EmitCompletionProgressReports {
+				g.suite.emitCompletionProgressReport(spec)
+			}
 		}
 
 		g.suite.reportEach(spec, types.NodeTypeReportAfterEach)
+		g.suite.runSpecHooksAfter(g.suite.currentSpecReport)
 		g.suite.processCurrentSpecReport()
+		g.specStates[spec.SubjectID()] = g.suite.currentSpecReport.State
 		if g.suite.currentSpecReport.State.Is(types.SpecStateFailureStates) {
 			g.succeeded = false
 			g.failedInARunOnceBefore = g.failedInARunOnceBefore || failedInARunOnceBefore
@@ -0,0 +1,73 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Benchmark", func() {
+	var iterations []int
+
+	BeforeEach(func() {
+		iterations = []int{}
+		conf.LabelFilter = "!slow"
+		RunFixture("benchmarks", func() {
+			Benchmark("fixed", func(b *GinkgoBenchmark) {
+				iterations = append(iterations, b.Iteration())
+				b.StopTimer()
+				time.Sleep(time.Millisecond)
+				b.StartTimer()
+			}, BenchmarkIterations(50))
+			Benchmark("auto-scaled", func(b *GinkgoBenchmark) {
+				time.Sleep(100 * time.Microsecond)
+			}, BenchmarkDuration(20*time.Millisecond))
+			Benchmark("too slow", func(b *GinkgoBenchmark) {
+				time.Sleep(time.Millisecond)
+			}, BenchmarkIterations(3), BenchmarkMaxMean(time.Microsecond))
+			Benchmark("filtered out", func(b *GinkgoBenchmark) {
+				rt.Run("filtered out")
+			}, Label("slow"))
+			It("not a benchmark", rt.T("not a benchmark"))
+		})
+	})
+
+	It("runs the body a fixed number of iterations and records stable stats", func() {
+		Ω(reporter.Did.Find("fixed")).Should(HavePassed())
+		Ω(iterations).Should(HaveLen(50))
+		Ω(iterations[0]).Should(Equal(0))
+		Ω(iterations[49]).Should(Equal(49))
+
+		stats := reporter.Did.Find("fixed").BenchmarkStats
+		Ω(stats).ShouldNot(BeNil())
+		Ω(stats.Iterations).Should(Equal(50))
+		Ω(stats.Mean).Should(BeNumerically("<", time.Millisecond), "the time spent with the timer stopped is excluded")
+		Ω(stats.Min).Should(BeNumerically("<=", stats.Mean))
+		Ω(stats.P95).Should(BeNumerically(">=", stats.Min))
+		Ω(stats.Max).Should(BeNumerically(">=", stats.P95))
+	})
+
+	It("auto-scales the number of iterations", func() {
+		Ω(reporter.Did.Find("auto-scaled")).Should(HavePassed())
+		stats := reporter.Did.Find("auto-scaled").BenchmarkStats
+		Ω(stats.Iterations).Should(BeNumerically(">", 1))
+		Ω(stats.Mean).Should(BeNumerically(">=", 100*time.Microsecond))
+	})
+
+	It("fails when the regression threshold is exceeded", func() {
+		Ω(reporter.Did.Find("too slow")).Should(HaveFailed(ContainSubstring("exceeded the maximum allowed mean of 1µs")))
+		Ω(reporter.Did.Find("too slow").BenchmarkStats.Iterations).Should(Equal(3))
+	})
+
+	It("composes with labels", func() {
+		Ω(reporter.Did.Find("filtered out")).Should(HaveBeenSkipped())
+		Ω(rt).ShouldNot(HaveRun("filtered out"))
+	})
+
+	It("does not record stats for other specs", func() {
+		Ω(reporter.Did.Find("not a benchmark")).Should(HavePassed())
+		Ω(reporter.Did.Find("not a benchmark").BenchmarkStats).Should(BeNil())
+	})
+})
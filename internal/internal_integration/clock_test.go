@@ -0,0 +1,101 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Injecting a Clock", func() {
+	var clock *FakeClock
+	var success bool
+
+	runFixtureWithClock := func(description string, callback func()) {
+		suite := internal.NewSuite()
+		suite.SetClock(clock)
+		WithSuite(suite, func() {
+			callback()
+			Ω(suite.BuildTree()).Should(Succeed())
+			success, _ = suite.Run(description, Label("TopLevelLabel"), reportedSuiteLabels, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar, conf)
+		})
+	}
+
+	BeforeEach(func() {
+		clock = NewFakeClock()
+	})
+
+	Context("when specs take varying amounts of (fake) time", func() {
+		var start time.Time
+		BeforeEach(func() {
+			start = clock.Now()
+			runFixtureWithClock("clock suite", func() {
+				Describe("container", func() {
+					It("is fast", rt.T("fast", func() {
+						clock.Advance(10 * time.Millisecond)
+					}))
+					It("is slow", rt.T("slow", func() {
+						By("taking a slow step", func() {
+							clock.Advance(8 * time.Second)
+						})
+						clock.Advance(2 * time.Second)
+					}))
+				})
+			})
+		})
+
+		It("timestamps and times the specs with the clock", func() {
+			Ω(success).Should(BeTrue())
+			fast, slow := reporter.Did.Find("is fast"), reporter.Did.Find("is slow")
+			Ω(fast.StartTime).Should(Equal(start))
+			Ω(fast.RunTime).Should(Equal(10 * time.Millisecond))
+			Ω(slow.StartTime).Should(Equal(start.Add(10 * time.Millisecond)))
+			Ω(slow.RunTime).Should(Equal(10 * time.Second))
+		})
+
+		It("makes it possible to deterministically identify slow specs", func() {
+			slowSpecs := []string{}
+			for _, report := range reporter.Did {
+				if report.RunTime > 5*time.Second {
+					slowSpecs = append(slowSpecs, report.LeafNodeText)
+				}
+			}
+			Ω(slowSpecs).Should(ConsistOf("is slow"))
+		})
+
+		It("times timeline events with the clock", func() {
+			byEnd := reporter.Did.Find("is slow").SpecEvents.WithType(types.SpecEventByEnd)
+			Ω(byEnd).Should(HaveLen(1))
+			Ω(byEnd[0].Duration).Should(Equal(8 * time.Second))
+		})
+
+		It("times the suite with the clock", func() {
+			Ω(reporter.End.StartTime).Should(Equal(start))
+			Ω(reporter.End.RunTime).Should(Equal(10*time.Second + 10*time.Millisecond))
+		})
+	})
+
+	Context("when a spec advances the clock past the suite timeout", func() {
+		BeforeEach(func() {
+			conf.Timeout = time.Minute
+			runFixtureWithClock("timeout suite", func() {
+				Describe("container", func() {
+					It("A", rt.T("A", func() {
+						clock.Advance(2 * time.Minute)
+					}))
+					It("B", rt.T("B"))
+				})
+			})
+		})
+
+		It("skips the remaining specs and fails the suite", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ContainElement("Suite Timeout Elapsed"))
+		})
+	})
+})
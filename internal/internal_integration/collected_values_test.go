@@ -0,0 +1,100 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CollectValue and ExpectUnique", func() {
+	Context("when the collected values are unique", func() {
+		BeforeEach(func() {
+			attempts := 0
+			success, _ := RunFixture("unique values", func() {
+				BeforeSuite(func() {
+					CollectValue("ports", 1000)
+				})
+				It("A", func() {
+					CollectValue("ports", 1001)
+				})
+				It("B", FlakeAttempts(2), func() {
+					attempts += 1
+					CollectValue("ports", 1002)
+					if attempts == 1 {
+						F("flake")
+					}
+				})
+				ReportAfterSuite("ports are unique", func(_ Report) {
+					ExpectUnique("ports")
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("records the values on the report of the node that collected them", func() {
+			Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeBeforeSuite).CollectedValues).Should(ConsistOf(HaveField("Value", "1000")))
+			Ω(reporter.Did.Find("A").CollectedValues).Should(ConsistOf(HaveField("Value", "1001")))
+			Ω(reporter.Did.Find("A").CollectedValues[0].Name).Should(Equal("ports"))
+			Ω(reporter.Did.Find("A").CollectedValues[0].Location.FileName).Should(HaveSuffix("collected_values_test.go"))
+		})
+
+		It("only keeps the values collected by the final attempt of a retried spec", func() {
+			Ω(reporter.Did.Find("B")).Should(HavePassed(NumAttempts(2)))
+			Ω(reporter.Did.Find("B").CollectedValues).Should(ConsistOf(HaveField("Value", "1002")))
+		})
+
+		It("passes the ReportAfterSuite node", func() {
+			Ω(reporter.Did.Find("ports are unique")).Should(HavePassed())
+			Ω(reporter.End.CollectedValues("ports")).Should(HaveLen(3))
+		})
+	})
+
+	Context("when duplicate values are collected", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("duplicate values", func() {
+				Describe("container", func() {
+					It("A", func() {
+						CollectValue("ports", 1000)
+					})
+					It("B", func() {
+						CollectValue("ports", 1001)
+					})
+					It("C", func() {
+						CollectValue("ports", 1000)
+					})
+				})
+				ReportAfterSuite("ports are unique", func(_ Report) {
+					ExpectUnique("ports")
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("fails the ReportAfterSuite node, and therefore the suite, listing the specs that collected the duplicate", func() {
+			Ω(reporter.Did.Find("A")).Should(HavePassed())
+			Ω(reporter.Did.Find("ports are unique")).Should(HaveFailed(
+				ContainSubstring(`Expected the values collected under "ports" to be unique but found duplicates:`),
+				ContainSubstring("1000 was collected 2 times:\n  container A at "),
+				ContainSubstring("\n  container C at "),
+				Not(ContainSubstring("1001")),
+			))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(3), NPassed(3)))
+		})
+	})
+
+	Context("when ExpectUnique is called outside of a ReportAfterSuite node", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("misplaced ExpectUnique", func() {
+				It("A", func() {
+					ExpectUnique("ports")
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("fails the node", func() {
+			Ω(reporter.Did.Find("A")).Should(HaveFailed(ContainSubstring("outside of a ReportAfterSuite node")))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package internal_integration_test
+
+import (
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.CaptureResourceUsage is enabled", func() {
+	var buffers [][]byte
+
+	BeforeEach(func() {
+		conf.CaptureResourceUsage = true
+		RunFixture("capture resource usage", func() {
+			It("allocates", rt.T("allocates", func() {
+				// peak RSS is process-global and monotonic so we allocate, a few MB at a time, until the process exceeds its previous peak
+				peak := internal.PeakRSS()
+				for peak >= 0 && internal.PeakRSS() <= peak && len(buffers) < 256 {
+					buffer := make([]byte, 4*1024*1024)
+					for i := 0; i < len(buffer); i += 4096 {
+						buffer[i] = 1
+					}
+					buffers = append(buffers, buffer)
+				}
+			}))
+			PIt("is pending", rt.T("is pending"))
+		})
+		buffers = nil
+	})
+
+	It("records the growth in peak RSS on supported platforms", func() {
+		Ω(reporter.Did.Find("allocates")).Should(HavePassed())
+		switch runtime.GOOS {
+		case "linux", "darwin", "freebsd", "openbsd", "netbsd", "dragonfly":
+			Ω(reporter.Did.Find("allocates").PeakRSSDelta).Should(BeNumerically(">", 0))
+		default:
+			Ω(reporter.Did.Find("allocates").PeakRSSDelta).Should(Equal(int64(-1)))
+		}
+	})
+
+	It("does not record resource usage for specs that do not run", func() {
+		Ω(reporter.Did.Find("is pending").PeakRSSDelta).Should(BeZero())
+	})
+})
+
+var _ = Describe("when config.CaptureResourceUsage is disabled", func() {
+	BeforeEach(func() {
+		RunFixture("no resource usage", func() {
+			It("runs", rt.T("runs"))
+		})
+	})
+
+	It("does not record resource usage", func() {
+		Ω(reporter.Did.Find("runs").PeakRSSDelta).Should(BeZero())
+	})
+})
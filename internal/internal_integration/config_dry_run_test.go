@@ -4,6 +4,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
 	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
 )
 
 var _ = Describe("when config.DryRun is enabled", func() {
@@ -65,3 +67,71 @@ var _ = Describe("when config.DryRun is enabled", func() {
 		Ω(reporter.End).Should(BeASuiteSummary(NSpecs(5), NPassed(3), NPending(1), NSkipped(1)))
 	})
 })
+
+var _ = Describe("when config.DryRun is enabled and the spec counts are summarized", func() {
+	BeforeEach(func() {
+		conf.DryRun = true
+		conf.LabelFilter = "!flaky"
+
+		RunFixture("dry run summary", func() {
+			Describe("cart", Label("cart"), func() {
+				It("A", Label("fast"), rt.T("A"))
+				PIt("B", rt.T("B"))
+				Describe("nested", func() {
+					It("C", Label("fast", "flaky"), rt.T("C"))
+				})
+			})
+			Describe("checkout", func() {
+				It("D", rt.T("D"))
+			})
+			It("E", Label("fast"), rt.T("E"))
+		})
+	})
+
+	It("reports per-container and per-label counts", func() {
+		summary := reporter.End.SpecReports.CountSummary()
+		Ω(summary.Total).Should(Equal(types.SpecCounts{Total: 5, WillRun: 3, Pending: 1, Skipped: 1}))
+		Ω(summary.ByContainer).Should(Equal([]types.SpecCounts{
+			{Name: "", Total: 1, WillRun: 1},
+			{Name: "cart", Total: 3, WillRun: 1, Pending: 1, Skipped: 1},
+			{Name: "checkout", Total: 1, WillRun: 1},
+		}))
+		Ω(summary.ByLabel).Should(Equal([]types.SpecCounts{
+			{Name: "cart", Total: 3, WillRun: 1, Pending: 1, Skipped: 1},
+			{Name: "fast", Total: 3, WillRun: 2, Skipped: 1},
+			{Name: "flaky", Total: 1, Skipped: 1},
+		}))
+	})
+})
+
+var _ = Describe("when config.DryRun is enabled and the spec counts are summarized for a suite with programmatic focus", func() {
+	BeforeEach(func() {
+		conf.DryRun = true
+
+		success, hasProgrammaticFocus := RunFixture("dry run summary with focus", func() {
+			Describe("cart", Label("cart"), func() {
+				FIt("A", rt.T("A"))
+				It("B", rt.T("B"))
+			})
+			FDescribe("checkout", func() {
+				It("C", Label("fast"), rt.T("C"))
+				PIt("D", rt.T("D"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+		Ω(hasProgrammaticFocus).Should(BeTrue())
+	})
+
+	It("counts the focused specs", func() {
+		summary := reporter.End.SpecReports.CountSummary()
+		Ω(summary.Total).Should(Equal(types.SpecCounts{Total: 4, WillRun: 2, Pending: 1, Skipped: 1, Focused: 3}))
+		Ω(summary.ByContainer).Should(Equal([]types.SpecCounts{
+			{Name: "cart", Total: 2, WillRun: 1, Skipped: 1, Focused: 1},
+			{Name: "checkout", Total: 2, WillRun: 1, Pending: 1, Focused: 2},
+		}))
+		Ω(summary.ByLabel).Should(Equal([]types.SpecCounts{
+			{Name: "cart", Total: 2, WillRun: 1, Skipped: 1, Focused: 1},
+			{Name: "fast", Total: 1, WillRun: 1, Focused: 1},
+		}))
+	})
+})
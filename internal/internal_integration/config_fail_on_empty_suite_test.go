@@ -0,0 +1,58 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.FailOnEmptySuite is set", func() {
+	var success bool
+
+	BeforeEach(func() {
+		conf.FailOnEmptySuite = true
+	})
+
+	Context("and the suite defines no specs", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("empty suite", func() {
+				BeforeSuite(rt.T("before-suite"))
+				Describe("an empty container", func() {})
+				AfterSuite(rt.T("after-suite"))
+			})
+		})
+
+		It("fails the suite and reports the special failure reason", func() {
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(0), NWillRun(0)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ContainElement("No specs defined and --fail-on-empty-suite is set"))
+		})
+	})
+
+	Context("and the suite defines specs", func() {
+		BeforeEach(func() {
+			conf.LabelFilter = "cat"
+			success, _ = RunFixture("non-empty suite", func() {
+				It("A", Label("dog"), rt.T("A"))
+			})
+		})
+
+		It("passes even if every spec is filtered out", func() {
+			Ω(success).Should(BeTrue())
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(1), NWillRun(0), NSkipped(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+		})
+	})
+
+	Context("and the flag is not set", func() {
+		BeforeEach(func() {
+			conf.FailOnEmptySuite = false
+			success, _ = RunFixture("empty suite", func() {})
+		})
+
+		It("passes the empty suite", func() {
+			Ω(success).Should(BeTrue())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+func leakyGoroutine(c chan interface{}) {
+	<-c
+}
+
+func allowedBackgroundGoroutine(c chan interface{}) {
+	<-c
+}
+
+// blockingStringer blocks GinkgoWriter.Printf, while it is formatting its arguments, until c is closed
+type blockingStringer chan interface{}
+
+func (c blockingStringer) String() string {
+	<-c
+	return ""
+}
+
+func goroutineBlockedOnGinkgoWriter(c chan interface{}) {
+	writer.Printf("%s", blockingStringer(c))
+}
+
+var _ = Describe("when config.FailOnGoroutineLeak is enabled", func() {
+	var release chan interface{}
+
+	BeforeEach(func() {
+		release = make(chan interface{})
+		conf.FailOnGoroutineLeak = true
+		conf.GoroutineLeakSettleTime = 200 * time.Millisecond
+		conf.GoroutineLeakAllowlist = []string{`allowedBackgroundGoroutine`}
+
+		RunFixture("goroutine leaks", func() {
+			It("leaks", rt.T("leaks", func() {
+				go leakyGoroutine(release)
+			}))
+			It("leaks an allowlisted goroutine", rt.T("allowlisted", func() {
+				go allowedBackgroundGoroutine(release)
+			}))
+			It("launches a goroutine that exits shortly after the spec ends", rt.T("settles", func() {
+				go func() { time.Sleep(20 * time.Millisecond) }()
+			}))
+			It("fails and leaks", rt.T("fails-and-leaks", func() {
+				go leakyGoroutine(release)
+				F("boom")
+			}))
+			It("leaks a goroutine that is blocked on the GinkgoWriter", rt.T("blocked-on-writer", func() {
+				go goroutineBlockedOnGinkgoWriter(release)
+			}))
+			It("does not leak", rt.T("does-not-leak"))
+		})
+		close(release)
+	})
+
+	It("fails specs that leak goroutines, including the leaked stacks in the failure", func() {
+		Ω(reporter.Did.Find("leaks")).Should(HaveFailed(ContainSubstring("Spec leaked 1 goroutine:"), types.FailureNodeIsLeafNode, types.NodeTypeIt))
+		Ω(reporter.Did.Find("leaks").Failure.Message).Should(ContainSubstring("leakyGoroutine"))
+		Ω(reporter.Did.Find("leaks").Failure.Message).Should(ContainSubstring("config_fail_on_goroutine_leak_test.go"))
+	})
+
+	It("fails specs that leak goroutines even if they are blocked inside Ginkgo", func() {
+		Ω(reporter.Did.Find("leaks a goroutine that is blocked on the GinkgoWriter")).Should(HaveFailed(ContainSubstring("Spec leaked 1 goroutine:")))
+		Ω(reporter.Did.Find("leaks a goroutine that is blocked on the GinkgoWriter").Failure.Message).Should(ContainSubstring("github.com/onsi/ginkgo/v2/internal.(*Writer).Printf"))
+		Ω(reporter.Did.Find("leaks a goroutine that is blocked on the GinkgoWriter").Failure.Message).Should(ContainSubstring("goroutineBlockedOnGinkgoWriter"))
+	})
+
+	It("does not fail specs whose goroutines are allowlisted or exit within the settle time", func() {
+		Ω(reporter.Did.Find("leaks an allowlisted goroutine")).Should(HavePassed())
+		Ω(reporter.Did.Find("launches a goroutine that exits shortly after the spec ends")).Should(HavePassed())
+		Ω(reporter.Did.Find("does not leak")).Should(HavePassed())
+	})
+
+	It("records the leak as an additional failure if the spec has already failed", func() {
+		Ω(reporter.Did.Find("fails and leaks")).Should(HaveFailed("boom"))
+		Ω(reporter.Did.Find("fails and leaks").AdditionalFailures).Should(HaveLen(1))
+		Ω(reporter.Did.Find("fails and leaks").AdditionalFailures[0].Failure.Message).Should(ContainSubstring("Spec leaked 1 goroutine:"))
+	})
+})
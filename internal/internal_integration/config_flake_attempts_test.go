@@ -62,6 +62,32 @@ var _ = Describe("when config.FlakeAttempts is greater than 1", func() {
 			Ω(reporter.Did.Find("C").AdditionalFailures[0]).Should(HaveFailed("C - 1"))
 			Ω(reporter.Did.Find("C").AdditionalFailures[1]).Should(HaveFailed("C - 2"))
 		})
+
+		It("notifies the reporter of each failed attempt before retrying", func() {
+			Ω(reporter.Attempts.Names()).Should(Equal([]string{"A", "C", "C"}))
+			attempts := reporter.Attempts
+			Ω(attempts[0]).Should(HaveFailed("A - 1", NumAttempts(1)))
+			Ω(attempts[1]).Should(HaveFailed("C - 1", NumAttempts(1)))
+			Ω(attempts[2]).Should(HaveFailed("C - 2", NumAttempts(2)))
+			Ω(attempts[2].AdditionalFailures).Should(HaveLen(1))
+		})
+
+		It("summarizes the flaky specs, and the failures recorded by their failed attempts, in the suite report", func() {
+			flakyReports := reporter.End.FlakyReports
+			Ω(flakyReports).Should(HaveLen(2))
+
+			Ω(flakyReports[0].LeafNodeText).Should(Equal("A"))
+			Ω(flakyReports[0].NumAttempts).Should(Equal(2))
+			Ω(flakyReports[0].AttemptFailures).Should(HaveLen(1))
+			Ω(flakyReports[0].AttemptFailures[0].Attempt).Should(Equal(1))
+			Ω(flakyReports[0].AttemptFailures[0].Failure.Message).Should(ContainSubstring("A - 1"))
+
+			Ω(flakyReports[1].LeafNodeText).Should(Equal("C"))
+			Ω(flakyReports[1].NumAttempts).Should(Equal(3))
+			Ω(flakyReports[1].AttemptFailures).Should(HaveLen(2))
+			Ω(flakyReports[1].AttemptFailures[1].Attempt).Should(Equal(2))
+			Ω(flakyReports[1].AttemptFailures[1].Failure.Message).Should(ContainSubstring("C - 2"))
+		})
 	})
 
 	Context("when the test fails", func() {
@@ -91,5 +117,9 @@ var _ = Describe("when config.FlakeAttempts is greater than 1", func() {
 			Ω(reporter.Did.Find("C").AdditionalFailures[0]).Should(HaveFailed("C - 1"))
 		})
 
+		It("does not notify the reporter of the final attempt - that is reported via DidRun", func() {
+			Ω(reporter.Attempts.Names()).Should(Equal([]string{"A", "C"}))
+			Ω(reporter.Attempts.Find("C")).Should(HaveFailed("C - 1", NumAttempts(1)))
+		})
 	})
 })
@@ -0,0 +1,48 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.LabelTimeoutMultipliers is set", func() {
+	BeforeEach(func() {
+		conf.LabelTimeoutMultipliers = map[string]float64{"integration": 3, "SLOW": 4, "fast": 0.5}
+		success, _ := RunFixture("label timeout multipliers", func() {
+			Describe("node timeouts", func() {
+				BeforeEach(func(ctx SpecContext) { <-ctx.Done() }, NodeTimeout(20*time.Millisecond))
+				It("unlabelled", rt.T("unlabelled"))
+				It("labelled", Label("integration"), rt.T("labelled"))
+			})
+			Describe("spec timeouts", Label("integration"), func() {
+				It("with several matching labels", Label("slow"), rt.TSC("with several matching labels", func(ctx SpecContext) { <-ctx.Done() }), SpecTimeout(20*time.Millisecond))
+				It("with a multiplier below one", Label("fast"), rt.TSC("with a multiplier below one", func(ctx SpecContext) { <-ctx.Done() }), SpecTimeout(20*time.Millisecond))
+			})
+			It("unlabelled sibling", rt.TSC("unlabelled sibling", func(ctx SpecContext) { <-ctx.Done() }), SpecTimeout(20*time.Millisecond))
+			It("without a timeout", Label("integration"), rt.T("without a timeout"))
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("scales the node timeouts of specs with a matching label", func() {
+		Ω(reporter.Did.Find("unlabelled")).Should(HaveTimedOut("A node timeout occurred"))
+		Ω(reporter.Did.Find("unlabelled").Failure.TimeoutDetails.Budget).Should(Equal(20 * time.Millisecond))
+		Ω(reporter.Did.Find("labelled")).Should(HaveTimedOut("A node timeout occurred"))
+		Ω(reporter.Did.Find("labelled").Failure.TimeoutDetails.Budget).Should(Equal(60 * time.Millisecond))
+	})
+
+	It("scales the spec timeouts of specs with a matching label, using the largest multiplier when several labels match", func() {
+		Ω(reporter.Did.Find("with several matching labels")).Should(HaveTimedOut("A spec timeout occurred"))
+		Ω(reporter.Did.Find("with several matching labels").Failure.TimeoutDetails.Budget).Should(Equal(80 * time.Millisecond))
+		Ω(reporter.Did.Find("with a multiplier below one").Failure.TimeoutDetails.Budget).Should(Equal(60 * time.Millisecond))
+		Ω(reporter.Did.Find("unlabelled sibling")).Should(HaveTimedOut("A spec timeout occurred"))
+		Ω(reporter.Did.Find("unlabelled sibling").Failure.TimeoutDetails.Budget).Should(Equal(20 * time.Millisecond))
+	})
+
+	It("does not affect specs without a timeout", func() {
+		Ω(reporter.Did.Find("without a timeout")).Should(HavePassed())
+	})
+})
@@ -0,0 +1,55 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.MinSpecsToRun is set", func() {
+	var success bool
+	fixture := func() {
+		BeforeSuite(rt.T("before-suite"))
+		Describe("a container", func() {
+			It("A", Label("cat"), rt.T("A"))
+			It("B", Label("dog"), rt.T("B"))
+			It("C", Label("dog"), rt.T("C"))
+		})
+		AfterSuite(rt.T("after-suite"))
+	}
+
+	BeforeEach(func() {
+		conf.MinSpecsToRun = 2
+	})
+
+	Context("and the filters select fewer specs than the minimum", func() {
+		BeforeEach(func() {
+			conf.LabelFilter = "cat"
+			success, _ = RunFixture("too few specs", fixture)
+		})
+
+		It("fails the suite without running anything", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTrackedNothing())
+		})
+
+		It("reports the special failure reason", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(3), NWillRun(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ContainElement("Only 1 specs will run but --min-specs-to-run requires at least 2"))
+		})
+	})
+
+	Context("and the filters select enough specs", func() {
+		BeforeEach(func() {
+			conf.LabelFilter = "dog"
+			success, _ = RunFixture("enough specs", fixture)
+		})
+
+		It("runs the suite as usual", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt).Should(HaveTracked("before-suite", "B", "C", "after-suite"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(3), NWillRun(2), NPassed(2), NSkipped(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(BeEmpty())
+		})
+	})
+})
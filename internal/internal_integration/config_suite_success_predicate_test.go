@@ -0,0 +1,168 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.SuiteSuccessPredicate is set", func() {
+	var success bool
+	var predicateReports []types.Report
+
+	atLeastThreeQuartersPass := func(report types.Report) bool {
+		predicateReports = append(predicateReports, report)
+		specs := report.SpecReports.WithLeafNodeType(types.NodeTypeIt)
+		return specs.CountWithState(types.SpecStatePassed)*4 >= len(specs)*3
+	}
+
+	BeforeEach(func() {
+		predicateReports = []types.Report{}
+		conf.SuiteSuccessPredicate = atLeastThreeQuartersPass
+	})
+
+	Context("and the predicate passes a suite with failures", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("mostly passing", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+				It("C", rt.T("C"))
+				It("D", rt.T("D", func() { F("fail") }))
+			})
+		})
+
+		It("passes the suite", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt.TrackedRuns()).Should(ConsistOf("A", "B", "C", "D"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(4), NPassed(3), NFailed(1)))
+			Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeTrue())
+		})
+
+		It("calls the predicate once with the complete report", func() {
+			Ω(predicateReports).Should(HaveLen(1))
+			Ω(predicateReports[0].SpecReports).Should(HaveLen(4))
+		})
+	})
+
+	Context("and the predicate fails a suite with too many failures", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("mostly failing", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B", func() { F("fail") }))
+			})
+		})
+
+		It("fails the suite without noting the predicate, as the outcome is unchanged", func() {
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NFailed(1)))
+			Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeFalse())
+		})
+	})
+
+	Context("and the predicate fails a suite that would otherwise pass", func() {
+		BeforeEach(func() {
+			conf.SuiteSuccessPredicate = func(report types.Report) bool { return false }
+			success, _ = RunFixture("strict", func() {
+				It("A", rt.T("A"))
+			})
+		})
+
+		It("fails the suite", func() {
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(1), NPassed(1)))
+			Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeTrue())
+		})
+	})
+
+	Context("and the suite fails for a special reason", func() {
+		BeforeEach(func() {
+			conf.SuiteSuccessPredicate = func(report types.Report) bool { return true }
+			conf.MinSpecsToRun = 2
+			success, _ = RunFixture("too few specs", func() {
+				It("A", rt.T("A"))
+			})
+		})
+
+		It("does not consult the predicate", func() {
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeFalse())
+		})
+	})
+
+	Context("when running in parallel", func() {
+		var otherProcReport types.Report
+
+		BeforeEach(func() {
+			SetUpForParallel(2)
+			otherProcReport = types.Report{
+				SuiteSucceeded: false,
+				SpecReports: types.SpecReports{
+					types.SpecReport{LeafNodeText: "C", LeafNodeLocation: cl, State: types.SpecStatePassed, LeafNodeType: types.NodeTypeIt},
+					types.SpecReport{LeafNodeText: "D", LeafNodeLocation: cl, State: types.SpecStateFailed, LeafNodeType: types.NodeTypeIt},
+				},
+			}
+		})
+
+		Context("on proc 1", func() {
+			var reportAfterSuiteReport types.Report
+
+			BeforeEach(func() {
+				conf.ParallelProcess = 1
+				client.PostSuiteDidEnd(otherProcReport)
+				close(exitChannels[2])
+				success, _ = RunFixture("mostly passing", func() {
+					It("A", rt.T("A"))
+					It("B", rt.T("B"))
+					ReportAfterSuite("report", func(report Report) { reportAfterSuiteReport = report })
+				})
+			})
+
+			It("calls the predicate once with the report aggregated across procs", func() {
+				Ω(predicateReports).Should(HaveLen(1))
+				Ω(Reports(predicateReports[0].SpecReports).Names()).Should(ConsistOf("A", "B", "C", "D"))
+			})
+
+			It("determines the outcome of the suite", func() {
+				Ω(success).Should(BeTrue())
+				Ω(reporter.End.SuiteSucceeded).Should(BeTrue())
+				Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeTrue())
+			})
+
+			It("passes the outcome determined by the predicate to ReportAfterSuite", func() {
+				Ω(reportAfterSuiteReport.SuiteSucceeded).Should(BeTrue())
+				Ω(reportAfterSuiteReport.SuiteOutcomeDeterminedByPredicate).Should(BeTrue())
+			})
+		})
+
+		Context("on a non-primary proc", func() {
+			BeforeEach(func() {
+				conf.ParallelProcess = 2
+				success, _ = RunFixture("failing", func() {
+					It("C", rt.T("C"))
+					It("D", rt.T("D", func() { F("fail") }))
+				})
+			})
+
+			It("does not call the predicate and defers to proc 1", func() {
+				Ω(predicateReports).Should(BeEmpty())
+				Ω(success).Should(BeTrue())
+				Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(1), NFailed(1)))
+				Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeFalse())
+			})
+		})
+	})
+})
+
+var _ = Describe("when config.SuiteSuccessPredicate is not set", func() {
+	It("fails the suite when any spec fails", func() {
+		success, _ := RunFixture("default", func() {
+			It("A", rt.T("A"))
+			It("B", rt.T("B"))
+			It("C", rt.T("C"))
+			It("D", rt.T("D", func() { F("fail") }))
+		})
+		Ω(success).Should(BeFalse())
+		Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeFalse())
+	})
+})
@@ -39,11 +39,18 @@ var _ = Describe("CurrentSpecReport", func() {
 			Context("a failing test", func() {
 				BeforeEach(logCurrentSpecReport("bef-B"))
 				It("B", logCurrentSpecReport("it-B", func() {
+					DeferCleanup(logCurrentSpecReport("cleanup-B"))
 					writer.Println("hello it-B")
 					F("failed")
 				}))
 				AfterEach(logCurrentSpecReport("aft-B"))
 			})
+			Context("a test that fails in an AfterEach", func() {
+				It("E", logCurrentSpecReport("it-E", func() {
+					DeferCleanup(logCurrentSpecReport("cleanup-E"))
+				}))
+				AfterEach(func() { F("failed in after each") })
+			})
 
 			Context("an ordered container", Ordered, func() {
 				It("C", logCurrentSpecReport("C"))
@@ -73,6 +80,16 @@ var _ = Describe("CurrentSpecReport", func() {
 		Ω(specs["aft-B"].Failed()).Should(BeTrue())
 	})
 
+	It("reflects the failure state of the spec in DeferCleanup callbacks registered by the spec", func() {
+		Ω(specs["cleanup-B"].Failed()).Should(BeTrue())
+		Ω(specs["cleanup-B"].State).Should(Equal(types.SpecStateFailed))
+		Ω(specs["cleanup-B"].Failure.Message).Should(Equal("failed"))
+
+		Ω(specs["it-E"].Failed()).Should(BeFalse())
+		Ω(specs["cleanup-E"].Failed()).Should(BeTrue())
+		Ω(specs["cleanup-E"].Failure.Message).Should(Equal("failed in after each"))
+	})
+
 	It("captures GinkgoWriter output", func() {
 		Ω(specs["bef-A"].CapturedGinkgoWriterOutput).Should(BeZero())
 		Ω(specs["it-A"].CapturedGinkgoWriterOutput).Should(Equal("hello bef-A\n"))
@@ -91,10 +108,12 @@ var _ = Describe("CurrentSpecReport", func() {
 	It("captures serial/ordered correctly", func() {
 		Ω(specs["A"].IsSerial).Should(BeFalse())
 		Ω(specs["A"].IsInOrderedContainer).Should(BeFalse())
+		Ω(specs["A"].OrderedContainerID).Should(BeZero())
 		Ω(specs["after-suite"].IsSerial).Should(BeFalse())
 		Ω(specs["after-suite"].IsInOrderedContainer).Should(BeFalse())
 		Ω(specs["C"].IsSerial).Should(BeFalse())
 		Ω(specs["C"].IsInOrderedContainer).Should(BeTrue())
+		Ω(specs["C"].OrderedContainerID).ShouldNot(BeZero())
 		Ω(specs["D"].IsSerial).Should(BeTrue())
 		Ω(specs["D"].IsInOrderedContainer).Should(BeFalse())
 	})
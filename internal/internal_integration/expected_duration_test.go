@@ -0,0 +1,90 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/internal"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the ExpectedDuration decorator", func() {
+	var clock *FakeClock
+	var success bool
+
+	BeforeEach(func() {
+		clock = NewFakeClock()
+	})
+
+	JustBeforeEach(func() {
+		suite := internal.NewSuite()
+		suite.SetClock(clock)
+		WithSuite(suite, func() {
+			Describe("container", ExpectedDuration(time.Second), func() {
+				It("is on time", rt.T("on-time", func() {
+					clock.Advance(1200 * time.Millisecond)
+				}))
+				It("is slow", rt.T("slow", func() {
+					clock.Advance(3 * time.Second)
+				}))
+				It("is fast", rt.T("fast", func() {
+					clock.Advance(100 * time.Millisecond)
+				}))
+				It("is fast and overridden", ExpectedDuration(100*time.Millisecond), rt.T("overridden", func() {
+					clock.Advance(100 * time.Millisecond)
+				}))
+				It("fails", rt.T("fails", func() {
+					clock.Advance(3 * time.Second)
+					F("boom")
+				}))
+			})
+			It("has no expectation", rt.T("no-expectation", func() {
+				clock.Advance(time.Hour)
+			}))
+			Ω(suite.BuildTree()).Should(Succeed())
+			success, _ = suite.Run("expected duration suite", Label(), reportedSuiteLabels, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar, conf)
+		})
+	})
+
+	It("records the innermost expected duration on each spec", func() {
+		Ω(reporter.Did.Find("is on time").ExpectedDuration).Should(Equal(time.Second))
+		Ω(reporter.Did.Find("is fast and overridden").ExpectedDuration).Should(Equal(100 * time.Millisecond))
+		Ω(reporter.Did.Find("has no expectation").ExpectedDuration).Should(BeZero())
+	})
+
+	It("flags deviations without failing the suite", func() {
+		Ω(success).Should(BeFalse())
+		Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(6), NPassed(5), NFailed(1)))
+
+		Ω(reporter.Did.Find("is on time")).Should(HavePassed())
+		Ω(reporter.Did.Find("is on time").DeviatesFromExpectedDuration()).Should(BeFalse())
+		Ω(reporter.Did.Find("is slow")).Should(HavePassed())
+		Ω(reporter.Did.Find("is slow").DurationDeviation()).Should(Equal("3x slower"))
+		Ω(reporter.Did.Find("is fast")).Should(HavePassed())
+		Ω(reporter.Did.Find("is fast").DurationDeviation()).Should(Equal("10x faster"))
+		Ω(reporter.Did.Find("is fast and overridden").DeviatesFromExpectedDuration()).Should(BeFalse())
+		Ω(reporter.Did.Find("has no expectation").DeviatesFromExpectedDuration()).Should(BeFalse())
+	})
+
+	Context("when FailOnDurationDeviation is set", func() {
+		BeforeEach(func() {
+			conf.FailOnDurationDeviation = true
+		})
+
+		It("fails passing specs that deviate from their expected duration", func() {
+			Ω(success).Should(BeFalse())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(6), NPassed(3), NFailed(3)))
+
+			Ω(reporter.Did.Find("is on time")).Should(HavePassed())
+			Ω(reporter.Did.Find("is slow")).Should(HaveFailed("Spec took 3s, 3x slower than its expected duration of 1s"))
+			Ω(reporter.Did.Find("is fast")).Should(HaveFailed("Spec took 100ms, 10x faster than its expected duration of 1s"))
+			Ω(reporter.Did.Find("is fast and overridden")).Should(HavePassed())
+			Ω(reporter.Did.Find("has no expectation")).Should(HavePassed())
+		})
+
+		It("leaves the failures of specs that already failed alone", func() {
+			Ω(reporter.Did.Find("fails")).Should(HaveFailed("boom"))
+		})
+	})
+})
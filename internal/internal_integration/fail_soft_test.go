@@ -0,0 +1,92 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FailSoft", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("soft failures", func() {
+			It("records several problems", rt.T("several", func() {
+				FailSoft("first problem")
+				FailSoft("second problem")
+				rt.Run("after-soft-failures")
+			}))
+			It("soft fails then fails hard", rt.T("hard", func() {
+				FailSoft("soft problem")
+				F("hard problem", cl)
+				rt.Run("after-hard-failure")
+			}))
+			It("soft fails then panics", rt.T("panics", func() {
+				FailSoft("soft problem")
+				panic("boom")
+			}))
+			Describe("container", func() {
+				AfterEach(rt.T("after-each", func() {
+					FailSoft("cleanup problem A")
+					FailSoft("cleanup problem B")
+				}))
+				It("fails in the It", rt.T("fails-in-it", func() {
+					F("it problem", cl)
+				}))
+			})
+			It("passes", rt.T("passes"))
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("lets the node keep running after a soft failure but stops it at a hard failure", func() {
+		Ω(rt).Should(HaveRun("after-soft-failures"))
+		Ω(rt).ShouldNot(HaveRun("after-hard-failure"))
+		Ω(rt).Should(HaveRun("after-each"))
+		Ω(reporter.Did.Find("passes")).Should(HavePassed())
+	})
+
+	It("fails the spec with the first soft failure and records the rest as additional failures", func() {
+		report := reporter.Did.Find("records several problems")
+		Ω(report).Should(HaveFailed("first problem", FailureNodeType(types.NodeTypeIt)))
+		Ω(report.Failure.Location.FileName).Should(HaveSuffix("fail_soft_test.go"))
+		Ω(report.AdditionalFailures).Should(HaveLen(1))
+		Ω(report.AdditionalFailures[0].State).Should(Equal(types.SpecStateFailed))
+		Ω(report.AdditionalFailures[0].Failure.Message).Should(Equal("second problem"))
+		Ω(report.AdditionalFailures[0].Failure.Location.FileName).Should(HaveSuffix("fail_soft_test.go"))
+		Ω(report.AdditionalFailures[0].Failure.FailureNodeType).Should(Equal(types.NodeTypeIt))
+		Ω(report.AdditionalFailures[0].Failure.FailureNodeContext).Should(Equal(types.FailureNodeIsLeafNode))
+	})
+
+	It("records failures and panics that follow a soft failure", func() {
+		report := reporter.Did.Find("soft fails then fails hard")
+		Ω(report).Should(HaveFailed("soft problem"))
+		Ω(report.AdditionalFailures).Should(HaveLen(1))
+		Ω(report.AdditionalFailures[0].Failure.Message).Should(Equal("hard problem"))
+		Ω(report.AdditionalFailures[0].Failure.Location).Should(Equal(cl))
+
+		report = reporter.Did.Find("soft fails then panics")
+		Ω(report).Should(HaveFailed("soft problem"))
+		Ω(report.AdditionalFailures).Should(HaveLen(1))
+		Ω(report.AdditionalFailures[0].State).Should(Equal(types.SpecStatePanicked))
+		Ω(report.AdditionalFailures[0].Failure.ForwardedPanic).Should(Equal("boom"))
+	})
+
+	It("orders soft failures recorded by a cleanup node after the node's own failure", func() {
+		report := reporter.Did.Find("fails in the It")
+		Ω(report).Should(HaveFailed("it problem"))
+		messages := []string{}
+		for _, additionalFailure := range report.AdditionalFailures {
+			messages = append(messages, additionalFailure.Failure.Message)
+			Ω(additionalFailure.Failure.FailureNodeType).Should(Equal(types.NodeTypeAfterEach))
+		}
+		Ω(messages).Should(Equal([]string{"cleanup problem A", "cleanup problem B"}))
+	})
+
+	It("emits each failure to the reporter", func() {
+		messages := []string{}
+		for _, failure := range reporter.Failures {
+			messages = append(messages, failure.Failure.Message)
+		}
+		Ω(messages).Should(ContainElements("first problem", "second problem", "soft problem", "hard problem", "it problem", "cleanup problem A", "cleanup problem B"))
+	})
+})
@@ -0,0 +1,70 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FailSuite", func() {
+	var success bool
+	var reports []types.Report
+
+	BeforeEach(func() {
+		reports = []types.Report{}
+	})
+
+	Context("when called in a ReportAfterSuite node", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("fail suite in report after suite", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"))
+				ReportAfterSuite("checks coverage", func(report Report) {
+					rt.Run("checks-coverage")
+					reports = append(reports, report)
+					FailSuite("coverage dropped below 80%")
+				}, ReportPriority(1))
+				ReportAfterSuite("uploads results", func(report Report) {
+					rt.Run("uploads-results")
+					reports = append(reports, report)
+				})
+			})
+		})
+
+		It("runs the node to completion and fails the suite with the reason", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTracked("A", "B", "checks-coverage", "uploads-results"))
+			Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeReportAfterSuite)).Should(HavePassed())
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(2), NPassed(2)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(Equal([]string{"coverage dropped below 80%"}))
+		})
+
+		It("makes the failure visible to subsequent ReportAfterSuite nodes", func() {
+			Ω(reports).Should(HaveLen(2))
+			Ω(reports[0].SuiteSucceeded).Should(BeTrue())
+			Ω(reports[1].SuiteSucceeded).Should(BeFalse())
+			Ω(reports[1].SpecialSuiteFailureReasons).Should(Equal([]string{"coverage dropped below 80%"}))
+		})
+	})
+
+	Context("when called in an AfterSuite node", func() {
+		BeforeEach(func() {
+			conf.SuiteSuccessPredicate = func(report types.Report) bool { return true }
+			success, _ = RunFixture("fail suite in after suite", func() {
+				It("A", rt.T("A"))
+				AfterSuite(rt.T("after-suite", func() {
+					FailSuite("leaked resources")
+				}))
+			})
+		})
+
+		It("fails the suite and the SuiteSuccessPredicate does not override it", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTracked("A", "after-suite"))
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(1), NPassed(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(Equal([]string{"leaked resources"}))
+			Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeFalse())
+		})
+	})
+})
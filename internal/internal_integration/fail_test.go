@@ -1,6 +1,8 @@
 package internal_integration_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -208,6 +210,38 @@ var _ = Describe("handling test failures", func() {
 				Ω(reporter.Failures[0]).Should(HavePanicked("boom", TLWithOffset("running B")))
 			})
 
+			It("records the structured panic value", func() {
+				Ω(reporter.Did.Find("B").Failure.PanicValue).Should(Equal(&types.PanicValue{Type: "string", Message: "boom", JSON: json.RawMessage(`"boom"`)}))
+			})
+		})
+
+		Describe("when an It panics with an error", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("panicked it with an error", func() {
+					It("A", rt.T("A", func() {
+						panic(fmt.Errorf("saving book: %w", &bookError{Title: "Les Miserables", Code: 422}))
+					}))
+				})
+				Ω(success).Should(BeFalse())
+			})
+
+			It("records the error's type, message, and unwrapped chain alongside the forwarded panic", func() {
+				failure := reporter.Did.Find("A").Failure
+				Ω(reporter.Did.Find("A")).Should(HavePanicked("saving book: invalid book Les Miserables (422)"))
+				Ω(failure.PanicValue.Type).Should(Equal("*fmt.wrapError"))
+				Ω(failure.PanicValue.Message).Should(Equal("saving book: invalid book Les Miserables (422)"))
+				Ω(failure.PanicValue.ErrorChain).Should(Equal([]types.PanicValueError{
+					{Type: "*internal_integration_test.bookError", Message: "invalid book Les Miserables (422)"},
+				}))
+			})
+
+			It("survives a round-trip through JSON", func() {
+				encoded, err := json.Marshal(reporter.Did.Find("A"))
+				Ω(err).ShouldNot(HaveOccurred())
+				var decoded types.SpecReport
+				Ω(json.Unmarshal(encoded, &decoded)).Should(Succeed())
+				Ω(decoded.Failure.PanicValue).Should(Equal(reporter.Did.Find("A").Failure.PanicValue))
+			})
 		})
 
 		Describe("when a BeforeEach fails/panics", func() {
@@ -411,3 +445,12 @@ var _ = Describe("handling test failures", func() {
 		})
 	})
 })
+
+type bookError struct {
+	Title string
+	Code  int
+}
+
+func (e *bookError) Error() string {
+	return fmt.Sprintf("invalid book %s (%d)", e.Title, e.Code)
+}
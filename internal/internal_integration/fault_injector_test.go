@@ -0,0 +1,99 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fault Injectors", func() {
+	var contexts []SpecContext
+
+	BeforeEach(func() {
+		contexts = []SpecContext{}
+	})
+
+	Context("when fault injectors match some specs", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("fault injectors", func() {
+				RegisterFaultInjector("chaos", func(ctx SpecContext) {
+					contexts = append(contexts, ctx)
+					rt.Run("inject-A " + ctx.SpecReport().LeafNodeText)
+				}, func(ctx SpecContext) {
+					rt.Run("recover-A " + ctx.SpecReport().LeafNodeText)
+				})
+				RegisterFaultInjector("chaos && network", func(ctx SpecContext) {
+					rt.Run("inject-B " + ctx.SpecReport().LeafNodeText)
+				}, func(ctx SpecContext) {
+					rt.Run("recover-B " + ctx.SpecReport().LeafNodeText)
+				})
+				RegisterFaultInjector("slow", nil, func(ctx SpecContext) {
+					rt.Run("recover-C " + ctx.SpecReport().LeafNodeText)
+				})
+				Describe("container", func() {
+					BeforeEach(rt.T("before-each"))
+					It("A", Label("chaos"), rt.T("A", func() {
+						DeferCleanup(rt.T("cleanup"))
+					}))
+					It("B", Label("chaos", "network"), rt.T("B"))
+					It("C", rt.T("C"))
+					AfterEach(rt.T("after-each"))
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs matching injectors around the spec, composing them in registration order, and leaves other specs alone", func() {
+			Ω(rt).Should(HaveTracked(
+				"inject-A A", "before-each", "A", "after-each", "cleanup", "recover-A A",
+				"inject-A B", "inject-B B", "before-each", "B", "after-each", "recover-B B", "recover-A B",
+				"before-each", "C", "after-each",
+			))
+		})
+
+		It("passes the injectors a SpecContext", func() {
+			Ω(contexts).Should(HaveLen(2))
+			for _, ctx := range contexts {
+				Ω(ctx.Err()).Should(MatchError("context canceled"))
+			}
+		})
+	})
+
+	Context("when an injector fails", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("failing fault injectors", func() {
+				RegisterFaultInjector("chaos", func(ctx SpecContext) {
+					rt.Run("inject-A")
+				}, func(ctx SpecContext) {
+					rt.Run("recover-A")
+				})
+				RegisterFaultInjector("chaos", func(ctx SpecContext) {
+					rt.Run("inject-B")
+					F("injection failed", cl)
+				}, func(ctx SpecContext) {
+					rt.Run("recover-B")
+				})
+				RegisterFaultInjector("chaos", func(ctx SpecContext) {
+					rt.Run("inject-C")
+				}, func(ctx SpecContext) {
+					rt.Run("recover-C")
+				})
+				Describe("container", func() {
+					BeforeEach(rt.T("before-each"))
+					It("A", Label("chaos"), rt.T("A"))
+					AfterEach(rt.T("after-each"))
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("does not run the spec but still unwinds the injectors that ran", func() {
+			Ω(rt).Should(HaveTracked("inject-A", "inject-B", "recover-B", "recover-A"))
+		})
+
+		It("fails the spec with the injector's failure", func() {
+			Ω(reporter.Did.Find("A")).Should(HaveFailed("injection failed", cl, types.FailureNodeAtTopLevel, FailureNodeType(types.NodeTypeBeforeEach)))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package internal_integration_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("when config.FinalOrderHook is set", func() {
+	var success bool
+
+	fixture := func() {
+		It("A", rt.T("A"))
+		It("B", Label("smoke"), rt.T("B"))
+		Describe("ordered", Ordered, func() {
+			It("C", rt.T("C"))
+			It("D", rt.T("D"))
+		})
+		It("E", Label("smoke"), rt.T("E"))
+	}
+
+	Context("and the hook returns a valid order", func() {
+		BeforeEach(func() {
+			conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+				out := []types.SpecReport{}
+				for i := len(ordered) - 1; i >= 0; i-- {
+					if isSmoke, _ := ordered[i].MatchesLabelFilter("smoke"); isSmoke {
+						out = append([]types.SpecReport{ordered[i]}, out...)
+					}
+				}
+				for _, report := range ordered {
+					if isSmoke, _ := report.MatchesLabelFilter("smoke"); !isSmoke {
+						out = append(out, report)
+					}
+				}
+				return out
+			}
+			success, _ = RunFixture("valid final order", fixture)
+		})
+
+		It("runs the specs in the order returned by the hook", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt.TrackedRuns()[:2]).Should(ConsistOf("B", "E"))
+			Ω(rt.TrackedRuns()[2:]).Should(ConsistOf("A", "C", "D"))
+			Ω(strings.Join(rt.TrackedRuns(), "")).Should(ContainSubstring("CD"))
+		})
+	})
+
+	Context("and the hook splits up an ordered container", func() {
+		BeforeEach(func() {
+			conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+				// run C, then A, then everything else - splitting C from D
+				out := make([]types.SpecReport, 2, len(ordered))
+				for _, report := range ordered {
+					switch report.LeafNodeText {
+					case "C":
+						out[0] = report
+					case "A":
+						out[1] = report
+					default:
+						out = append(out, report)
+					}
+				}
+				return out
+			}
+			success, _ = RunFixture("invalid final order", func() {
+				It("A", rt.T("A"))
+				Describe("ordered", Ordered, func() {
+					It("C", rt.T("C"))
+					It("D", rt.T("D"))
+				})
+				It("Z", rt.T("Z"))
+			})
+		})
+
+		It("fails the suite without running any specs", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTrackedNothing())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ContainElement(ContainSubstring("FinalOrderHook returned an invalid order")))
+		})
+	})
+})
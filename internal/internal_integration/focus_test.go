@@ -1,6 +1,8 @@
 package internal_integration_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/types"
 	. "github.com/onsi/gomega"
@@ -255,4 +257,40 @@ var _ = Describe("Focus", func() {
 			Ω(reporter.End).Should(BeASuiteSummary(true, NPassed(1), NSkipped(3), NPending(0), NSpecs(4), NWillRun(4)))
 		})
 	})
+
+	Describe("with config.FocusSpecIDs", func() {
+		fixture := func() {
+			Describe("container", func() {
+				for i := 1; i <= 3; i++ {
+					It("does the thing", rt.T(fmt.Sprintf("container-%d", i)))
+				}
+			})
+			It("does the thing", rt.T("top-level"))
+		}
+
+		BeforeEach(func() {
+			success, _ := RunFixture("collect spec ids", fixture)
+			Ω(success).Should(BeTrue())
+			ids := map[string]bool{}
+			for _, report := range reporter.Did {
+				Ω(report.ID).ShouldNot(BeEmpty())
+				ids[report.ID] = true
+			}
+			Ω(ids).Should(HaveLen(4))
+
+			conf.FocusSpecIDs = []string{reporter.Did.FindByFullText("container does the thing").ID}
+			rt.Reset()
+			reporter = NewFakeReporter()
+			success, _ = RunFixture("focus spec ids", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs only the spec with the requested ID, even when other specs share its text", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("container-1"))
+		})
+
+		It("reports on the suite with accurate numbers", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(true, NPassed(1), NSkipped(3), NSpecs(4), NWillRun(1)))
+		})
+	})
 })
@@ -0,0 +1,86 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the Informational decorator", func() {
+	var success bool
+
+	fixture := func() {
+		It("passes", rt.T("passes"))
+		It("panics", Informational, rt.T("panics", func() {
+			panic("boom")
+		}))
+		Describe("informational container", Informational, func() {
+			It("fails", rt.T("fails", func() {
+				F("fail", cl)
+			}))
+			It("passes too", rt.T("passes-too"))
+		})
+		It("runs last", rt.T("runs-last"))
+	}
+
+	Context("when only informational specs fail", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("informational", fixture)
+		})
+
+		It("runs every spec and the suite succeeds", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt.TrackedRuns()).Should(ConsistOf("passes", "panics", "fails", "passes-too", "runs-last"))
+			Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(5), NPassed(3), NFailed(2)))
+		})
+
+		It("reports the informational specs and their failures", func() {
+			Ω(reporter.Did.Find("passes").IsInformational).Should(BeFalse())
+
+			Ω(reporter.Did.Find("panics")).Should(HavePanicked("boom"))
+			Ω(reporter.Did.Find("panics").IsInformational).Should(BeTrue())
+
+			Ω(reporter.Did.Find("fails")).Should(HaveFailed("fail", cl))
+			Ω(reporter.Did.Find("fails").IsInformational).Should(BeTrue())
+
+			Ω(reporter.Did.Find("passes too")).Should(HavePassed())
+			Ω(reporter.Did.Find("passes too").IsInformational).Should(BeTrue())
+
+			Ω(reporter.End.SpecReports.Informational()).Should(HaveLen(3))
+			Ω(reporter.End.SpecReports.WithoutInformational().CountWithState(types.SpecStateFailureStates)).Should(BeZero())
+		})
+	})
+
+	Context("when running with FailFast", func() {
+		BeforeEach(func() {
+			conf.FailFast = true
+			success, _ = RunFixture("informational with fail fast", fixture)
+		})
+
+		It("does not stop at informational failures", func() {
+			Ω(success).Should(BeTrue())
+			Ω(rt.TrackedRuns()).Should(ConsistOf("passes", "panics", "fails", "passes-too", "runs-last"))
+		})
+	})
+
+	Context("when an informational spec aborts the suite", func() {
+		BeforeEach(func() {
+			success, _ = RunFixture("informational abort", func() {
+				Describe("container", func() {
+					It("aborts", Informational, rt.T("aborts", func() {
+						Abort("abort", cl)
+					}))
+					It("never runs", rt.T("never-runs"))
+				})
+			})
+		})
+
+		It("still fails the suite", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTracked("aborts"))
+			Ω(reporter.Did.Find("aborts")).Should(HaveAborted("abort", cl))
+			Ω(reporter.Did.Find("never runs")).Should(HaveBeenSkipped())
+		})
+	})
+})
@@ -36,6 +36,7 @@ var rt *RunTracker
 var cl types.CodeLocation
 var interruptHandler *FakeInterruptHandler
 var outputInterceptor *FakeOutputInterceptor
+var reportedSuiteLabels Labels
 
 var server parallel_support.Server
 var client parallel_support.Client
@@ -62,6 +63,7 @@ var _ = BeforeEach(func() {
 	cl = types.NewCodeLocation(0)
 	interruptHandler = NewFakeInterruptHandler()
 	outputInterceptor = NewFakeOutputInterceptor()
+	reportedSuiteLabels = Labels{}
 
 	conf.ParallelTotal = 1
 	conf.ParallelProcess = 1
@@ -94,7 +96,7 @@ func RunFixture(description string, callback func()) (bool, bool) {
 	WithSuite(suite, func() {
 		callback()
 		Ω(suite.BuildTree()).Should(Succeed())
-		success, hasProgrammaticFocus = suite.Run(description, Label("TopLevelLabel"), "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar, conf)
+		success, hasProgrammaticFocus = suite.Run(description, Label("TopLevelLabel"), reportedSuiteLabels, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, progressSignalRegistrar, conf)
 	})
 	return success, hasProgrammaticFocus
 }
@@ -128,7 +130,7 @@ func RunFixtureInParallel(description string, callback func(proc int)) bool {
 			interruptHandler := interrupt_handler.NewInterruptHandler(client)
 			defer interruptHandler.Stop()
 
-			success, _ := suite.Run(fmt.Sprintf("%s - %d", description, proc), Label("TopLevelLabel"), "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, c)
+			success, _ := suite.Run(fmt.Sprintf("%s - %d", description, proc), Label("TopLevelLabel"), Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, c)
 			close(exit)
 			finished <- success
 		}()
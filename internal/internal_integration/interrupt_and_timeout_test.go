@@ -81,6 +81,42 @@ var _ = Describe("Interrupts and Timeouts", func() {
 		})
 	})
 
+	Describe("when the suite timeout elapses while a slow spec is running", func() {
+		BeforeEach(func(_ SpecContext) {
+			conf.Timeout = time.Millisecond * 100
+			success, _ := RunFixture(CurrentSpecReport().LeafNodeText, func() {
+				Describe("container", Ordered, func() {
+					It("A", rt.T("A"))
+					It("B", rt.TSC("B", func(c SpecContext) {
+						<-c.Done()
+					}))
+					It("C", rt.T("C"))
+					AfterAll(rt.T("after-all"))
+				})
+				ReportAfterSuite("report", func(report Report) {
+					rt.Run("report-after-suite")
+				})
+			})
+			Ω(success).Should(Equal(false))
+		}, NodeTimeout(time.Second*5))
+
+		It("times out the running spec, skips the remaining specs, and still runs cleanup and reporting nodes", func() {
+			Ω(rt).Should(HaveTracked("A", "B", "after-all", "report-after-suite"))
+			Ω(reporter.Did.Find("A")).Should(HavePassed())
+			Ω(reporter.Did.Find("B")).Should(HaveTimedOut("A suite timeout occurred"))
+			Ω(reporter.Did.Find("C")).Should(HaveBeenSkipped())
+		})
+
+		It("includes a progress report", func() {
+			Ω(reporter.Did.Find("B").Failure.ProgressReport.Message).Should(Equal("{{bold}}This is the Progress Report generated when the suite timeout occurred:{{/}}"))
+		})
+
+		It("produces a full summary and notes the special failure reason", func() {
+			Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(3), NWillRun(3), NPassed(1), NSkipped(1), NFailed(1)))
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(Equal([]string{"Suite Timeout Elapsed"}))
+		})
+	})
+
 	Describe("when aborted", func() {
 		BeforeEach(func() {
 			success, _ := RunFixture(CurrentSpecReport().LeafNodeText, func() {
@@ -142,6 +178,16 @@ var _ = Describe("Interrupts and Timeouts", func() {
 					Ω(reporter.Did.Find("A").Failure.ProgressReport.OtherGoroutines()).ShouldNot(BeEmpty())
 				})
 
+				It("records the node that was running and how long it had been running, but no budget since no timeout was in play", func() {
+					details := reporter.Did.Find("A").Failure.TimeoutDetails
+					Ω(details).ShouldNot(BeNil())
+					Ω(details.Timeout).Should(BeEmpty())
+					Ω(details.Budget).Should(BeZero())
+					Ω(details.Elapsed).Should(BeNumerically(">", 0))
+					Ω(details.NodeType).Should(Equal(types.NodeTypeIt))
+					Ω(details.NodeText).Should(Equal("A"))
+				})
+
 				It("emits a condensed ProgressReport with a shorter stack trace - note that it does not say anything about a leaked goroutine becuase the grace period is not enforced", func() {
 					Ω(reporter.ProgressReports).Should(HaveLen(1))
 					pr := reporter.ProgressReports[0]
@@ -881,6 +927,27 @@ var _ = Describe("Interrupts and Timeouts", func() {
 
 			Ω(reporter.End.SpecialSuiteFailureReasons).Should(Equal([]string{"Suite Timeout Elapsed"}))
 		})
+
+		It("attributes each timeout to the budget that was in play and the node that was running", func() {
+			details := reporter.Did.Find("A").Failure.TimeoutDetails
+			Ω(details.Timeout).Should(Equal("node"))
+			Ω(details.Budget).Should(Equal(time.Millisecond * 100))
+			Ω(details.Elapsed).Should(BeNumerically("~", time.Millisecond*100, 50*time.Millisecond))
+			Ω(details.NodeType).Should(Equal(types.NodeTypeBeforeEach))
+			Ω(details.NodeText).Should(Equal("when the node timeout is shortest"))
+
+			details = reporter.Did.Find("B").Failure.TimeoutDetails
+			Ω(details.Timeout).Should(Equal("spec"))
+			Ω(details.Budget).Should(Equal(time.Millisecond * 150))
+			Ω(details.Elapsed).Should(BeNumerically("~", time.Millisecond*150, 50*time.Millisecond))
+			Ω(details.NodeType).Should(Equal(types.NodeTypeBeforeEach))
+
+			details = reporter.Did.Find("C").Failure.TimeoutDetails
+			Ω(details.Timeout).Should(Equal("suite"))
+			Ω(details.Budget).Should(Equal(time.Millisecond * 450))
+			Ω(details.Elapsed).Should(BeNumerically("~", time.Millisecond*450, 50*time.Millisecond))
+			Ω(details.NodeType).Should(Equal(types.NodeTypeBeforeEach))
+		})
 	})
 
 	Describe("using timeouts with Gomega's Eventually", func() {
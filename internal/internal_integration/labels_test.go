@@ -123,4 +123,27 @@ var _ = Describe("Labels", func() {
 			Ω(report.PreRunStats.TotalSpecs).Should(Equal(2))
 		})
 	})
+
+	Context("when the suite is tagged with report-only suite labels", func() {
+		BeforeEach(func() {
+			reportedSuiteLabels = Label("books", "integration")
+			conf.LabelFilter = "!books"
+			success, _ := RunFixture("labelled tests", func() {
+				It("A", rt.T("A"))
+				It("B", rt.T("B"), Label("books"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("includes the labels in the suite report alongside the suite-level labels", func() {
+			Ω(reporter.Begin.SuiteLabels).Should(Equal([]string{"TopLevelLabel", "books", "integration"}))
+			Ω(reporter.End.SuiteLabels).Should(Equal([]string{"TopLevelLabel", "books", "integration"}))
+		})
+
+		It("does not apply the labels to the specs or use them when filtering", func() {
+			Ω(rt).Should(HaveTracked("A"))
+			Ω(reporter.Did.Find("A").Labels()).Should(BeEmpty())
+			Ω(reporter.Did.Find("B")).Should(HaveBeenSkipped())
+		})
+	})
 })
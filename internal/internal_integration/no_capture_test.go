@@ -0,0 +1,43 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the NoCapture decorator", func() {
+	var intercepting map[string]bool
+
+	BeforeEach(func() {
+		intercepting = map[string]bool{}
+		output := func(key string) func() {
+			return func() {
+				intercepting[key] = outputInterceptor.IsIntercepting()
+				outputInterceptor.AppendInterceptedOutput(key + "-output\n")
+			}
+		}
+
+		success, _ := RunFixture("no capture", func() {
+			Describe("container", func() {
+				It("A", output("A"))
+				It("B", NoCapture, output("B"))
+				Describe("nested", NoCapture, func() {
+					It("C", output("C"))
+				})
+				It("D", output("D"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("does not intercept output for NoCapture specs", func() {
+		Ω(intercepting).Should(Equal(map[string]bool{"A": true, "B": false, "C": false, "D": true}))
+	})
+
+	It("leaves CapturedStdOutErr empty for NoCapture specs while populating it for their siblings", func() {
+		Ω(reporter.Did.Find("A").CapturedStdOutErr).Should(Equal("A-output\n"))
+		Ω(reporter.Did.Find("B").CapturedStdOutErr).Should(BeEmpty())
+		Ω(reporter.Did.Find("C").CapturedStdOutErr).Should(BeEmpty())
+		Ω(reporter.Did.Find("D").CapturedStdOutErr).Should(Equal("D-output\n"))
+	})
+})
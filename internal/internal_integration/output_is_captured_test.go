@@ -0,0 +1,37 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputIsCaptured", func() {
+	var captured map[string]bool
+
+	BeforeEach(func() {
+		captured = map[string]bool{}
+		record := func(key string) func() {
+			return func() { captured[key] = OutputIsCaptured() }
+		}
+
+		RunFixture("output is captured", func() {
+			BeforeSuite(record("before-suite"))
+			Describe("container", func() {
+				captured["container"] = OutputIsCaptured()
+				BeforeEach(record("before-each"))
+				It("A", record("A"))
+			})
+		})
+	})
+
+	It("is true while a node is running and the output interceptor is intercepting", func() {
+		Ω(captured).Should(HaveKeyWithValue("before-suite", true))
+		Ω(captured).Should(HaveKeyWithValue("before-each", true))
+		Ω(captured).Should(HaveKeyWithValue("A", true))
+	})
+
+	It("is false outside of a running spec", func() {
+		Ω(captured).Should(HaveKeyWithValue("container", false))
+		Ω(outputInterceptor.IsIntercepting()).Should(BeFalse())
+	})
+})
@@ -81,6 +81,8 @@ var _ = Describe("Running tests in parallel", func() {
 				close(serialValidator)
 			}
 		}), rt.T("after-suite-2"))
+
+		AfterAllProcesses(rt.T("after-all-processes"))
 	}
 
 	BeforeEach(func() {
@@ -116,7 +118,7 @@ var _ = Describe("Running tests in parallel", func() {
 		exit1 := exitChannels[1] //avoid a race around exitChannels access in a separate goroutine
 		//now launch suite 1...
 		go func() {
-			success, _ := suite1.Run("proc 1", Label("TopLevelLabel"), "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, conf)
+			success, _ := suite1.Run("proc 1", Label("TopLevelLabel"), Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, conf)
 			finished <- success
 			close(exit1)
 		}()
@@ -125,7 +127,7 @@ var _ = Describe("Running tests in parallel", func() {
 		reporter2 = NewFakeReporter()
 		exit2 := exitChannels[2] //avoid a race around exitChannels access in a separate goroutine
 		go func() {
-			success, _ := suite2.Run("proc 2", Label("TopLevelLabel"), "/path/to/suite", internal.NewFailer(), reporter2, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, conf2)
+			success, _ := suite2.Run("proc 2", Label("TopLevelLabel"), Labels{}, "/path/to/suite", internal.NewFailer(), reporter2, writer, outputInterceptor, interruptHandler, client, noopProgressSignalRegistrar, conf2)
 			finished <- success
 			close(exit2)
 		}()
@@ -149,7 +151,7 @@ var _ = Describe("Running tests in parallel", func() {
 
 		allRuns := append(rt.TrackedRuns(), rt2.TrackedRuns()...)
 		Ω(allRuns).Should(ConsistOf(
-			"before-suite-1", "before-suite-2 floop", "after-suite-1", "after-suite-2", "before-suite-2 floop", "after-suite-1",
+			"before-suite-1", "before-suite-2 floop", "after-suite-1", "after-suite-2", "after-all-processes", "before-suite-2 floop", "after-suite-1",
 			"A", "B", "C", "D", "E", "F", "G", "H", "I", "OA", "OB", "OC", "OSA", "OSB", //all ran
 		))
 
@@ -168,6 +170,16 @@ var _ = Describe("Running tests in parallel", func() {
 		}
 	})
 
+	It("only runs AfterAllProcesses on proc 1, after the SynchronizedAfterSuite", func() {
+		Ω(rt).Should(HaveRun("after-all-processes"))
+		Ω(rt2).ShouldNot(HaveRun("after-all-processes"))
+		runs := rt.TrackedRuns()
+		Ω(runs[len(runs)-2:]).Should(Equal([]string{"after-suite-2", "after-all-processes"}))
+
+		Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeAfterAllProcesses)).Should(HavePassed())
+		Ω(reporter2.Did.FindByLeafNodeType(types.NodeTypeAfterAllProcesses)).Should(BeZero())
+	})
+
 	It("only runs serial tests on proc 1, after the other proc has finished", func() {
 		names := reporter.Did.Names()
 		Ω(names).Should(ContainElements("G", "H", "I", "OSA", "OSB"))
@@ -209,4 +221,19 @@ var _ = Describe("Running tests in parallel", func() {
 		Ω(reporter.End.SpecReports.WithLeafNodeType(types.NodeTypeIt).CountWithState(types.SpecStatePassed) +
 			reporter2.End.SpecReports.WithLeafNodeType(types.NodeTypeIt).CountWithState(types.SpecStatePassed)).Should(Equal(14))
 	})
+	It("computes the parallel efficiency of each process", func() {
+		efficiency1, efficiency2 := reporter.End.ParallelEfficiency, reporter2.End.ParallelEfficiency
+		Ω(efficiency1).ShouldNot(BeNil())
+		Ω(efficiency2).ShouldNot(BeNil())
+		Ω(efficiency1.NumProcesses).Should(Equal(2))
+		Ω(efficiency1.ProcessBusyTime).Should(HaveKey(1))
+		Ω(efficiency1.ProcessBusyTime).ShouldNot(HaveKey(2))
+		Ω(efficiency2.ProcessBusyTime).Should(HaveKey(2))
+		Ω(efficiency1.TotalSpecTime).Should(BeNumerically(">=", 50*time.Millisecond)) //proc 1 runs the 5 serial specs
+		Ω(efficiency1.WallTime).Should(Equal(reporter.End.RunTime))
+
+		combined := reporter.End.Add(reporter2.End).ParallelEfficiency
+		Ω(combined.TotalSpecTime).Should(Equal(efficiency1.TotalSpecTime + efficiency2.TotalSpecTime))
+		Ω(combined.ProcessBusyTime).Should(HaveLen(2))
+	})
 })
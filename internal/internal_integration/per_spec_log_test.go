@@ -0,0 +1,88 @@
+package internal_integration_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("writing per-spec logs", func() {
+	var logDir string
+	var success bool
+
+	BeforeEach(func() {
+		logDir = filepath.Join(GinkgoT().TempDir(), "logs")
+		conf.PerSpecLogDir = logDir
+	})
+
+	JustBeforeEach(func() {
+		success, _ = RunFixture("per-spec logs", func() {
+			It("is chatty", rt.T("chatty", func() {
+				writer.Println("hello")
+				writer.Println("world")
+			}))
+			It("is quiet", rt.T("quiet"))
+			It("fails chattily", rt.T("fails", func() {
+				writer.Println("about to fail")
+				F("boom")
+			}))
+			for i := 0; i < 2; i++ {
+				It("has a twin", rt.T("twin", func() {
+					writer.Println("twin")
+				}))
+			}
+		})
+	})
+
+	It("writes each spec's captured output to its own file and records the path", func() {
+		Ω(success).Should(BeFalse())
+		Ω(rt.TrackedRuns()).Should(ConsistOf("chatty", "quiet", "fails", "twin", "twin"))
+
+		chatty := reporter.Did.Find("is chatty")
+		Ω(filepath.Dir(chatty.LogFilePath)).Should(Equal(logDir))
+		Ω(filepath.Ext(chatty.LogFilePath)).Should(Equal(".log"))
+		Ω(os.ReadFile(chatty.LogFilePath)).Should(Equal([]byte("hello\nworld\n")))
+		Ω(chatty.CapturedGinkgoWriterOutput).Should(Equal("hello\nworld\n"))
+
+		fails := reporter.Did.Find("fails chattily")
+		Ω(fails).Should(HaveFailed("boom"))
+		Ω(os.ReadFile(fails.LogFilePath)).Should(Equal([]byte("about to fail\n")))
+	})
+
+	It("does not write a file for specs that captured no output", func() {
+		Ω(reporter.Did.Find("is quiet").LogFilePath).Should(BeEmpty())
+		Ω(os.ReadDir(logDir)).Should(HaveLen(4))
+	})
+
+	It("gives specs with the same text and location their own files", func() {
+		paths := []string{}
+		for _, report := range reporter.Did.WithLeafNodeType(types.NodeTypeIt) {
+			if report.LeafNodeText == "has a twin" {
+				paths = append(paths, report.LogFilePath)
+			}
+		}
+		Ω(paths).Should(HaveLen(2))
+		Ω(paths[0]).ShouldNot(Equal(paths[1]))
+		for _, path := range paths {
+			Ω(os.ReadFile(path)).Should(Equal([]byte("twin\n")))
+		}
+	})
+
+	Context("when the log directory cannot be created", func() {
+		BeforeEach(func() {
+			blocker := filepath.Join(GinkgoT().TempDir(), "blocker")
+			Ω(os.WriteFile(blocker, []byte("not a directory"), 0644)).Should(Succeed())
+			conf.PerSpecLogDir = filepath.Join(blocker, "logs")
+		})
+
+		It("fails the suite without running any specs", func() {
+			Ω(success).Should(BeFalse())
+			Ω(rt).Should(HaveTrackedNothing())
+			Ω(reporter.End.SpecialSuiteFailureReasons).Should(ContainElement(ContainSubstring("Failed to write per-spec logs")))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package internal_integration_test
+
+import (
+	"fmt"
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the OnlyOn and SkipOn decorators", func() {
+	var currentPlatform string
+	BeforeEach(func() {
+		currentPlatform = runtime.GOOS + "/" + runtime.GOARCH
+		success, _ := RunFixture("platform-specific specs", func() {
+			It("runs on this os", OnlyOn("not-an-os", runtime.GOOS), rt.T("this-os"))
+			It("runs on this platform", OnlyOn(currentPlatform), rt.T("this-platform"))
+			It("runs elsewhere", OnlyOn("not-an-os"), rt.T("elsewhere"))
+			It("skips on this os", SkipOn(runtime.GOOS), rt.T("skip-this-os"))
+			It("skips elsewhere", SkipOn("not-an-os"), rt.T("skip-elsewhere"))
+			Describe("ordered container", Ordered, OnlyOn(runtime.GOOS), func() {
+				BeforeAll(rt.T("before-all"))
+				It("A", rt.T("A"))
+				It("B", SkipOn(runtime.GOOS), rt.T("B"))
+				AfterAll(rt.T("after-all"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("only runs the specs that match the current platform", func() {
+		Ω(rt.TrackedRuns()).Should(ConsistOf("this-os", "this-platform", "skip-elsewhere", "before-all", "A", "after-all"))
+	})
+
+	It("marks the other specs as skipped and records why", func() {
+		Ω(reporter.Did.Find("runs on this os")).Should(HavePassed())
+		Ω(reporter.Did.Find("runs on this platform")).Should(HavePassed())
+		Ω(reporter.Did.Find("skips elsewhere")).Should(HavePassed())
+		Ω(reporter.Did.Find("runs elsewhere")).Should(HaveBeenSkippedWithMessage(fmt.Sprintf("Spec skipped because it only runs on not-an-os and this is %s", currentPlatform)))
+		Ω(reporter.Did.Find("skips on this os")).Should(HaveBeenSkippedWithMessage(fmt.Sprintf("Spec skipped because it is marked to skip on %s and this is %s", runtime.GOOS, currentPlatform)))
+	})
+
+	It("still runs AfterAll when the last spec in an ordered container is skipped", func() {
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("B")).Should(HaveBeenSkippedWithMessage(fmt.Sprintf("Spec skipped because it is marked to skip on %s and this is %s", runtime.GOOS, currentPlatform)))
+		Ω(rt.TrackedRuns()).Should(ContainElement("after-all"))
+	})
+})
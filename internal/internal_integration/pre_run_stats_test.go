@@ -0,0 +1,48 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("PreRunStats", func() {
+	BeforeEach(func() {
+		conf.FocusStrings = []string{"table"}
+		RunFixture("pre-run stats", func() {
+			BeforeSuite(rt.T("before-suite"))
+			BeforeEach(rt.T("outer-bef"))
+			Describe("container", func() {
+				JustBeforeEach(rt.T("jbef"))
+				AfterEach(rt.T("aft"))
+				It("A", rt.T("A"))
+				PIt("B", rt.T("B"))
+				DescribeTable("table", func(_ int) { rt.Run("table") },
+					Entry("1", 1),
+					Entry("2", 2),
+					Entry("3", 3),
+				)
+			})
+			Describe("empty container", func() {
+				BeforeEach(rt.T("unused-bef"))
+			})
+			Describe("ordered container", Ordered, func() {
+				BeforeAll(rt.T("bef-all"))
+				It("C", rt.T("C"))
+				It("D", rt.T("D"))
+			})
+			It("E", rt.T("E"))
+			AfterSuite(rt.T("after-suite"))
+		})
+	})
+
+	It("counts specs, containers, and setup nodes", func() {
+		Ω(reporter.Begin.PreRunStats).Should(Equal(types.PreRunStats{
+			TotalSpecs:       8,
+			SpecsThatWillRun: 3,
+			TotalContainers:  3,
+			TotalSetupNodes:  4,
+		}))
+	})
+})
@@ -306,6 +306,74 @@ var _ = Describe("Progress Reporting", func() {
 		})
 	})
 
+	Context("when ProgressReportSinks are configured", func() {
+		var sink *recordingProgressReportSink
+		BeforeEach(func() {
+			sink = &recordingProgressReportSink{}
+			conf.ProgressReportSinks = []types.ProgressReportSink{sink}
+			success, _ := RunFixture("emitting spec progress to a sink", func() {
+				It("A", func() {
+					time.Sleep(300 * time.Millisecond)
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("emits every polled progress report to the sink in addition to the reporter", func() {
+			Ω(len(sink.reports)).Should(BeNumerically(">", 1))
+			Ω(sink.reports).Should(Equal(reporter.ProgressReports))
+		})
+
+		It("emits reports at the configured cadence", func() {
+			Ω(sink.times[0].Sub(sink.reports[0].CurrentNodeStartTime)).Should(BeNumerically(">=", 100*time.Millisecond))
+			for i := 1; i < len(sink.times); i++ {
+				Ω(sink.times[i].Sub(sink.times[i-1])).Should(BeNumerically(">=", 50*time.Millisecond))
+			}
+		})
+	})
+
+	Context("when EmitCompletionProgressReports is enabled", func() {
+		var sink *recordingProgressReportSink
+		BeforeEach(func() {
+			sink = &recordingProgressReportSink{}
+			conf.ProgressReportSinks = []types.ProgressReportSink{sink}
+			conf.EmitCompletionProgressReports = true
+			success, _ := RunFixture("emitting completion progress reports", func() {
+				Describe("container", func() {
+					It("A", func() {
+						writer.Println("running A")
+					})
+					It("B", func() {
+						F("fail")
+					})
+					It("C", Pending, func() {})
+				})
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("emits a completion progress report to the sink for each spec that ran", func() {
+			Ω(sink.reports).Should(HaveLen(2))
+			Ω(sink.reports[0].ContainerHierarchyTexts).Should(Equal([]string{"container"}))
+			Ω(sink.reports[0].LeafNodeText).Should(Equal("A"))
+			Ω(sink.reports[0].Message).Should(HavePrefix("{{bold}}Spec passed after"))
+			Ω(sink.reports[0].CurrentNodeType).Should(Equal(types.NodeTypeIt))
+			Ω(sink.reports[0].CurrentNodeText).Should(Equal("A"))
+			Ω(sink.reports[0].CapturedGinkgoWriterOutput).Should(Equal("running A\n"))
+			Ω(sink.reports[0].Goroutines).ShouldNot(BeEmpty())
+			Ω(sink.reports[0].SpecStartTime).Should(Equal(reporter.Did.Find("A").StartTime))
+
+			Ω(sink.reports[1].LeafNodeText).Should(Equal("B"))
+			Ω(sink.reports[1].Message).Should(HavePrefix("{{bold}}Spec failed after"))
+		})
+
+		It("does not send completion progress reports to the reporter or record them in the spec report", func() {
+			Ω(reporter.ProgressReports).Should(BeEmpty())
+			Ω(reporter.Did.Find("A").ProgressReports).Should(BeEmpty())
+			Ω(reporter.Did.Find("B").ProgressReports).Should(BeEmpty())
+		})
+	})
+
 	Context("when a test takes longer then the overridden PollProgressAfter", func() {
 		BeforeEach(func() {
 			success, _ := RunFixture("emitting spec progress", func() {
@@ -502,3 +570,13 @@ var _ = Describe("Progress Reporting", func() {
 	})
 
 })
+
+type recordingProgressReportSink struct {
+	reports []types.ProgressReport
+	times   []time.Time
+}
+
+func (sink *recordingProgressReportSink) Emit(report types.ProgressReport) {
+	sink.reports = append(sink.reports, report)
+	sink.times = append(sink.times, time.Now())
+}
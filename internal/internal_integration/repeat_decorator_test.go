@@ -0,0 +1,83 @@
+package internal_integration_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the Repeat decorator", func() {
+	var success bool
+	JustBeforeEach(func() {
+		countSometimes := 0
+		success, _ = RunFixture("repeated specs", func() {
+			It("sometimes fails", Repeat(5), rt.T("sometimes-fails", func() {
+				countSometimes += 1
+				writer.Println("here we go")
+				if countSometimes == 2 || countSometimes == 4 {
+					F(fmt.Sprintf("fail - %d", countSometimes))
+				}
+			}))
+			Describe("container", Repeat(2), func() {
+				It("always passes", rt.T("always-passes"))
+				It("skips", rt.T("skips", func() {
+					Skip("skip")
+				}))
+			})
+		})
+	})
+
+	It("runs every spec the requested number of times, regardless of failures, but does not rerun skipped specs", func() {
+		Ω(rt).Should(HaveTracked(
+			"sometimes-fails", "sometimes-fails", "sometimes-fails", "sometimes-fails", "sometimes-fails",
+			"always-passes", "always-passes",
+			"skips",
+		))
+	})
+
+	It("fails the spec with the first failure if any iteration failed and records the per-iteration outcomes", func() {
+		Ω(success).Should(BeFalse())
+		report := reporter.Did.Find("sometimes fails")
+		Ω(report).Should(HaveFailed("fail - 2", NumAttempts(5), CapturedGinkgoWriterOutput("here we go\nhere we go\nhere we go\nhere we go\nhere we go\n")))
+		Ω(report.MaxRepeat).Should(Equal(5))
+		Ω(report.RepeatStates).Should(Equal([]types.SpecState{types.SpecStatePassed, types.SpecStateFailed, types.SpecStatePassed, types.SpecStateFailed, types.SpecStatePassed}))
+		passed, failed := report.RepeatCounts()
+		Ω(passed).Should(Equal(3))
+		Ω(failed).Should(Equal(2))
+
+		Ω(report.AdditionalFailures).Should(HaveLen(1))
+		Ω(report.AdditionalFailures[0].Failure.Message).Should(Equal("Failure recorded during iteration 4:\nfail - 4"))
+
+		Ω(report.Timeline()).Should(BeTimelineContaining(
+			BeSpecEvent(types.SpecEventSpecIteration, 1, "passed", TLWithOffset("here we go\n")),
+			BeSpecEvent(types.SpecEventSpecIteration, 2, "failed", TLWithOffset("here we go\nhere we go\n")),
+			BeSpecEvent(types.SpecEventSpecIteration, 3, "passed"),
+			BeSpecEvent(types.SpecEventSpecIteration, 4, "failed"),
+		))
+	})
+
+	It("passes specs when every iteration passes", func() {
+		report := reporter.Did.Find("always passes")
+		Ω(report).Should(HavePassed(NumAttempts(2)))
+		Ω(report.RepeatStates).Should(Equal([]types.SpecState{types.SpecStatePassed, types.SpecStatePassed}))
+	})
+
+	It("stops repeating skipped specs", func() {
+		Ω(reporter.Did.Find("skips")).Should(HaveBeenSkippedWithMessage("skip", NumAttempts(1)))
+	})
+
+	Context("when FlakeAttempts is configured", func() {
+		BeforeEach(func() {
+			conf.FlakeAttempts = 3
+		})
+
+		It("takes precedence", func() {
+			report := reporter.Did.Find("sometimes fails")
+			Ω(report).Should(HaveFailed("fail - 2", NumAttempts(5)))
+			Ω(report.MaxFlakeAttempts).Should(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ordering ReportAfterSuite nodes", func() {
+	cl := func(fileName string, lineNumber int) types.CodeLocation {
+		return types.CodeLocation{FileName: fileName, LineNumber: lineNumber}
+	}
+
+	Context("without priorities", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("report after suite ordering", func() {
+				It("A", rt.T("A"))
+				ReportAfterSuite("in c at 3", func(_ Report) { rt.Run("c:3") }, cl("/suite/c_test.go", 3))
+				ReportAfterSuite("in a at 20", func(_ Report) { rt.Run("a:20") }, cl("/suite/a_test.go", 20))
+				ReportAfterSuite("in a at 10", func(_ Report) { rt.Run("a:10") }, cl("/suite/a_test.go", 10))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs them in declaration order, sorted by file name and line number", func() {
+			Ω(rt).Should(HaveTracked("A", "a:10", "a:20", "c:3"))
+		})
+	})
+
+	Context("with priorities", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("report after suite priorities", func() {
+				It("A", rt.T("A"))
+				ReportAfterSuite("in a at 10", func(_ Report) { rt.Run("a:10") }, cl("/suite/a_test.go", 10))
+				ReportAfterSuite("last", func(_ Report) { rt.Run("last") }, cl("/suite/a_test.go", 1), ReportPriority(-1))
+				ReportAfterSuite("in b at 1", func(_ Report) { rt.Run("b:1") }, cl("/suite/b_test.go", 1))
+				ReportAfterSuite("first", func(_ Report) { rt.Run("first") }, cl("/suite/z_test.go", 99), ReportPriority(10))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs higher priority nodes first", func() {
+			Ω(rt).Should(HaveTracked("A", "first", "a:10", "b:1", "last"))
+		})
+	})
+})
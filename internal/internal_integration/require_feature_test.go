@@ -0,0 +1,70 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the RequireFeature decorator", func() {
+	var checkedFeatures []string
+
+	BeforeEach(func() {
+		checkedFeatures = []string{}
+	})
+
+	fixture := func(registerChecker bool) func() {
+		return func() {
+			if registerChecker {
+				SetFeatureChecker(func(feature string) bool {
+					checkedFeatures = append(checkedFeatures, feature)
+					return feature == "feature-on"
+				})
+			}
+			It("needs no features", rt.T("no-features"))
+			It("needs an enabled feature", RequireFeature("feature-on"), rt.T("enabled"))
+			It("needs a disabled feature", RequireFeature("feature-off"), rt.T("disabled"))
+			Describe("enabled container", Ordered, RequireFeature("feature-on"), func() {
+				BeforeAll(rt.T("before-all"))
+				It("also needs an enabled feature", rt.T("enabled-nested"))
+				It("also needs a disabled feature", RequireFeature("feature-off"), rt.T("disabled-nested"))
+				AfterAll(rt.T("after-all"))
+			})
+		}
+	}
+
+	Context("when a feature checker has been registered", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("feature checker", fixture(true))
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the specs whose features are on", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("no-features", "enabled", "before-all", "enabled-nested", "after-all"))
+			Ω(reporter.Did.Find("needs an enabled feature")).Should(HavePassed())
+			Ω(reporter.Did.Find("also needs an enabled feature")).Should(HavePassed())
+		})
+
+		It("skips the specs whose features are off and records why", func() {
+			reason := `Spec skipped because it requires feature "feature-off" and the feature is off`
+			Ω(reporter.Did.Find("needs a disabled feature")).Should(HaveBeenSkippedWithMessage(reason))
+			Ω(reporter.Did.Find("also needs a disabled feature")).Should(HaveBeenSkippedWithMessage(reason))
+		})
+
+		It("consults the checker before each decorated spec", func() {
+			Ω(checkedFeatures).Should(ConsistOf("feature-on", "feature-off", "feature-on", "feature-on", "feature-off"))
+		})
+	})
+
+	Context("when no feature checker has been registered", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("no feature checker", fixture(false))
+			Ω(success).Should(BeTrue())
+		})
+
+		It("considers every feature off", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("no-features"))
+			Ω(reporter.Did.Find("needs an enabled feature")).Should(HaveBeenSkippedWithMessage(`Spec skipped because it requires feature "feature-on" and no feature checker has been registered.  Call SetFeatureChecker before RunSpecs.`))
+		})
+	})
+})
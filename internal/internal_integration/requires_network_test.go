@@ -0,0 +1,92 @@
+package internal_integration_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the RequiresNetwork decorator", func() {
+	var probeErr error
+	var probedAddresses []string
+
+	BeforeEach(func() {
+		probeErr = nil
+		probedAddresses = []string{}
+		conf.NetworkProbe = func(address string) error {
+			probedAddresses = append(probedAddresses, address)
+			return probeErr
+		}
+	})
+
+	fixture := func() {
+		It("runs offline", rt.T("offline"))
+		It("needs the network", RequiresNetwork, rt.T("network-A"))
+		Describe("network container", Ordered, RequiresNetwork, func() {
+			BeforeAll(rt.T("before-all"))
+			It("also needs the network", rt.T("network-B"))
+			AfterAll(rt.T("after-all"))
+		})
+	}
+
+	Context("when the network is reachable", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("online", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the specs and only probes once", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("offline", "network-A", "before-all", "network-B", "after-all"))
+			Ω(probedAddresses).Should(Equal([]string{"proxy.golang.org:443"}))
+		})
+	})
+
+	Context("when the network is unreachable", func() {
+		BeforeEach(func() {
+			probeErr = errors.New("dial tcp: lookup example.com: no such host")
+			conf.NetworkProbeAddress = "example.com:443"
+			success, _ := RunFixture("offline", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("skips the specs that require the network and records why", func() {
+			Ω(rt).Should(HaveTracked("offline"))
+			Ω(probedAddresses).Should(Equal([]string{"example.com:443"}))
+			Ω(reporter.Did.Find("runs offline")).Should(HavePassed())
+			reason := "Spec skipped because it requires network access and example.com:443 is unreachable: dial tcp: lookup example.com: no such host\nPass --assume-online to run it anyway."
+			Ω(reporter.Did.Find("needs the network")).Should(HaveBeenSkippedWithMessage(reason))
+			Ω(reporter.Did.Find("also needs the network")).Should(HaveBeenSkippedWithMessage(reason))
+		})
+	})
+
+	Context("when configured to assume the network is reachable", func() {
+		BeforeEach(func() {
+			probeErr = errors.New("offline")
+			conf.AssumeOnline = true
+			success, _ := RunFixture("assume online", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the specs without probing", func() {
+			Ω(rt.TrackedRuns()).Should(ConsistOf("offline", "network-A", "before-all", "network-B", "after-all"))
+			Ω(probedAddresses).Should(BeEmpty())
+		})
+	})
+
+	Context("when no spec requires the network", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("no network specs", func() {
+				It("runs offline", rt.T("offline"))
+				It("needs the network but is filtered out", RequiresNetwork, Pending, rt.T("network"))
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("never probes", func() {
+			Ω(rt).Should(HaveTracked("offline"))
+			Ω(probedAddresses).Should(BeEmpty())
+		})
+	})
+})
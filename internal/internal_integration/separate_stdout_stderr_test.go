@@ -0,0 +1,54 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("separating captured stdout and stderr", func() {
+	fixture := func() {
+		Describe("container", func() {
+			It("A", func() {
+				outputInterceptor.AppendInterceptedOutput("A-stdout\n")
+				outputInterceptor.AppendInterceptedStderr("A-stderr\n")
+			})
+			It("B", NoCapture, func() {
+				outputInterceptor.AppendInterceptedOutput("B-stdout\n")
+				outputInterceptor.AppendInterceptedStderr("B-stderr\n")
+			})
+		})
+	}
+
+	Context("when SeparateStdoutStderr is set", func() {
+		BeforeEach(func() {
+			conf.SeparateStdoutStderr = true
+			success, _ := RunFixture("separate stdout and stderr", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("captures stdout and stderr separately while still capturing the combined output", func() {
+			Ω(reporter.Did.Find("A").CapturedStdOut).Should(Equal("A-stdout\n"))
+			Ω(reporter.Did.Find("A").CapturedStdErr).Should(Equal("A-stderr\n"))
+			Ω(reporter.Did.Find("A").CapturedStdOutErr).Should(Equal("A-stdout\nA-stderr\n"))
+		})
+
+		It("captures nothing for NoCapture specs", func() {
+			Ω(reporter.Did.Find("B").CapturedStdOut).Should(BeEmpty())
+			Ω(reporter.Did.Find("B").CapturedStdErr).Should(BeEmpty())
+			Ω(reporter.Did.Find("B").CapturedStdOutErr).Should(BeEmpty())
+		})
+	})
+
+	Context("when SeparateStdoutStderr is not set", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("combined stdout and stderr", fixture)
+			Ω(success).Should(BeTrue())
+		})
+
+		It("only captures the combined output", func() {
+			Ω(reporter.Did.Find("A").CapturedStdOutErr).Should(Equal("A-stdout\nA-stderr\n"))
+			Ω(reporter.Did.Find("A").CapturedStdOut).Should(BeEmpty())
+			Ω(reporter.Did.Find("A").CapturedStdErr).Should(BeEmpty())
+		})
+	})
+})
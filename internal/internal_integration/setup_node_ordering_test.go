@@ -0,0 +1,78 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adjusting the order of setup nodes", func() {
+	Context("when an inner setup node is hinted to run before an outer one", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("OrderBefore", func() {
+				Describe("outer", func() {
+					BeforeEach(rt.T("outer-bef"), HookName("outer"))
+					BeforeEach(rt.T("outer-bef-2"))
+					JustBeforeEach(rt.T("outer-jbef"), HookName("outer-jbef"))
+					Describe("inner", func() {
+						BeforeEach(rt.T("inner-bef"))
+						BeforeEach(rt.T("inner-bef-first"), OrderBefore("outer"))
+						JustBeforeEach(rt.T("inner-jbef-first"), OrderBefore("outer-jbef"))
+						It("A", rt.T("A"))
+					})
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the hinted node earlier than the default nesting order", func() {
+			Ω(rt).Should(HaveTracked("inner-bef-first", "outer-bef", "outer-bef-2", "inner-bef", "inner-jbef-first", "outer-jbef", "A"))
+		})
+	})
+
+	Context("when a setup node is hinted to run after another", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("OrderAfter", func() {
+				Describe("outer", func() {
+					BeforeEach(rt.T("outer-bef"), HookName("outer"))
+					BeforeEach(rt.T("outer-bef-2"))
+					Describe("inner", func() {
+						BeforeEach(rt.T("inner-bef"), OrderAfter("outer"))
+						It("A", rt.T("A"))
+					})
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("runs the hinted node immediately after its target", func() {
+			Ω(rt).Should(HaveTracked("outer-bef", "inner-bef", "outer-bef-2", "A"))
+		})
+	})
+
+	Context("when the target is not part of the spec's setup chain", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("missing target", func() {
+				Describe("outer", func() {
+					BeforeEach(rt.T("outer-bef"))
+					Describe("inner", func() {
+						BeforeEach(rt.T("inner-bef"), OrderBefore("does-not-exist"))
+						It("A", rt.T("A"))
+					})
+					Describe("sibling", func() {
+						BeforeEach(rt.T("sibling-bef"), HookName("sibling"))
+						It("B", rt.T("B"))
+					})
+				})
+			})
+			Ω(success).Should(BeTrue())
+		})
+
+		It("leaves the chain in nesting order", func() {
+			Ω(rt.TrackedRuns()).Should(Or(
+				Equal([]string{"outer-bef", "inner-bef", "A", "outer-bef", "sibling-bef", "B"}),
+				Equal([]string{"outer-bef", "sibling-bef", "B", "outer-bef", "inner-bef", "A"}),
+			))
+		})
+	})
+})
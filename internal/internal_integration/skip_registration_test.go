@@ -0,0 +1,46 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SkipRegistration", func() {
+	var cl types.CodeLocation
+	BeforeEach(func() {
+		success, _ := RunFixture("skipped registrations", func() {
+			Describe("container", func() {
+				It("A", rt.T("A"))
+				cl = types.NewCodeLocation(0)
+				SkipRegistration("not supported on this platform")
+			})
+			Describe("ordered container", Ordered, func() {
+				BeforeAll(rt.T("before-all"))
+				It("B", rt.T("B"))
+				SkipRegistration("requires cgroups")
+				AfterAll(rt.T("after-all"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("runs the registered specs", func() {
+		Ω(rt).Should(HaveTracked("A", "before-all", "B", "after-all"))
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("B")).Should(HavePassed())
+	})
+
+	It("reports a skipped placeholder spec that records the reason", func() {
+		placeholder := reporter.Did.Find("not supported on this platform")
+		Ω(placeholder).Should(HaveBeenSkippedWithMessage("Spec not registered: not supported on this platform"))
+		Ω(placeholder.ContainerHierarchyTexts).Should(Equal([]string{"container"}))
+		Ω(placeholder.LeafNodeType).Should(Equal(types.NodeTypeIt))
+		Ω(placeholder.LeafNodeLocation.FileName).Should(Equal(cl.FileName))
+		Ω(placeholder.LeafNodeLocation.LineNumber).Should(Equal(cl.LineNumber + 1))
+
+		Ω(reporter.Did.Find("requires cgroups")).Should(HaveBeenSkippedWithMessage("Spec not registered: requires cgroups"))
+		Ω(reporter.End).Should(BeASuiteSummary(true, NSpecs(4), NPassed(2), NSkipped(2)))
+	})
+})
@@ -0,0 +1,67 @@
+package internal_integration_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the CaptureStackIfSlowerThan decorator", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("slow spec stacks", func() {
+			Describe("container", CaptureStackIfSlowerThan(20*time.Millisecond), func() {
+				It("is slow", rt.T("slow", func() {
+					time.Sleep(100 * time.Millisecond)
+				}))
+				It("is fast", rt.T("fast"))
+				It("is slow but overridden", CaptureStackIfSlowerThan(time.Minute), rt.T("overridden", func() {
+					time.Sleep(50 * time.Millisecond)
+				}))
+				Context("with slow setup", func() {
+					BeforeEach(rt.T("slow-setup", func() {
+						time.Sleep(100 * time.Millisecond)
+					}))
+					It("has slow setup", rt.T("has-slow-setup"))
+				})
+			})
+			It("is slow but not decorated", rt.T("not-decorated", func() {
+				time.Sleep(50 * time.Millisecond)
+			}))
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("captures the spec's goroutine for specs that are still running after the threshold", func() {
+		stack := reporter.Did.Find("is slow").SlowSpecStack
+		Ω(stack).ShouldNot(BeNil())
+		Ω(stack.Threshold).Should(Equal(20 * time.Millisecond))
+		Ω(stack.CurrentNodeType).Should(Equal(types.NodeTypeIt))
+		Ω(stack.Goroutine.IsSpecGoroutine).Should(BeTrue())
+		Ω(stack.Goroutine.HasHighlights()).Should(BeTrue())
+	})
+
+	It("records the node that was running when the threshold elapsed", func() {
+		stack := reporter.Did.Find("has slow setup").SlowSpecStack
+		Ω(stack).ShouldNot(BeNil())
+		Ω(stack.CurrentNodeType).Should(Equal(types.NodeTypeBeforeEach))
+	})
+
+	It("does not capture a stack for specs that complete within the threshold or are not decorated", func() {
+		Ω(reporter.Did.Find("is fast").SlowSpecStack).Should(BeNil())
+		Ω(reporter.Did.Find("is slow but overridden").SlowSpecStack).Should(BeNil())
+		Ω(reporter.Did.Find("is slow but not decorated").SlowSpecStack).Should(BeNil())
+	})
+
+	It("serializes the captured stack", func() {
+		data, err := json.Marshal(reporter.Did.Find("is slow"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(data)).Should(ContainSubstring(`"SlowSpecStack":{"Threshold":20000000`))
+
+		data, err = json.Marshal(reporter.Did.Find("is fast"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(data)).ShouldNot(ContainSubstring("SlowSpecStack"))
+	})
+})
@@ -0,0 +1,48 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the DependsOn decorator", func() {
+	var success bool
+
+	BeforeEach(func() {
+		success, _ = RunFixture("spec dependencies", func() {
+			Describe("container", func() {
+				It("uses the account", DependsOn("creates an account"), rt.T("uses-account"))
+				It("is independent", rt.T("independent", func() {
+					F("fail", cl)
+				}))
+				It("creates an account", rt.T("creates-account"))
+				It("audits the account", DependsOn("uses the account", "creates an account"), rt.T("audits-account"))
+				It("deletes the widget", DependsOn("creates a widget"), rt.T("deletes-widget"))
+				It("creates a widget", rt.T("creates-widget", func() {
+					F("widget failure", cl)
+				}))
+				It("reports on the widget", DependsOn("deletes the widget"), rt.T("reports-on-widget"))
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("runs each spec after the specs it depends on and does not skip independent specs when a spec fails", func() {
+		Ω(rt).Should(HaveTracked("creates-account", "uses-account", "audits-account", "independent", "creates-widget"))
+	})
+
+	It("runs satisfied dependents", func() {
+		Ω(reporter.Did.Find("uses the account")).Should(HavePassed())
+		Ω(reporter.Did.Find("audits the account")).Should(HavePassed())
+	})
+
+	It("skips dependents whose prerequisites did not pass, and records the reason", func() {
+		Ω(reporter.Did.Find("creates a widget")).Should(HaveFailed("widget failure", cl))
+		Ω(reporter.Did.Find("deletes the widget")).Should(HaveBeenSkippedWithMessage(`Spec skipped because it depends on "creates a widget", which did not pass`))
+		Ω(reporter.Did.Find("reports on the widget")).Should(HaveBeenSkippedWithMessage(`Spec skipped because it depends on "deletes the widget", which did not pass`))
+		Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(7), NPassed(3), NFailed(2), NSkipped(2)))
+		Ω(reporter.Did.WithState(types.SpecStateSkipped).Names()).Should(ConsistOf("deletes the widget", "reports on the widget"))
+	})
+})
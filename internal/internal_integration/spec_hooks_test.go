@@ -0,0 +1,61 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spec Hooks", func() {
+	var beforeReports, afterReports []types.SpecReport
+
+	BeforeEach(func() {
+		beforeReports, afterReports = []types.SpecReport{}, []types.SpecReport{}
+		success, _ := RunFixture("spec hooks", func() {
+			RegisterSpecHooks(func(report types.SpecReport) {
+				rt.Run("before-hook " + report.LeafNodeText)
+				beforeReports = append(beforeReports, report)
+			}, func(report types.SpecReport) {
+				rt.Run("after-hook " + report.LeafNodeText)
+				afterReports = append(afterReports, report)
+			})
+			RegisterSpecHooks(nil, func(report types.SpecReport) {
+				rt.Run("second-after-hook " + report.LeafNodeText)
+			})
+			BeforeSuite(rt.T("before-suite"))
+			Describe("container", Ordered, func() {
+				ReportBeforeEach(func(report SpecReport) { rt.Run("report-before-each " + report.LeafNodeText) })
+				BeforeEach(rt.T("before-each"))
+				It("A", rt.T("A"))
+				It("B", rt.T("B", func() { F("fail") }))
+				It("C", Pending, rt.T("C"))
+				ReportAfterEach(func(report SpecReport) { rt.Run("report-after-each " + report.LeafNodeText) })
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("calls the hooks around each spec, outside of the spec's nodes and report nodes", func() {
+		Ω(rt).Should(HaveTracked(
+			"before-suite",
+			"before-hook A", "report-before-each A", "before-each", "A", "report-after-each A", "after-hook A", "second-after-hook A",
+			"before-hook B", "report-before-each B", "before-each", "B", "report-after-each B", "after-hook B", "second-after-hook B",
+			"before-hook C", "report-before-each C", "report-after-each C", "after-hook C", "second-after-hook C",
+		))
+	})
+
+	It("passes the planned report to the before hook and the completed report to the after hook", func() {
+		Ω(beforeReports).Should(HaveLen(3))
+		Ω(beforeReports[0].LeafNodeText).Should(Equal("A"))
+		Ω(beforeReports[0].State).Should(Equal(types.SpecStateInvalid))
+		Ω(beforeReports[2].State).Should(Equal(types.SpecStatePending))
+
+		Ω(afterReports).Should(HaveLen(3))
+		Ω(afterReports[0].State).Should(Equal(types.SpecStatePassed))
+		Ω(afterReports[0].RunTime).ShouldNot(BeZero())
+		Ω(afterReports[1].State).Should(Equal(types.SpecStateFailed))
+		Ω(afterReports[1].Failure.Message).Should(Equal("fail"))
+		Ω(afterReports[2].State).Should(Equal(types.SpecStatePending))
+	})
+})
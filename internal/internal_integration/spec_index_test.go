@@ -0,0 +1,49 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spec indices", func() {
+	BeforeEach(func() {
+		conf.SkipStrings = []string{"filtered"}
+		success, _ := RunFixture("spec indices", func() {
+			BeforeSuite(rt.T("before-suite"))
+			It("A", rt.T("A"))
+			It("filtered out", rt.T("filtered"))
+			Describe("container", func() {
+				It("B", rt.T("B"))
+				PIt("pending")
+				It("C", rt.T("C"))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("reports how many specs will be reported on when the suite begins", func() {
+		Ω(reporter.Begin.TotalSpecsToRun).Should(Equal(5))
+		Ω(reporter.End.TotalSpecsToRun).Should(Equal(5))
+	})
+
+	It("indexes every spec in the order it ran, including skipped and pending specs", func() {
+		indices := []int{}
+		for _, report := range reporter.Did.WithLeafNodeType(types.NodeTypeIt) {
+			indices = append(indices, report.Index)
+		}
+		Ω(indices).Should(Equal([]int{1, 2, 3, 4, 5}))
+	})
+
+	It("makes the last spec identifiable", func() {
+		last := reporter.Did.WithLeafNodeType(types.NodeTypeIt)[4]
+		Ω(last.Index).Should(Equal(reporter.Begin.TotalSpecsToRun))
+		for _, report := range reporter.Did.WithLeafNodeType(types.NodeTypeIt)[:4] {
+			Ω(report.Index).Should(BeNumerically("<", reporter.Begin.TotalSpecsToRun))
+		}
+	})
+
+	It("does not index suite-level nodes", func() {
+		Ω(reporter.Did.FindByLeafNodeType(types.NodeTypeBeforeSuite).Index).Should(BeZero())
+	})
+})
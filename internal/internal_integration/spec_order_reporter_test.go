@@ -0,0 +1,97 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("notifying reporters of the spec order", func() {
+	fixture := func() {
+		It("A", rt.T("A"))
+		It("B", rt.T("B"))
+		PIt("pending", rt.T("pending"))
+		Describe("ordered", Ordered, func() {
+			It("C", rt.T("C", func() {
+				F("fail", cl)
+			}))
+			It("D", rt.T("D"))
+		})
+		It("E", rt.T("E"))
+		It("F", Label("filtered-out"), rt.T("F"))
+	}
+
+	// the names of the specs that were reported via DidRun, in order, excluding the pending and filtered-out specs
+	didRunNames := func() []string {
+		names := []string{}
+		for _, name := range reporter.Did.Names() {
+			if name != "pending" && name != "F" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		conf.RandomizeAllSpecs = true
+		conf.LabelFilter = "!filtered-out"
+	})
+
+	Context("when the suite runs", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("spec order", fixture)
+			Ω(success).Should(BeFalse())
+		})
+
+		It("sends the reporter the specs that will run, in the order they run", func() {
+			Ω(reporter.Planned.Names()).Should(ConsistOf("A", "B", "C", "D", "E"))
+			Ω(reporter.Planned.Names()).Should(Equal(didRunNames()))
+		})
+
+		It("includes specs that are only skipped while the suite runs", func() {
+			Ω(reporter.Did.Find("D")).Should(HaveBeenSkippedWithMessage("Spec skipped because an earlier spec in an ordered container failed"))
+			Ω(reporter.Planned.Find("D").LeafNodeText).Should(Equal("D"))
+			Ω(rt.TrackedRuns()).ShouldNot(ContainElement("D"))
+		})
+	})
+
+	Context("when a FinalOrderHook is set", func() {
+		BeforeEach(func() {
+			conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+				out := []types.SpecReport{}
+				for _, report := range ordered {
+					if report.LeafNodeText == "E" {
+						out = append([]types.SpecReport{report}, out...)
+					} else {
+						out = append(out, report)
+					}
+				}
+				return out
+			}
+			RunFixture("spec order with hook", fixture)
+		})
+
+		It("sends the reporter the order returned by the hook", func() {
+			Ω(reporter.Planned.Names()[0]).Should(Equal("E"))
+			Ω(rt.TrackedRuns()[0]).Should(Equal("E"))
+			Ω(reporter.Planned.Names()).Should(Equal(didRunNames()))
+		})
+	})
+
+	Context("when the BeforeSuite fails", func() {
+		BeforeEach(func() {
+			success, _ := RunFixture("failed before suite", func() {
+				BeforeSuite(rt.T("before-suite", func() {
+					F("fail", cl)
+				}))
+				fixture()
+			})
+			Ω(success).Should(BeFalse())
+		})
+
+		It("does not notify the reporter", func() {
+			Ω(reporter.Planned).Should(BeNil())
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package internal_integration_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OnSpecRetry", func() {
+	type retry struct {
+		report  types.SpecReport
+		attempt int
+		failure types.Failure
+	}
+	var retries []retry
+
+	BeforeEach(func() {
+		retries = []retry{}
+		success, _ := RunFixture("spec retry callbacks", func() {
+			OnSpecRetry(func(report types.SpecReport, attempt int, failure types.Failure) {
+				rt.Run(fmt.Sprintf("retry %s #%d", report.LeafNodeText, attempt))
+				retries = append(retries, retry{report, attempt, failure})
+			})
+			Describe("container", Ordered, func() {
+				attempts := 0
+				It("A", rt.T("A", func() {
+					attempts += 1
+					if attempts < 3 {
+						F(fmt.Sprintf("fail %d", attempts))
+					}
+				}), FlakeAttempts(3))
+				It("B", rt.T("B"), FlakeAttempts(3))
+				It("C", rt.T("C", func() { F("always") }), FlakeAttempts(2))
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("calls the callback between attempts, before the spec is re-run", func() {
+		Ω(rt).Should(HaveTracked(
+			"A", "retry A #2", "A", "retry A #3", "A",
+			"B",
+			"C", "retry C #2", "C",
+		))
+	})
+
+	It("passes the upcoming attempt number and the failure from the previous attempt", func() {
+		Ω(retries).Should(HaveLen(3))
+		Ω(retries[0].attempt).Should(Equal(2))
+		Ω(retries[0].failure.Message).Should(Equal("fail 1"))
+		Ω(retries[0].report.State).Should(Equal(types.SpecStateFailed))
+		Ω(retries[0].report.NumAttempts).Should(Equal(1))
+
+		Ω(retries[1].attempt).Should(Equal(3))
+		Ω(retries[1].failure.Message).Should(Equal("fail 2"))
+		Ω(retries[1].report.NumAttempts).Should(Equal(2))
+
+		Ω(retries[2].attempt).Should(Equal(2))
+		Ω(retries[2].failure.Message).Should(Equal("always"))
+	})
+
+	It("does not change the outcome of the specs", func() {
+		Ω(reporter.Did.Find("A")).Should(HavePassed())
+		Ω(reporter.Did.Find("B")).Should(HavePassed())
+		Ω(reporter.Did.Find("C")).Should(HaveFailed("always"))
+	})
+})
@@ -0,0 +1,102 @@
+package internal_integration_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("the SpecTree in the suite report", func() {
+	BeforeEach(func() {
+		conf.LabelFilter = "!slow"
+		success, _ := RunFixture("spec tree", func() {
+			BeforeEach(rt.T("bef"))
+			Describe("container", Label("outer"), func() {
+				It("A", rt.T("A"))
+				PIt("B", rt.T("B"))
+				Context("slow context", Label("slow"), func() {
+					It("C", rt.T("C"))
+				})
+				DescribeTable("table", func(_ int) { rt.Run("table") },
+					Entry("1", 1),
+					Entry("2", Label("slow"), 2),
+				)
+			})
+			PDescribe("pending container", func() {
+				It("D", rt.T("D"))
+			})
+			It("E", Label("slow"), rt.T("E"))
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("includes every container and spec, in the order they were defined, whether or not they ran", func() {
+		tree := reporter.End.SpecTree
+		Ω(tree).Should(HaveLen(3))
+
+		container := tree[0]
+		Ω(container.NodeType).Should(Equal(types.NodeTypeContainer))
+		Ω(container.Text).Should(Equal("container"))
+		Ω(container.Labels).Should(Equal([]string{"outer"}))
+		Ω(container.CodeLocation).ShouldNot(BeZero())
+		Ω(container.WillRun()).Should(BeTrue())
+		Ω(container.Children).Should(HaveLen(4))
+
+		a, b, slowContext, table := container.Children[0], container.Children[1], container.Children[2], container.Children[3]
+		Ω(a.NodeType).Should(Equal(types.NodeTypeIt))
+		Ω(a.Text).Should(Equal("A"))
+		Ω(a.SpecID).Should(Equal(reporter.Did.Find("A").ID))
+		Ω(a.WillRun()).Should(BeTrue())
+		Ω(a.Children).Should(BeEmpty())
+
+		Ω(b.Text).Should(Equal("B"))
+		Ω(b.Pending).Should(BeTrue())
+		Ω(b.Skipped).Should(BeFalse())
+
+		Ω(slowContext.Text).Should(Equal("slow context"))
+		Ω(slowContext.Labels).Should(Equal([]string{"slow"}))
+		Ω(slowContext.Skipped).Should(BeTrue())
+		Ω(slowContext.Children).Should(HaveLen(1))
+		Ω(slowContext.Children[0].Text).Should(Equal("C"))
+		Ω(slowContext.Children[0].Skipped).Should(BeTrue())
+
+		Ω(table.Text).Should(Equal("table"))
+		Ω(table.WillRun()).Should(BeTrue())
+		Ω(table.Children).Should(HaveLen(2))
+		Ω(table.Children[0].WillRun()).Should(BeTrue())
+		Ω(table.Children[1].Labels).Should(Equal([]string{"slow"}))
+		Ω(table.Children[1].Skipped).Should(BeTrue())
+
+		pendingContainer := tree[1]
+		Ω(pendingContainer.Text).Should(Equal("pending container"))
+		Ω(pendingContainer.Pending).Should(BeTrue())
+		Ω(pendingContainer.Skipped).Should(BeFalse())
+		Ω(pendingContainer.Children[0].Pending).Should(BeTrue())
+
+		Ω(tree[2].Text).Should(Equal("E"))
+		Ω(tree[2].Skipped).Should(BeTrue())
+	})
+
+	It("records the maximum container nesting depth", func() {
+		Ω(reporter.Begin.MaxNestingDepth).Should(Equal(2))
+		Ω(reporter.End.MaxNestingDepth).Should(Equal(2))
+	})
+
+	It("is available before the suite runs", func() {
+		Ω(reporter.Begin.SpecTree).Should(Equal(reporter.End.SpecTree))
+	})
+
+	It("is included in the JSON report", func() {
+		data, err := json.Marshal(reporter.End)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(data)).Should(ContainSubstring(`"SpecTree":[{"NodeType":"Container","Text":"container"`))
+		Ω(string(data)).Should(ContainSubstring(`"Text":"slow context"`))
+
+		var decoded types.Report
+		Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+		Ω(decoded.SpecTree).Should(Equal(reporter.End.SpecTree))
+	})
+})
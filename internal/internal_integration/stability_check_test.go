@@ -0,0 +1,61 @@
+package internal_integration_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the StabilityCheck decorator", func() {
+	var comparisons []string
+
+	outputMustMatch := StabilityCheck(func(prev types.SpecReport, curr types.SpecReport) error {
+		comparisons = append(comparisons, fmt.Sprintf("%d:%q vs %d:%q", prev.NumAttempts, prev.CapturedGinkgoWriterOutput, curr.NumAttempts, curr.CapturedGinkgoWriterOutput))
+		if prev.CapturedGinkgoWriterOutput != curr.CapturedGinkgoWriterOutput {
+			return fmt.Errorf("output changed")
+		}
+		return nil
+	})
+
+	BeforeEach(func() {
+		comparisons = []string{}
+		counter := 0
+		success, _ := RunFixture("stability check", func() {
+			Describe("container", outputMustMatch, func() {
+				It("stable", MustPassRepeatedly(3), rt.T("stable", func() {
+					writer.Print("same")
+				}))
+				It("unstable", MustPassRepeatedly(3), rt.T("unstable", func() {
+					counter += 1
+					writer.Printf("run %d", counter)
+				}))
+				It("not repeated", rt.T("not-repeated", func() {
+					writer.Print("once")
+				}))
+			})
+		})
+		Ω(success).Should(BeFalse())
+	})
+
+	It("compares the reports of consecutive passing attempts, restricting each report's output to its own attempt", func() {
+		Ω(comparisons).Should(Equal([]string{
+			`1:"same" vs 2:"same"`,
+			`2:"same" vs 3:"same"`,
+			`1:"run 1" vs 2:"run 2"`,
+		}))
+	})
+
+	It("passes specs whose attempts pass the stability check", func() {
+		Ω(reporter.Did.Find("stable")).Should(HavePassed(NumAttempts(3)))
+		Ω(reporter.Did.Find("not repeated")).Should(HavePassed(NumAttempts(1)))
+	})
+
+	It("fails specs whose passing attempts fail the stability check, without running them again", func() {
+		Ω(reporter.Did.Find("unstable")).Should(HaveFailed("Spec was unstable: attempts 1 and 2 both passed but the StabilityCheck failed:\noutput changed", NumAttempts(2)))
+		Ω(rt.TrackedRuns()).Should(Equal([]string{"stable", "stable", "stable", "unstable", "unstable", "not-repeated"}))
+		Ω(reporter.End).Should(BeASuiteSummary(false, NSpecs(3), NPassed(2), NFailed(1)))
+	})
+})
@@ -2,11 +2,13 @@ package internal_integration_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/onsi/ginkgo/v2/internal"
 	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
 	"github.com/onsi/ginkgo/v2/types"
 )
@@ -168,6 +170,37 @@ var _ = Describe("Table driven tests", func() {
 			})
 		})
 
+		Describe("entry description format strings that do not match the entry's parameters", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("table with mismatched entry description format strings", func() {
+					DescribeTable("hello",
+						func(n int, s string) { rt.Run(CurrentSpecReport().LeafNodeText) },
+						EntryDescription("case %d: %s"),
+						Entry(nil, 1, "one"),
+						Entry(nil, 2, "two%!"),
+						Entry(nil, 3, "three"),
+						Entry(EntryDescription("case %d"), 4, "four"),
+						Entry(EntryDescription("case %d: %s, %s"), 5, "five"),
+						Entry(EntryDescription("case %s: %d"), 6, "six"),
+					)
+				})
+				Ω(success).Should(BeFalse())
+			})
+
+			It("renders the template for every entry whose parameters match", func() {
+				Ω(rt).Should(HaveTracked("case 1: one", "case 2: two%!", "case 3: three"))
+			})
+
+			It("fails the mismatched entries with a clear error", func() {
+				failures := reporter.Did.WithState(types.SpecStatePanicked)
+				Ω(failures).Should(HaveLen(3))
+				Ω(failures[0].Failure.ForwardedPanic).Should(ContainSubstring("EntryDescription does not match the Entry's parameters"))
+				Ω(failures[0].Failure.ForwardedPanic).Should(ContainSubstring("case 4%!(EXTRA string=four)"))
+				Ω(failures[1].Failure.ForwardedPanic).Should(ContainSubstring("case 5: five, %!s(MISSING)"))
+				Ω(failures[2].Failure.ForwardedPanic).Should(ContainSubstring("case %!s(int=6): %!d(string=six)"))
+			})
+		})
+
 		Describe("entries with entry description functions and entry description format strings", func() {
 			BeforeEach(func() {
 				entryDescriptionBuilder := func(a, b int) string {
@@ -403,6 +436,86 @@ var _ = Describe("Table driven tests", func() {
 		})
 	})
 
+	Describe("loading entries from a file", func() {
+		type tableCase struct {
+			Name string `json:"name" yaml:"name"`
+			A    int    `json:"a" yaml:"a"`
+			B    int    `json:"b" yaml:"b"`
+		}
+		entryFactory := func(c tableCase) TableEntry {
+			return Entry(c.Name, c.A, c.B)
+		}
+
+		Context("with a YAML file", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("table from YAML", func() {
+					DescribeTable("from yaml", bodyFunc, TableFromFile("testdata/table_cases.yaml", entryFactory))
+				})
+				Ω(success).Should(BeFalse())
+			})
+
+			It("expands each record into an entry", func() {
+				Ω(rt).Should(HaveTracked("A", "B", "C"))
+				Ω(reporter.Did.Find("A")).Should(HavePassed())
+				Ω(reporter.Did.Find("B")).Should(HaveFailed("fail"))
+				Ω(reporter.Did.Find("C")).Should(HavePassed())
+			})
+
+			It("points each entry's code location at the fixture file and record", func() {
+				path, err := filepath.Abs("testdata/table_cases.yaml")
+				Ω(err).ShouldNot(HaveOccurred())
+				location := reporter.Did.Find("B").LeafNodeLocation
+				Ω(location.FileName).Should(Equal(path))
+				Ω(location.LineNumber).Should(Equal(5))
+				Ω(location.String()).Should(Equal(path + ":5 (record #1)"))
+				Ω(reporter.Did.Find("C").LeafNodeLocation.LineNumber).Should(Equal(8))
+			})
+		})
+
+		Context("with a JSON file", func() {
+			BeforeEach(func() {
+				success, _ := RunFixture("table from JSON", func() {
+					DescribeTable("from json", bodyFunc, TableFromFile("testdata/table_cases.json", entryFactory))
+				})
+				Ω(success).Should(BeFalse())
+			})
+
+			It("expands each record into an entry", func() {
+				Ω(rt).Should(HaveTracked("A", "B", "C"))
+				Ω(reporter.Did.Find("A")).Should(HavePassed())
+				Ω(reporter.Did.Find("B")).Should(HaveFailed("fail"))
+				Ω(reporter.Did.Find("C")).Should(HavePassed())
+			})
+
+			It("points each entry's code location at the fixture file and record", func() {
+				path, err := filepath.Abs("testdata/table_cases.json")
+				Ω(err).ShouldNot(HaveOccurred())
+				location := reporter.Did.Find("B").LeafNodeLocation
+				Ω(location.FileName).Should(Equal(path))
+				Ω(location.LineNumber).Should(Equal(3))
+				Ω(location.String()).Should(Equal(path + ":3 (record #1)"))
+				Ω(reporter.Did.Find("C").LeafNodeLocation.LineNumber).Should(Equal(4))
+			})
+		})
+
+		Context("with a file that cannot be loaded", func() {
+			It("fails tree construction with an error that includes the path and the location of the call", func() {
+				suite := internal.NewSuite()
+				var cl types.CodeLocation
+				WithSuite(suite, func() {
+					DescribeTable("from a missing file", bodyFunc, TableFromFile("testdata/missing.json", entryFactory))
+					cl = types.NewCodeLocation(0)
+					cl.LineNumber -= 1
+				})
+				err := suite.BuildTree()
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("testdata/missing.json"))
+				Ω(err.(types.GinkgoError).CodeLocation.FileName).Should(Equal(cl.FileName))
+				Ω(err.(types.GinkgoError).CodeLocation.LineNumber).Should(Equal(cl.LineNumber))
+			})
+		})
+	})
+
 	Describe("support for FlakyAttempts decorators", func() {
 		BeforeEach(func() {
 			success, _ := RunFixture("flaky table", func() {
@@ -0,0 +1,29 @@
+package internal_integration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("the VerboseOutput decorator", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("verbose", func() {
+			Describe("container", func() {
+				It("A", rt.T("A"))
+				It("B", VerboseOutput, rt.T("B"))
+				Describe("nested", VerboseOutput, func() {
+					It("C", rt.T("C"))
+				})
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("marks the reports of VerboseOutput specs, and of specs in VerboseOutput containers, as verbose", func() {
+		Ω(rt).Should(HaveTracked("A", "B", "C"))
+		Ω(reporter.Did.Find("A").IsVerbose).Should(BeFalse())
+		Ω(reporter.Did.Find("B").IsVerbose).Should(BeTrue())
+		Ω(reporter.Did.Find("C").IsVerbose).Should(BeTrue())
+	})
+})
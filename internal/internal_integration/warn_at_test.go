@@ -0,0 +1,52 @@
+package internal_integration_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WarnAt", func() {
+	BeforeEach(func() {
+		success, _ := RunFixture("warning before a timeout", func() {
+			Describe("container", func() {
+				It("crosses the threshold", rt.TSC("crosses", func(ctx SpecContext) {
+					time.Sleep(150 * time.Millisecond)
+				}), NodeTimeout(500*time.Millisecond), WarnAt(0.2))
+
+				It("finishes well within its budget", rt.TSC("fast", func(ctx SpecContext) {}), NodeTimeout(time.Second), WarnAt(0.9))
+
+				It("warns against the spec timeout", rt.TSC("spec-timeout", func(ctx SpecContext) {
+					time.Sleep(150 * time.Millisecond)
+				}), SpecTimeout(500*time.Millisecond), WarnAt(0.2))
+			})
+		})
+		Ω(success).Should(BeTrue())
+	})
+
+	It("runs every spec to completion", func() {
+		Ω(rt).Should(HaveTracked("crosses", "fast", "spec-timeout"))
+		Ω(reporter.Did.Find("crosses the threshold")).Should(HavePassed())
+		Ω(reporter.Did.Find("finishes well within its budget")).Should(HavePassed())
+		Ω(reporter.Did.Find("warns against the spec timeout")).Should(HavePassed())
+	})
+
+	It("emits a warning progress report for nodes that cross the threshold", func() {
+		Ω(reporter.Did.Find("crosses the threshold").ProgressReports).Should(HaveLen(1))
+		pr := reporter.Did.Find("crosses the threshold").ProgressReports[0]
+		Ω(pr.Message).Should(HavePrefix("{{bold}}{{orange}}[TIMEOUT WARNING]{{/}}"))
+		Ω(pr.Message).Should(ContainSubstring("used 20% of its time budget and will hit the node timeout in"))
+		Ω(pr.CurrentNodeType).Should(Equal(types.NodeTypeIt))
+		Ω(pr.LeafNodeText).Should(Equal("crosses the threshold"))
+
+		Ω(reporter.Did.Find("warns against the spec timeout").ProgressReports).Should(HaveLen(1))
+		Ω(reporter.Did.Find("warns against the spec timeout").ProgressReports[0].Message).Should(ContainSubstring("will hit the spec timeout in"))
+	})
+
+	It("does not emit a warning for nodes that finish before the threshold", func() {
+		Ω(reporter.Did.Find("finishes well within its budget").ProgressReports).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// DEFAULT_NETWORK_PROBE_ADDRESS is dialed to decide whether specs decorated with RequiresNetwork can run when SuiteConfig.NetworkProbeAddress is empty
+const DEFAULT_NETWORK_PROBE_ADDRESS = "proxy.golang.org:443"
+
+// NETWORK_PROBE_TIMEOUT bounds how long Ginkgo waits for the network probe to connect
+const NETWORK_PROBE_TIMEOUT = 2 * time.Second
+
+func dialNetworkProbe(address string) error {
+	conn, err := net.DialTimeout("tcp", address, NETWORK_PROBE_TIMEOUT)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+/*
+networkSkipReason returns the reason specs decorated with RequiresNetwork should be skipped - or "" if they should run.
+
+The network is only probed the first time a spec decorated with RequiresNetwork is about to run and the result is reused for the rest of the suite.  Nothing is probed during a dry run or when the suite is configured to AssumeOnline.
+*/
+func (suite *Suite) networkSkipReason() string {
+	if suite.config.AssumeOnline || suite.config.DryRun {
+		return ""
+	}
+	address := suite.config.NetworkProbeAddress
+	if address == "" {
+		address = DEFAULT_NETWORK_PROBE_ADDRESS
+	}
+	if !suite.networkProbed {
+		probe := suite.config.NetworkProbe
+		if probe == nil {
+			probe = dialNetworkProbe
+		}
+		suite.networkProbeErr = probe(address)
+		suite.networkProbed = true
+	}
+	if suite.networkProbeErr == nil {
+		return ""
+	}
+	return fmt.Sprintf("Spec skipped because it requires network access and %s is unreachable: %s\nPass --assume-online to run it anyway.", address, suite.networkProbeErr.Error())
+}
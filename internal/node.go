@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"sync"
@@ -53,14 +54,32 @@ type Node struct {
 	MarkedOrdered           bool
 	MarkedContinueOnFailure bool
 	MarkedOncePerOrdered    bool
+	MarkedNoCapture         bool
+	MarkedRequiresNetwork   bool
+	MarkedInformational     bool
+	MarkedVerbose           bool
 	FlakeAttempts           int
 	MustPassRepeatedly      int
+	Repeat                  int
+	ExpectedDuration        time.Duration
+	CaptureStackThreshold   time.Duration
+	StabilityCheck          StabilityCheck
 	Labels                  Labels
+	OnlyOnPlatforms         OnlyOnPlatforms
+	SkipOnPlatforms         SkipOnPlatforms
+	RequiredFeatures        RequiredFeatures
+	RegistrationSkipReason  string
+	SpecDependencies        SpecDependencies
 	PollProgressAfter       time.Duration
 	PollProgressInterval    time.Duration
 	NodeTimeout             time.Duration
 	SpecTimeout             time.Duration
 	GracePeriod             time.Duration
+	WarnAt                  float64
+	HookName                string
+	OrderBefore             string
+	OrderAfter              string
+	ReportPriority          int
 
 	NodeIDWhereCleanupWasGenerated uint
 }
@@ -73,6 +92,10 @@ type orderedType bool
 type continueOnFailureType bool
 type honorsOrderedType bool
 type suppressProgressReporting bool
+type noCaptureType bool
+type requiresNetworkType bool
+type informationalType bool
+type verboseOutputType bool
 
 const Focus = focusType(true)
 const Pending = pendingType(true)
@@ -81,17 +104,35 @@ const Ordered = orderedType(true)
 const ContinueOnFailure = continueOnFailureType(true)
 const OncePerOrdered = honorsOrderedType(true)
 const SuppressProgressReporting = suppressProgressReporting(true)
+const NoCapture = noCaptureType(true)
+const RequiresNetwork = requiresNetworkType(true)
+const Informational = informationalType(true)
+const VerboseOutput = verboseOutputType(true)
 
 type FlakeAttempts uint
 type MustPassRepeatedly uint
+type Repeat uint
+type ExpectedDuration time.Duration
+type CaptureStackIfSlowerThan time.Duration
+type StabilityCheck func(prev types.SpecReport, curr types.SpecReport) error
 type Offset uint
 type Done chan<- interface{} // Deprecated Done Channel for asynchronous testing
 type Labels []string
+type OnlyOnPlatforms []string
+type SkipOnPlatforms []string
+type RequiredFeatures []string
+type RegistrationSkipReason string
+type SpecDependencies []string
 type PollProgressInterval time.Duration
 type PollProgressAfter time.Duration
 type NodeTimeout time.Duration
 type SpecTimeout time.Duration
 type GracePeriod time.Duration
+type WarnAt float64
+type HookName string
+type OrderBefore string
+type OrderAfter string
+type ReportPriority int
 
 func (l Labels) MatchesLabelFilter(query string) bool {
 	return types.MustParseLabelFilter(query)(l)
@@ -146,12 +187,38 @@ func isDecoration(arg interface{}) bool {
 		return true
 	case t == reflect.TypeOf(SuppressProgressReporting):
 		return true
+	case t == reflect.TypeOf(NoCapture):
+		return true
+	case t == reflect.TypeOf(RequiresNetwork):
+		return true
+	case t == reflect.TypeOf(Informational):
+		return true
+	case t == reflect.TypeOf(VerboseOutput):
+		return true
 	case t == reflect.TypeOf(FlakeAttempts(0)):
 		return true
 	case t == reflect.TypeOf(MustPassRepeatedly(0)):
 		return true
+	case t == reflect.TypeOf(Repeat(0)):
+		return true
+	case t == reflect.TypeOf(ExpectedDuration(0)):
+		return true
+	case t == reflect.TypeOf(CaptureStackIfSlowerThan(0)):
+		return true
+	case t == reflect.TypeOf(StabilityCheck(nil)):
+		return true
 	case t == reflect.TypeOf(Labels{}):
 		return true
+	case t == reflect.TypeOf(OnlyOnPlatforms{}):
+		return true
+	case t == reflect.TypeOf(SkipOnPlatforms{}):
+		return true
+	case t == reflect.TypeOf(RequiredFeatures{}):
+		return true
+	case t == reflect.TypeOf(RegistrationSkipReason("")):
+		return true
+	case t == reflect.TypeOf(SpecDependencies{}):
+		return true
 	case t == reflect.TypeOf(PollProgressInterval(0)):
 		return true
 	case t == reflect.TypeOf(PollProgressAfter(0)):
@@ -162,6 +229,16 @@ func isDecoration(arg interface{}) bool {
 		return true
 	case t == reflect.TypeOf(GracePeriod(0)):
 		return true
+	case t == reflect.TypeOf(WarnAt(0)):
+		return true
+	case t == reflect.TypeOf(HookName("")):
+		return true
+	case t == reflect.TypeOf(OrderBefore("")):
+		return true
+	case t == reflect.TypeOf(OrderAfter("")):
+		return true
+	case t == reflect.TypeOf(ReportPriority(0)):
+		return true
 	case t.Kind() == reflect.Slice && isSliceOfDecorations(arg):
 		return true
 	default:
@@ -262,6 +339,26 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 			}
 		case t == reflect.TypeOf(SuppressProgressReporting):
 			deprecationTracker.TrackDeprecation(types.Deprecations.SuppressProgressReporting())
+		case t == reflect.TypeOf(NoCapture):
+			node.MarkedNoCapture = bool(arg.(noCaptureType))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "NoCapture"))
+			}
+		case t == reflect.TypeOf(RequiresNetwork):
+			node.MarkedRequiresNetwork = bool(arg.(requiresNetworkType))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "RequiresNetwork"))
+			}
+		case t == reflect.TypeOf(Informational):
+			node.MarkedInformational = bool(arg.(informationalType))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Informational"))
+			}
+		case t == reflect.TypeOf(VerboseOutput):
+			node.MarkedVerbose = bool(arg.(verboseOutputType))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "VerboseOutput"))
+			}
 		case t == reflect.TypeOf(FlakeAttempts(0)):
 			node.FlakeAttempts = int(arg.(FlakeAttempts))
 			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
@@ -272,6 +369,26 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "MustPassRepeatedly"))
 			}
+		case t == reflect.TypeOf(Repeat(0)):
+			node.Repeat = int(arg.(Repeat))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Repeat"))
+			}
+		case t == reflect.TypeOf(ExpectedDuration(0)):
+			node.ExpectedDuration = time.Duration(arg.(ExpectedDuration))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "ExpectedDuration"))
+			}
+		case t == reflect.TypeOf(CaptureStackIfSlowerThan(0)):
+			node.CaptureStackThreshold = time.Duration(arg.(CaptureStackIfSlowerThan))
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "CaptureStackIfSlowerThan"))
+			}
+		case t == reflect.TypeOf(StabilityCheck(nil)):
+			node.StabilityCheck = arg.(StabilityCheck)
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "StabilityCheck"))
+			}
 		case t == reflect.TypeOf(PollProgressAfter(0)):
 			node.PollProgressAfter = time.Duration(arg.(PollProgressAfter))
 			if nodeType.Is(types.NodeTypeContainer) {
@@ -297,6 +414,33 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 			if nodeType.Is(types.NodeTypeContainer) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "GracePeriod"))
 			}
+		case t == reflect.TypeOf(HookName("")):
+			node.HookName = string(arg.(HookName))
+			if !nodeType.Is(types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "HookName"))
+			}
+		case t == reflect.TypeOf(OrderBefore("")):
+			node.OrderBefore = string(arg.(OrderBefore))
+			if !nodeType.Is(types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "OrderBefore"))
+			}
+		case t == reflect.TypeOf(OrderAfter("")):
+			node.OrderAfter = string(arg.(OrderAfter))
+			if !nodeType.Is(types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "OrderAfter"))
+			}
+		case t == reflect.TypeOf(ReportPriority(0)):
+			node.ReportPriority = int(arg.(ReportPriority))
+			if !nodeType.Is(types.NodeTypeReportAfterSuite) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "ReportPriority"))
+			}
+		case t == reflect.TypeOf(WarnAt(0)):
+			node.WarnAt = float64(arg.(WarnAt))
+			if nodeType.Is(types.NodeTypeContainer) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "WarnAt"))
+			} else if node.WarnAt <= 0 || node.WarnAt >= 1 {
+				appendError(types.GinkgoErrors.InvalidWarnAtFraction(node.CodeLocation, node.WarnAt))
+			}
 		case t == reflect.TypeOf(Labels{}):
 			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
 				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "Label"))
@@ -309,6 +453,31 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 					appendError(err)
 				}
 			}
+		case t == reflect.TypeOf(OnlyOnPlatforms{}):
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "OnlyOn"))
+			}
+			node.OnlyOnPlatforms = append(node.OnlyOnPlatforms, arg.(OnlyOnPlatforms)...)
+		case t == reflect.TypeOf(SkipOnPlatforms{}):
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "SkipOn"))
+			}
+			node.SkipOnPlatforms = append(node.SkipOnPlatforms, arg.(SkipOnPlatforms)...)
+		case t == reflect.TypeOf(RequiredFeatures{}):
+			if !nodeType.Is(types.NodeTypesForContainerAndIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "RequireFeature"))
+			}
+			node.RequiredFeatures = append(node.RequiredFeatures, arg.(RequiredFeatures)...)
+		case t == reflect.TypeOf(RegistrationSkipReason("")):
+			if !nodeType.Is(types.NodeTypeIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "RegistrationSkipReason"))
+			}
+			node.RegistrationSkipReason = string(arg.(RegistrationSkipReason))
+		case t == reflect.TypeOf(SpecDependencies{}):
+			if !nodeType.Is(types.NodeTypeIt) {
+				appendError(types.GinkgoErrors.InvalidDecoratorForNodeType(node.CodeLocation, nodeType, "DependsOn"))
+			}
+			node.SpecDependencies = append(node.SpecDependencies, arg.(SpecDependencies)...)
 		case t.Kind() == reflect.Func:
 			if nodeType.Is(types.NodeTypeContainer) {
 				if node.Body != nil {
@@ -400,6 +569,10 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 		appendError(types.GinkgoErrors.InvalidDeclarationOfFocusedAndPending(node.CodeLocation, nodeType))
 	}
 
+	if node.OrderBefore != "" && node.OrderAfter != "" {
+		appendError(types.GinkgoErrors.InvalidDeclarationOfOrderBeforeAndOrderAfter(node.CodeLocation, nodeType))
+	}
+
 	if node.MarkedContinueOnFailure && !node.MarkedOrdered {
 		appendError(types.GinkgoErrors.InvalidContinueOnFailureDecoration(node.CodeLocation))
 	}
@@ -430,6 +603,10 @@ func NewNode(deprecationTracker *types.DeprecationTracker, nodeType types.NodeTy
 		appendError(types.GinkgoErrors.InvalidDeclarationOfFlakeAttemptsAndMustPassRepeatedly(node.CodeLocation, nodeType))
 	}
 
+	if node.Repeat > 0 && (node.FlakeAttempts > 0 || node.MustPassRepeatedly > 0) {
+		appendError(types.GinkgoErrors.InvalidDeclarationOfRepeatWithFlakeAttemptsOrMustPassRepeatedly(node.CodeLocation, nodeType))
+	}
+
 	if len(errors) > 0 {
 		return Node{}, errors
 	}
@@ -761,6 +938,64 @@ func (n Nodes) SortedByAscendingNestingLevel() Nodes {
 	return out
 }
 
+/*
+SortedByReportPriority returns a copy of n sorted into the order in which ReportAfterSuite nodes run: by descending ReportPriority and then in declaration order - i.e. by file name and line number.
+Nodes with a custom code location (e.g. those autogenerated by Ginkgo) run after the user-declared nodes of the same priority, in the order they were registered.
+*/
+func (n Nodes) SortedByReportPriority() Nodes {
+	out := make(Nodes, len(n))
+	copy(out, n)
+	sort.SliceStable(out, func(i int, j int) bool {
+		if out[i].ReportPriority != out[j].ReportPriority {
+			return out[i].ReportPriority > out[j].ReportPriority
+		}
+		iCustom, jCustom := out[i].CodeLocation.CustomMessage != "", out[j].CodeLocation.CustomMessage != ""
+		if iCustom || jCustom {
+			return !iCustom && jCustom
+		}
+		if out[i].CodeLocation.FileName != out[j].CodeLocation.FileName {
+			return out[i].CodeLocation.FileName < out[j].CodeLocation.FileName
+		}
+		return out[i].CodeLocation.LineNumber < out[j].CodeLocation.LineNumber
+	})
+	return out
+}
+
+/*
+WithOrderingHintsApplied returns a copy of the (already sorted) setup chain n in which each node decorated with OrderBefore or OrderAfter has been moved to run immediately before (or after) the node in the chain with the matching HookName.
+
+Hints are applied in chain order.  Hints whose target is not in the chain are ignored.
+*/
+func (n Nodes) WithOrderingHintsApplied() Nodes {
+	out := make(Nodes, len(n))
+	copy(out, n)
+	for _, node := range n {
+		target, after := node.OrderBefore, false
+		if node.OrderAfter != "" {
+			target, after = node.OrderAfter, true
+		}
+		if target == "" || target == node.HookName {
+			continue
+		}
+		rest := out.Filter(func(other Node) bool { return other.ID != node.ID })
+		idx := -1
+		for i := range rest {
+			if rest[i].HookName == target {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			continue
+		}
+		if after {
+			idx += 1
+		}
+		out = append(rest[:idx].CopyAppend(node), rest[idx:]...)
+	}
+	return out
+}
+
 func (n Nodes) FirstWithNestingLevel(level int) Node {
 	for i := range n {
 		if n[i].NestingLevel == level {
@@ -870,6 +1105,42 @@ func (n Nodes) HasNodeMarkedSerial() bool {
 	return false
 }
 
+func (n Nodes) HasNodeMarkedNoCapture() bool {
+	for i := range n {
+		if n[i].MarkedNoCapture {
+			return true
+		}
+	}
+	return false
+}
+
+func (n Nodes) HasNodeMarkedRequiresNetwork() bool {
+	for i := range n {
+		if n[i].MarkedRequiresNetwork {
+			return true
+		}
+	}
+	return false
+}
+
+func (n Nodes) HasNodeMarkedInformational() bool {
+	for i := range n {
+		if n[i].MarkedInformational {
+			return true
+		}
+	}
+	return false
+}
+
+func (n Nodes) HasNodeMarkedVerbose() bool {
+	for i := range n {
+		if n[i].MarkedVerbose {
+			return true
+		}
+	}
+	return false
+}
+
 func (n Nodes) FirstNodeMarkedOrdered() Node {
 	for i := range n {
 		if n[i].MarkedOrdered {
@@ -908,6 +1179,98 @@ func (n Nodes) GetMaxMustPassRepeatedly() int {
 	return maxMustPassRepeatedly
 }
 
+func (n Nodes) GetMaxRepeat() int {
+	maxRepeat := 0
+	for i := range n {
+		if n[i].Repeat > 0 {
+			maxRepeat = n[i].Repeat
+		}
+	}
+	return maxRepeat
+}
+
+func (n Nodes) GetExpectedDuration() time.Duration {
+	expectedDuration := time.Duration(0)
+	for i := range n {
+		if n[i].ExpectedDuration > 0 {
+			expectedDuration = n[i].ExpectedDuration
+		}
+	}
+	return expectedDuration
+}
+
+func (n Nodes) GetCaptureStackThreshold() time.Duration {
+	threshold := time.Duration(0)
+	for i := range n {
+		if n[i].CaptureStackThreshold > 0 {
+			threshold = n[i].CaptureStackThreshold
+		}
+	}
+	return threshold
+}
+
+// GetStabilityCheck returns the StabilityCheck that applies to the spec described by these nodes.  The innermost StabilityCheck wins.
+func (n Nodes) GetStabilityCheck() StabilityCheck {
+	var check StabilityCheck
+	for i := range n {
+		if n[i].StabilityCheck != nil {
+			check = n[i].StabilityCheck
+		}
+	}
+	return check
+}
+
+/*
+PlatformSkipReason returns the reason the spec described by these nodes should be skipped when running on goos/goarch - or "" if the spec should run.
+
+Platforms passed to OnlyOn and SkipOn are either a GOOS ("linux") or a GOOS/GOARCH pair ("linux/arm64").  A spec only runs if the platform matches every OnlyOn in its hierarchy and none of its SkipOns.
+*/
+func (n Nodes) PlatformSkipReason(goos string, goarch string) string {
+	for i := range n {
+		if len(n[i].OnlyOnPlatforms) > 0 && !matchesAnyPlatform(n[i].OnlyOnPlatforms, goos, goarch) {
+			return fmt.Sprintf("Spec skipped because it only runs on %s and this is %s/%s", strings.Join(n[i].OnlyOnPlatforms, ", "), goos, goarch)
+		}
+		for _, platform := range n[i].SkipOnPlatforms {
+			if matchesPlatform(platform, goos, goarch) {
+				return fmt.Sprintf("Spec skipped because it is marked to skip on %s and this is %s/%s", platform, goos, goarch)
+			}
+		}
+	}
+	return ""
+}
+
+// RegistrationSkipReason returns the reason a spec is skipped if it is a placeholder registered with SkipRegistration, or an empty string otherwise
+func (n Nodes) RegistrationSkipReason() string {
+	subject := n.FirstNodeWithType(types.NodeTypeIt)
+	if subject.RegistrationSkipReason == "" {
+		return ""
+	}
+	return fmt.Sprintf("Spec not registered: %s", subject.RegistrationSkipReason)
+}
+
+// RequiredFeatures returns the features required by every RequireFeature decorator in the hierarchy, outermost first
+func (n Nodes) RequiredFeatures() []string {
+	out := []string{}
+	for i := range n {
+		out = append(out, n[i].RequiredFeatures...)
+	}
+	return out
+}
+
+func matchesAnyPlatform(platforms []string, goos string, goarch string) bool {
+	for _, platform := range platforms {
+		if matchesPlatform(platform, goos, goarch) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPlatform(platform string, goos string, goarch string) bool {
+	os, arch, hasArch := strings.Cut(platform, "/")
+	return os == goos && (!hasArch || arch == goarch)
+}
+
 func unrollInterfaceSlice(args interface{}) []interface{} {
 	v := reflect.ValueOf(args)
 	if v.Kind() != reflect.Slice {
@@ -916,7 +1279,7 @@ func unrollInterfaceSlice(args interface{}) []interface{} {
 	out := []interface{}{}
 	for i := 0; i < v.Len(); i++ {
 		el := reflect.ValueOf(v.Index(i).Interface())
-		if el.Kind() == reflect.Slice && el.Type() != reflect.TypeOf(Labels{}) {
+		if el.Kind() == reflect.Slice && el.Type() != reflect.TypeOf(Labels{}) && el.Type() != reflect.TypeOf(OnlyOnPlatforms{}) && el.Type() != reflect.TypeOf(SkipOnPlatforms{}) && el.Type() != reflect.TypeOf(RequiredFeatures{}) && el.Type() != reflect.TypeOf(SpecDependencies{}) {
 			out = append(out, unrollInterfaceSlice(el.Interface())...)
 		} else {
 			out = append(out, v.Index(i).Interface())
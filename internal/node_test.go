@@ -53,8 +53,20 @@ var _ = Describe("Partitioning Decorations", func() {
 			[]interface{}{},
 			FlakeAttempts(1),
 			MustPassRepeatedly(1),
+			Repeat(1),
+			ExpectedDuration(time.Second),
+			CaptureStackIfSlowerThan(time.Second),
+			OnlyOn("linux"),
+			SkipOn("windows"),
+			RequireFeature("feature-x"),
+			internal.RegistrationSkipReason("not here"),
 			true,
 			OncePerOrdered,
+			NoCapture,
+			RequiresNetwork,
+			Informational,
+			VerboseOutput,
+			DependsOn("A", "B"),
 		)
 
 		Ω(decorations).Should(Equal([]interface{}{
@@ -76,7 +88,19 @@ var _ = Describe("Partitioning Decorations", func() {
 			Label("D"),
 			FlakeAttempts(1),
 			MustPassRepeatedly(1),
+			Repeat(1),
+			ExpectedDuration(time.Second),
+			CaptureStackIfSlowerThan(time.Second),
+			OnlyOn("linux"),
+			SkipOn("windows"),
+			RequireFeature("feature-x"),
+			internal.RegistrationSkipReason("not here"),
 			OncePerOrdered,
+			NoCapture,
+			RequiresNetwork,
+			Informational,
+			VerboseOutput,
+			DependsOn("A", "B"),
 		}))
 
 		Ω(remaining).Should(Equal([]interface{}{
@@ -347,6 +371,86 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("the NoCapture decoration", func() {
+		It("applies to containers and Its", func() {
+			for _, nt := range []types.NodeType{ntCon, ntIt} {
+				node, errors := internal.NewNode(dt, nt, "", body, NoCapture)
+				Ω(node.MarkedNoCapture).Should(BeTrue())
+				ExpectAllWell(errors)
+			}
+		})
+
+		It("does not apply to other nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, NoCapture, cl)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "NoCapture")))
+		})
+	})
+
+	Describe("the RequiresNetwork decoration", func() {
+		It("applies to containers and Its", func() {
+			for _, nt := range []types.NodeType{ntCon, ntIt} {
+				node, errors := internal.NewNode(dt, nt, "", body, RequiresNetwork)
+				Ω(node.MarkedRequiresNetwork).Should(BeTrue())
+				ExpectAllWell(errors)
+			}
+		})
+
+		It("does not apply to other nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, RequiresNetwork, cl)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "RequiresNetwork")))
+		})
+	})
+
+	Describe("the Informational decoration", func() {
+		It("applies to containers and Its", func() {
+			for _, nt := range []types.NodeType{ntCon, ntIt} {
+				node, errors := internal.NewNode(dt, nt, "", body, Informational)
+				Ω(node.MarkedInformational).Should(BeTrue())
+				ExpectAllWell(errors)
+			}
+		})
+
+		It("does not apply to other nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, Informational, cl)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "Informational")))
+		})
+	})
+
+	Describe("the VerboseOutput decoration", func() {
+		It("applies to containers and Its", func() {
+			for _, nt := range []types.NodeType{ntCon, ntIt} {
+				node, errors := internal.NewNode(dt, nt, "", body, VerboseOutput)
+				Ω(node.MarkedVerbose).Should(BeTrue())
+				ExpectAllWell(errors)
+			}
+		})
+
+		It("does not apply to other nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, VerboseOutput, cl)
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "VerboseOutput")))
+		})
+	})
+
+	Describe("the DependsOn decoration", func() {
+		It("applies to Its", func() {
+			node, errors := internal.NewNode(dt, ntIt, "", body, DependsOn("A"), DependsOn("B", "C"))
+			Ω(node.SpecDependencies).Should(Equal(SpecDependencies{"A", "B", "C"}))
+			ExpectAllWell(errors)
+		})
+
+		It("does not apply to other nodes", func() {
+			for _, nt := range []types.NodeType{ntCon, ntBef} {
+				node, errors := internal.NewNode(dt, nt, "", body, DependsOn("A"), cl)
+				Ω(node).Should(BeZero())
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, nt, "DependsOn")))
+			}
+		})
+	})
+
 	Describe("the PollProgressAfter and PollProgressInterval decorations", func() {
 		It("applies to non-container nodes, only", func() {
 			for _, nt := range []types.NodeType{ntBef, ntAf, ntJusAf, ntJusBef, ntIt} {
@@ -410,6 +514,66 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("the Repeat decoration", func() {
+		It("sets the Repeat field", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, Repeat(5))
+			Ω(node.Repeat).Should(Equal(5))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, Repeat(3))
+			Ω(node.Repeat).Should(Equal(3))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, Repeat(2))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "Repeat")))
+		})
+		It("errors when combined with FlakeAttempts or MustPassRepeatedly", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, cl, Repeat(2), FlakeAttempts(2))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDeclarationOfRepeatWithFlakeAttemptsOrMustPassRepeatedly(cl, ntIt)))
+
+			node, errors = internal.NewNode(dt, ntIt, "text", body, cl, Repeat(2), MustPassRepeatedly(2))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDeclarationOfRepeatWithFlakeAttemptsOrMustPassRepeatedly(cl, ntIt)))
+		})
+	})
+
+	Describe("the ExpectedDuration decoration", func() {
+		It("sets the ExpectedDuration field", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, ExpectedDuration(500*time.Millisecond))
+			Ω(node.ExpectedDuration).Should(Equal(500 * time.Millisecond))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, ExpectedDuration(time.Second))
+			Ω(node.ExpectedDuration).Should(Equal(time.Second))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, ExpectedDuration(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "ExpectedDuration")))
+		})
+	})
+
+	Describe("the CaptureStackIfSlowerThan decoration", func() {
+		It("sets the CaptureStackThreshold field", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, CaptureStackIfSlowerThan(500*time.Millisecond))
+			Ω(node.CaptureStackThreshold).Should(Equal(500 * time.Millisecond))
+			ExpectAllWell(errors)
+
+			node, errors = internal.NewNode(dt, ntCon, "text", body, CaptureStackIfSlowerThan(time.Second))
+			Ω(node.CaptureStackThreshold).Should(Equal(time.Second))
+			ExpectAllWell(errors)
+		})
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, CaptureStackIfSlowerThan(time.Second))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "CaptureStackIfSlowerThan")))
+		})
+	})
+
 	Describe("The Label decoration", func() {
 		It("has no labels by default", func() {
 			node, errors := internal.NewNode(dt, ntIt, "text", body)
@@ -451,6 +615,59 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("the OnlyOn and SkipOn decorations", func() {
+		It("tracks the platforms", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, OnlyOn("linux", "darwin/arm64"), SkipOn("linux/386"))
+			Ω(node.OnlyOnPlatforms).Should(Equal(OnlyOnPlatforms{"linux", "darwin/arm64"}))
+			Ω(node.SkipOnPlatforms).Should(Equal(SkipOnPlatforms{"linux/386"}))
+			ExpectAllWell(errors)
+		})
+
+		It("appends multiple decorations together, even if nested", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, OnlyOn("linux"), []interface{}{OnlyOn("darwin"), SkipOn("linux/386")})
+			Ω(node.OnlyOnPlatforms).Should(Equal(OnlyOnPlatforms{"linux", "darwin"}))
+			Ω(node.SkipOnPlatforms).Should(Equal(SkipOnPlatforms{"linux/386"}))
+			ExpectAllWell(errors)
+		})
+
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, OnlyOn("linux"), SkipOn("windows"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(
+				types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "OnlyOn"),
+				types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "SkipOn"),
+			))
+		})
+	})
+
+	Describe("the RequireFeature decoration", func() {
+		It("appends multiple decorations together, even if nested", func() {
+			node, errors := internal.NewNode(dt, ntCon, "text", body, RequireFeature("a", "b"), []interface{}{RequireFeature("c")})
+			Ω(node.RequiredFeatures).Should(Equal(RequiredFeatures{"a", "b", "c"}))
+			ExpectAllWell(errors)
+		})
+
+		It("cannot be applied to non-container/it nodes", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, RequireFeature("a"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntBef, "RequireFeature")))
+		})
+	})
+
+	Describe("the RegistrationSkipReason decoration", func() {
+		It("records the reason", func() {
+			node, errors := internal.NewNode(dt, ntIt, "text", body, internal.RegistrationSkipReason("not here"))
+			Ω(node.RegistrationSkipReason).Should(Equal("not here"))
+			ExpectAllWell(errors)
+		})
+
+		It("can only be applied to It nodes", func() {
+			node, errors := internal.NewNode(dt, ntCon, "", body, cl, internal.RegistrationSkipReason("not here"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "RegistrationSkipReason")))
+		})
+	})
+
 	Describe("the timeout-related decorators", func() {
 		It("correctly assigned timeouts when specified", func() {
 			node, errors := internal.NewNode(dt, ntIt, "spec", func(_ SpecContext) {}, cl, NodeTimeout(time.Second), SpecTimeout(2*time.Second), GracePeriod(3*time.Second))
@@ -504,6 +721,78 @@ var _ = Describe("Constructing nodes", func() {
 		})
 	})
 
+	Describe("the WarnAt decorator", func() {
+		It("records the fraction on the node", func() {
+			node, errors := internal.NewNode(dt, ntIt, "spec", func(_ SpecContext) {}, cl, NodeTimeout(time.Second), WarnAt(0.8))
+			Ω(errors).Should(BeEmpty())
+			Ω(node.WarnAt).Should(Equal(0.8))
+		})
+
+		It("errors if applied to a container", func() {
+			node, errors := internal.NewNode(dt, ntCon, "container", body, cl, WarnAt(0.8))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntCon, "WarnAt")))
+		})
+
+		It("errors if the fraction is not between 0 and 1", func() {
+			for _, fraction := range []float64{0, 1, -0.5, 1.5} {
+				node, errors := internal.NewNode(dt, ntIt, "spec", func(_ SpecContext) {}, cl, WarnAt(fraction))
+				Ω(node).Should(BeZero())
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidWarnAtFraction(cl, fraction)))
+			}
+		})
+	})
+
+	Describe("the setup node ordering decorators", func() {
+		It("records the hints on the node", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, HookName("db"), OrderBefore("outer"))
+			Ω(errors).Should(BeEmpty())
+			Ω(node.HookName).Should(Equal("db"))
+			Ω(node.OrderBefore).Should(Equal("outer"))
+
+			node, errors = internal.NewNode(dt, ntJusBef, "", body, cl, OrderAfter("outer"))
+			Ω(errors).Should(BeEmpty())
+			Ω(node.OrderAfter).Should(Equal("outer"))
+		})
+
+		It("errors if applied to a node that is not a BeforeEach or JustBeforeEach", func() {
+			node, errors := internal.NewNode(dt, ntAf, "", body, cl, HookName("db"), OrderBefore("outer"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(
+				types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntAf, "HookName"),
+				types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntAf, "OrderBefore"),
+			))
+
+			node, errors = internal.NewNode(dt, ntIt, "spec", body, cl, OrderAfter("outer"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntIt, "OrderAfter")))
+		})
+
+		It("errors if both OrderBefore and OrderAfter are specified", func() {
+			node, errors := internal.NewNode(dt, ntBef, "", body, cl, OrderBefore("a"), OrderAfter("b"))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDeclarationOfOrderBeforeAndOrderAfter(cl, ntBef)))
+		})
+	})
+
+	Describe("the ReportPriority decorator", func() {
+		It("records the priority on ReportAfterSuite nodes", func() {
+			node, errors := internal.NewNode(dt, types.NodeTypeReportAfterSuite, "report", func(_ types.Report) {}, cl, ReportPriority(3))
+			Ω(errors).Should(BeEmpty())
+			Ω(node.ReportPriority).Should(Equal(3))
+		})
+
+		It("errors if applied to any other node", func() {
+			node, errors := internal.NewNode(dt, types.NodeTypeReportBeforeSuite, "", func(_ types.Report) {}, cl, ReportPriority(3))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, types.NodeTypeReportBeforeSuite, "ReportPriority")))
+
+			node, errors = internal.NewNode(dt, ntIt, "spec", body, cl, ReportPriority(3))
+			Ω(node).Should(BeZero())
+			Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidDecoratorForNodeType(cl, ntIt, "ReportPriority")))
+		})
+	})
+
 	Describe("the SuppressProgressReporting decorator", func() {
 		It("is deprecated", func() {
 			node, errors := internal.NewNode(dt, ntIt, "spec", func() {}, cl, SuppressProgressReporting)
@@ -1398,6 +1687,49 @@ var _ = Describe("Nodes", func() {
 		})
 	})
 
+	Describe("SortedByReportPriority", func() {
+		It("returns a copy sorted by descending priority and then by file name and line number, with custom code locations last", func() {
+			withPriority := func(node Node, priority int) Node {
+				node.ReportPriority = priority
+				return node
+			}
+			a10 := N(types.CodeLocation{FileName: "/a.go", LineNumber: 10})
+			a2 := N(types.CodeLocation{FileName: "/a.go", LineNumber: 2})
+			b1 := N(types.CodeLocation{FileName: "/b.go", LineNumber: 1})
+			autogenerated := N(types.NewCustomCodeLocation("autogenerated by Ginkgo"))
+			high := withPriority(N(types.CodeLocation{FileName: "/z.go", LineNumber: 1}), 1)
+			low := withPriority(N(types.CodeLocation{FileName: "/a.go", LineNumber: 1}), -1)
+
+			nodes := Nodes{autogenerated, low, b1, a10, high, a2}
+			Ω(nodes.SortedByReportPriority()).Should(Equal(Nodes{high, a2, a10, b1, autogenerated, low}))
+			Ω(nodes).Should(Equal(Nodes{autogenerated, low, b1, a10, high, a2}), "original nodes should not have been modified")
+		})
+	})
+
+	Describe("WithOrderingHintsApplied", func() {
+		var outer, outer2, inner, hintedBefore, hintedAfter, hintedMissing Node
+		BeforeEach(func() {
+			outer = N(ntBef, NestingLevel(0), HookName("outer"))
+			outer2 = N(ntBef, NestingLevel(0))
+			inner = N(ntBef, NestingLevel(1), HookName("inner"))
+			hintedBefore = N(ntBef, NestingLevel(2), OrderBefore("outer"))
+			hintedAfter = N(ntBef, NestingLevel(2), OrderAfter("outer"))
+			hintedMissing = N(ntBef, NestingLevel(2), OrderBefore("missing"))
+		})
+
+		It("returns a copy with hinted nodes moved relative to their targets", func() {
+			nodes := Nodes{outer, outer2, inner, hintedBefore}
+			Ω(nodes.WithOrderingHintsApplied()).Should(Equal(Nodes{hintedBefore, outer, outer2, inner}))
+			Ω(nodes).Should(Equal(Nodes{outer, outer2, inner, hintedBefore}), "original nodes should not have been modified")
+
+			Ω(Nodes{outer, outer2, inner, hintedAfter}.WithOrderingHintsApplied()).Should(Equal(Nodes{outer, hintedAfter, outer2, inner}))
+		})
+
+		It("ignores hints whose target is not in the chain", func() {
+			Ω(Nodes{outer, inner, hintedMissing}.WithOrderingHintsApplied()).Should(Equal(Nodes{outer, inner, hintedMissing}))
+		})
+	})
+
 	Describe("WithinNestingLevel", func() {
 		var n0, n1, n2a, n2b, n3 Node
 		var nodes Nodes
@@ -1579,6 +1911,46 @@ var _ = Describe("Nodes", func() {
 		})
 	})
 
+	Describe("HasNodeMarkedNoCapture", func() {
+		It("returns true when there is a node marked NoCapture", func() {
+			Ω(Nodes{N(), N(ntCon, NoCapture), N()}.HasNodeMarkedNoCapture()).Should(BeTrue())
+		})
+
+		It("returns false when there is no node marked NoCapture", func() {
+			Ω(Nodes{N(), N(), N()}.HasNodeMarkedNoCapture()).Should(BeFalse())
+		})
+	})
+
+	Describe("HasNodeMarkedRequiresNetwork", func() {
+		It("returns true when there is a node marked RequiresNetwork", func() {
+			Ω(Nodes{N(), N(ntCon, RequiresNetwork), N()}.HasNodeMarkedRequiresNetwork()).Should(BeTrue())
+		})
+
+		It("returns false when there is no node marked RequiresNetwork", func() {
+			Ω(Nodes{N(), N(), N()}.HasNodeMarkedRequiresNetwork()).Should(BeFalse())
+		})
+	})
+
+	Describe("HasNodeMarkedInformational", func() {
+		It("returns true when there is a node marked Informational", func() {
+			Ω(Nodes{N(), N(ntCon, Informational), N()}.HasNodeMarkedInformational()).Should(BeTrue())
+		})
+
+		It("returns false when there is no node marked Informational", func() {
+			Ω(Nodes{N(), N(), N()}.HasNodeMarkedInformational()).Should(BeFalse())
+		})
+	})
+
+	Describe("HasNodeMarkedVerbose", func() {
+		It("returns true when there is a node marked VerboseOutput", func() {
+			Ω(Nodes{N(), N(ntCon, VerboseOutput), N()}.HasNodeMarkedVerbose()).Should(BeTrue())
+		})
+
+		It("returns false when there is no node marked VerboseOutput", func() {
+			Ω(Nodes{N(), N(), N()}.HasNodeMarkedVerbose()).Should(BeFalse())
+		})
+	})
+
 	Describe("FirstNodeMarkedOrdered", func() {
 		Context("when there are nodes marked ordered", func() {
 			It("returns the first one", func() {
@@ -1654,6 +2026,83 @@ var _ = Describe("Nodes", func() {
 
 	})
 
+	Describe("GetMaxRepeat", func() {
+		It("returns 0 when no node is decorated with Repeat", func() {
+			nodes := Nodes{N(), N(), N()}
+			Ω(nodes.GetMaxRepeat()).Should(Equal(0))
+		})
+		It("returns the last Repeat value", func() {
+			nodes := Nodes{N(), N(Repeat(4)), N(), N(Repeat(2))}
+			Ω(nodes.GetMaxRepeat()).Should(Equal(2))
+		})
+	})
+
+	Describe("GetExpectedDuration", func() {
+		It("returns 0 when no node is decorated with ExpectedDuration", func() {
+			nodes := Nodes{N(), N(), N()}
+			Ω(nodes.GetExpectedDuration()).Should(BeZero())
+		})
+		It("returns the innermost ExpectedDuration", func() {
+			nodes := Nodes{N(), N(ExpectedDuration(time.Minute)), N(), N(ExpectedDuration(time.Second))}
+			Ω(nodes.GetExpectedDuration()).Should(Equal(time.Second))
+		})
+	})
+
+	Describe("GetCaptureStackThreshold", func() {
+		It("returns 0 when no node is decorated with CaptureStackIfSlowerThan", func() {
+			nodes := Nodes{N(), N(), N()}
+			Ω(nodes.GetCaptureStackThreshold()).Should(BeZero())
+		})
+		It("returns the innermost threshold", func() {
+			nodes := Nodes{N(), N(CaptureStackIfSlowerThan(time.Minute)), N(), N(CaptureStackIfSlowerThan(time.Second))}
+			Ω(nodes.GetCaptureStackThreshold()).Should(Equal(time.Second))
+		})
+	})
+
+	Describe("RequiredFeatures", func() {
+		It("returns the features required by every node, outermost first", func() {
+			Ω(Nodes{N(RequireFeature("a")), N(), N(RequireFeature("b", "c"))}.RequiredFeatures()).Should(Equal([]string{"a", "b", "c"}))
+			Ω(Nodes{N(), N()}.RequiredFeatures()).Should(BeEmpty())
+		})
+	})
+
+	Describe("RegistrationSkipReason", func() {
+		It("returns the reason the subject node is a placeholder for specs that were not registered", func() {
+			Ω(Nodes{N(ntCon), N(internal.RegistrationSkipReason("not here"))}.RegistrationSkipReason()).Should(Equal("Spec not registered: not here"))
+			Ω(Nodes{N(ntCon), N()}.RegistrationSkipReason()).Should(BeEmpty())
+		})
+	})
+
+	Describe("PlatformSkipReason", func() {
+		It("returns an empty reason when no node is decorated with OnlyOn or SkipOn", func() {
+			nodes := Nodes{N(), N()}
+			Ω(nodes.PlatformSkipReason("linux", "amd64")).Should(BeEmpty())
+		})
+
+		It("returns an empty reason when the platform matches OnlyOn", func() {
+			nodes := Nodes{N(OnlyOn("darwin", "linux")), N(OnlyOn("linux/amd64"))}
+			Ω(nodes.PlatformSkipReason("linux", "amd64")).Should(BeEmpty())
+		})
+
+		It("explains why the spec is skipped when the platform does not match OnlyOn", func() {
+			nodes := Nodes{N(OnlyOn("darwin", "linux/arm64"))}
+			Ω(nodes.PlatformSkipReason("linux", "amd64")).Should(Equal("Spec skipped because it only runs on darwin, linux/arm64 and this is linux/amd64"))
+		})
+
+		It("requires every OnlyOn in the hierarchy to match", func() {
+			nodes := Nodes{N(OnlyOn("linux", "darwin")), N(OnlyOn("darwin"))}
+			Ω(nodes.PlatformSkipReason("darwin", "arm64")).Should(BeEmpty())
+			Ω(nodes.PlatformSkipReason("linux", "amd64")).Should(Equal("Spec skipped because it only runs on darwin and this is linux/amd64"))
+		})
+
+		It("explains why the spec is skipped when the platform matches SkipOn", func() {
+			nodes := Nodes{N(SkipOn("windows")), N(SkipOn("linux/386"))}
+			Ω(nodes.PlatformSkipReason("linux", "amd64")).Should(BeEmpty())
+			Ω(nodes.PlatformSkipReason("windows", "amd64")).Should(Equal("Spec skipped because it is marked to skip on windows and this is windows/amd64"))
+			Ω(nodes.PlatformSkipReason("linux", "386")).Should(Equal("Spec skipped because it is marked to skip on linux/386 and this is linux/386"))
+		})
+	})
+
 	Describe("Labels", func() {
 		It("can match against a filter", func() {
 			Ω(Label().MatchesLabelFilter("")).Should(BeTrue())
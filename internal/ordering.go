@@ -1,8 +1,12 @@
 package internal
 
 import (
+	"fmt"
+	"hash/fnv"
 	"math/rand"
+	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/onsi/ginkgo/v2/types"
 )
@@ -94,6 +98,9 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 	// then we break things into execution groups
 	// a group represents a single unit of execution and is a collection of SpecIndices
 	// usually a group is just a single spec, however ordered containers must be preserved as a single group
+	// as are specs connected by DependsOn, which we order so that each spec runs after the specs it depends on
+	dependencies := specDependencies(specs)
+	dependencyGroups := dependencyGroupIDs(specs, dependencies)
 	executionGroupIDs := []uint{}
 	executionGroups := map[uint]SpecIndices{}
 	for _, idx := range sortableSpecs.Indexes {
@@ -102,9 +109,18 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		if groupNode.IsZero() {
 			groupNode = spec.Nodes.FirstNodeWithType(types.NodeTypeIt)
 		}
-		executionGroups[groupNode.ID] = append(executionGroups[groupNode.ID], idx)
-		if len(executionGroups[groupNode.ID]) == 1 {
-			executionGroupIDs = append(executionGroupIDs, groupNode.ID)
+		groupID := groupNode.ID
+		if dependencyGroupID, ok := dependencyGroups[idx]; ok {
+			groupID = dependencyGroupID
+		}
+		executionGroups[groupID] = append(executionGroups[groupID], idx)
+		if len(executionGroups[groupID]) == 1 {
+			executionGroupIDs = append(executionGroupIDs, groupID)
+		}
+	}
+	for _, groupID := range executionGroupIDs {
+		if len(executionGroups[groupID]) > 1 {
+			executionGroups[groupID] = orderByDependencies(executionGroups[groupID], dependencies)
 		}
 	}
 
@@ -150,6 +166,11 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	}
 
+	// when randomizing within containers, containers keep their declaration order and only the execution groups that share an immediate container are shuffled
+	if suiteConfig.RandomizeWithinContainers {
+		orderedGroups = orderWithinContainers(specs, executionGroupIDs, executionGroups, suiteConfig.RandomSeed)
+	}
+
 	// If we're running in series, we're done.
 	if suiteConfig.ParallelTotal == 1 {
 		return orderedGroups, GroupedSpecIndices{}
@@ -167,5 +188,137 @@ func OrderSpecs(specs Specs, suiteConfig types.SuiteConfig) (GroupedSpecIndices,
 		}
 	}
 
+	// If we've been given spec costs we dispatch the parallelizable groups longest-first.  The sort is stable so groups with equal (or no) cost retain their shuffled order.
+	if len(suiteConfig.SpecCosts) > 0 {
+		costOf := func(specIndices SpecIndices) time.Duration {
+			cost := time.Duration(0)
+			for _, idx := range specIndices {
+				cost += suiteConfig.SpecCosts[specs[idx].Text()]
+			}
+			return cost
+		}
+		sort.SliceStable(parallelizableGroups, func(i, j int) bool {
+			return costOf(parallelizableGroups[i]) > costOf(parallelizableGroups[j])
+		})
+	}
+
 	return parallelizableGroups, serialGroups
 }
+
+/*
+orderWithinContainers takes the execution groups in their sorted (i.e. declaration) order and shuffles the groups that share an immediate container amongst the positions those groups occupy.
+Top-level specs and Ordered containers that are not nested in a container share the suite as their immediate container.
+
+Each container is shuffled with its own random source, seeded by combining the global seed with a hash of the container's path.  The path is built from the text, file name, and line number of
+every container leading up to it so the order within one container does not depend on how many specs other containers have, and is the same across parallel processes and machines.
+*/
+func orderWithinContainers(specs Specs, executionGroupIDs []uint, executionGroups map[uint]SpecIndices, seed int64) GroupedSpecIndices {
+	containerIDs := []uint{}
+	containerSeeds := map[uint]int64{}
+	slotsByContainerID := map[uint][]int{}
+	for slot, groupID := range executionGroupIDs {
+		nodes := specs[executionGroups[groupID][0]].Nodes.WithType(types.NodeTypesForContainerAndIt)
+		groupIdx := nodes.IndexOfFirstNodeMarkedOrdered()
+		if groupIdx == -1 {
+			groupIdx = len(nodes) - 1
+		}
+
+		containerID := uint(0)
+		if groupIdx > 0 {
+			containerID = nodes[groupIdx-1].ID
+		}
+		if _, ok := slotsByContainerID[containerID]; !ok {
+			hash := fnv.New64a()
+			for _, node := range nodes[:groupIdx] {
+				fmt.Fprintf(hash, "%s|%s:%d|", node.Text, filepath.Base(node.CodeLocation.FileName), node.CodeLocation.LineNumber)
+			}
+			containerIDs = append(containerIDs, containerID)
+			containerSeeds[containerID] = seed ^ int64(hash.Sum64())
+		}
+		slotsByContainerID[containerID] = append(slotsByContainerID[containerID], slot)
+	}
+
+	orderedGroups := make(GroupedSpecIndices, len(executionGroupIDs))
+	for _, containerID := range containerIDs {
+		slots := slotsByContainerID[containerID]
+		permutation := rand.New(rand.NewSource(containerSeeds[containerID])).Perm(len(slots))
+		for i, j := range permutation {
+			orderedGroups[slots[i]] = executionGroups[executionGroupIDs[slots[j]]]
+		}
+	}
+	return orderedGroups
+}
+
+/*
+ApplyFinalOrderHook passes the specs, in the order computed by OrderSpecs, to suiteConfig.FinalOrderHook and regroups them in the order the hook returns.
+
+The hook may reorder specs freely but must return every spec exactly once, must keep the specs in an Ordered container adjacent and in their original relative order, and (when running in parallel) must keep Serial specs after all other specs.
+An error describing the violation is returned if the hook does not honor these constraints.
+*/
+func ApplyFinalOrderHook(specs Specs, groupedSpecIndices GroupedSpecIndices, serialGroupedSpecIndices GroupedSpecIndices, suiteConfig types.SuiteConfig) (GroupedSpecIndices, GroupedSpecIndices, error) {
+	if suiteConfig.FinalOrderHook == nil {
+		return groupedSpecIndices, serialGroupedSpecIndices, nil
+	}
+
+	// specs are matched to the reports the hook returns by their full text and location.  specs that share both are indistinguishable so we hand them out in their original order.
+	key := func(report types.SpecReport) string {
+		return report.FullText() + "|" + report.LeafNodeLocation.String()
+	}
+	groups := append(append(GroupedSpecIndices{}, groupedSpecIndices...), serialGroupedSpecIndices...)
+	groupOf := map[int]int{}
+	specIndicesByKey := map[string][]int{}
+	reports := []types.SpecReport{}
+	for g, specIndices := range groups {
+		for _, idx := range specIndices {
+			groupOf[idx] = g
+			report := specReportForSpec(specs[idx])
+			reports = append(reports, report)
+			specIndicesByKey[key(report)] = append(specIndicesByKey[key(report)], idx)
+		}
+	}
+
+	reordered := suiteConfig.FinalOrderHook(reports)
+	if len(reordered) != len(reports) {
+		return nil, nil, fmt.Errorf("the hook was given %d specs but returned %d", len(reports), len(reordered))
+	}
+
+	newGroupedSpecIndices, newSerialGroupedSpecIndices := GroupedSpecIndices{}, GroupedSpecIndices{}
+	numPlaced := map[int]int{}
+	currentGroup := -1
+	for _, report := range reordered {
+		candidates := specIndicesByKey[key(report)]
+		if len(candidates) == 0 {
+			return nil, nil, fmt.Errorf("the hook returned a spec it was not given, or returned a spec more than once: %s", report.FullText())
+		}
+		idx := candidates[0]
+		specIndicesByKey[key(report)] = candidates[1:]
+
+		g := groupOf[idx]
+		if groups[g][numPlaced[g]] != idx {
+			return nil, nil, fmt.Errorf("the hook changed the order of the specs in an Ordered container: %s", report.FullText())
+		}
+		isSerial := g >= len(groupedSpecIndices)
+		if g != currentGroup {
+			if numPlaced[g] > 0 {
+				return nil, nil, fmt.Errorf("the hook split up the specs in an Ordered container: %s", report.FullText())
+			}
+			if isSerial {
+				newSerialGroupedSpecIndices = append(newSerialGroupedSpecIndices, SpecIndices{})
+			} else {
+				if len(newSerialGroupedSpecIndices) > 0 {
+					return nil, nil, fmt.Errorf("the hook placed a spec before a Serial spec - Serial specs must run after all other specs when running in parallel: %s", report.FullText())
+				}
+				newGroupedSpecIndices = append(newGroupedSpecIndices, SpecIndices{})
+			}
+			currentGroup = g
+		}
+		if isSerial {
+			newSerialGroupedSpecIndices[len(newSerialGroupedSpecIndices)-1] = append(newSerialGroupedSpecIndices[len(newSerialGroupedSpecIndices)-1], idx)
+		} else {
+			newGroupedSpecIndices[len(newGroupedSpecIndices)-1] = append(newGroupedSpecIndices[len(newGroupedSpecIndices)-1], idx)
+		}
+		numPlaced[g] += 1
+	}
+
+	return newGroupedSpecIndices, newSerialGroupedSpecIndices, nil
+}
@@ -97,6 +97,75 @@ var _ = Describe("OrderSpecs", func() {
 		})
 	})
 
+	Context("when configured to randomize within containers", func() {
+		var con1, con2 Node
+		BeforeEach(func() {
+			conf.RandomizeWithinContainers = true
+			con1 = N(ntCon, CL("file_A", 10))
+			con2 = N(ntCon, CL("file_A", 50))
+			specs = Specs{
+				S(N("A", ntIt, CL("file_A", 1))),
+				S(N("B", ntIt, CL("file_A", 5))),
+				S(con1, N("C", ntIt, CL("file_A", 15))),
+				S(con1, N("D", ntIt, CL("file_A", 20))),
+				S(con1, N(ntCon, CL("file_A", 25)), N("E", ntIt, CL("file_A", 30))),
+				S(con1, N("F", ntIt, CL("file_A", 35))),
+				S(N("G", ntIt, CL("file_A", 40))),
+				S(con2, N("H", ntIt, CL("file_A", 55))),
+				S(con2, N("I", ntIt, CL("file_A", 60))),
+				S(con2, N("J", ntIt, CL("file_A", 65))),
+			}
+		})
+
+		It("keeps containers in declaration order and only shuffles specs amongst the positions their immediate container occupies", func() {
+			orders := map[string]bool{}
+			for conf.RandomSeed = 1; conf.RandomSeed < 20; conf.RandomSeed += 1 {
+				groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+				Ω(serialSpecIndices).Should(BeEmpty())
+
+				texts := getTexts(specs, groupedSpecIndices)
+				Ω(texts).Should(HaveLen(10))
+				Ω([]string{texts[0], texts[1], texts[6]}).Should(ConsistOf("A", "B", "G"))
+				Ω([]string{texts[2], texts[3], texts[5]}).Should(ConsistOf("C", "D", "F"))
+				Ω(texts[4]).Should(Equal("E"))
+				Ω(texts[7:]).Should(ConsistOf("H", "I", "J"))
+				orders[texts.Join()] = true
+			}
+			Ω(len(orders)).Should(BeNumerically(">", 1))
+		})
+
+		It("is reproducible for a given seed", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				for i := 0; i < 10; i++ {
+					reshuffledGroupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+					Ω(getTexts(specs, reshuffledGroupedSpecIndices)).Should(Equal(getTexts(specs, groupedSpecIndices)))
+				}
+			}
+		})
+
+		It("shuffles each container independently of the specs in other containers", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				con1Order := getTexts(specs, groupedSpecIndices)[2:6]
+
+				moreSpecs := append(Specs{}, specs...)
+				moreSpecs = append(moreSpecs, S(con2, N("K", ntIt, CL("file_A", 70))), S(N("L", ntIt, CL("file_A", 80))))
+				groupedSpecIndices, _ = internal.OrderSpecs(moreSpecs, conf)
+				Ω(getTexts(moreSpecs, groupedSpecIndices)[2:6]).Should(Equal(con1Order))
+			}
+		})
+
+		It("never shuffles the specs in ordered containers", func() {
+			ordered := N(ntCon, Ordered, CL("file_A", 45))
+			specs = append(specs, S(ordered, N("O1", ntIt, CL("file_A", 46))), S(ordered, N("O2", ntIt, CL("file_A", 47))))
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, groupedSpecIndices).Join()).Should(ContainSubstring("O1O2"))
+			}
+		})
+	})
+
 	Context("when passed the same seed", func() {
 		It("always generates the same order", func() {
 			for _, conf.RandomizeAllSpecs = range []bool{true, false} {
@@ -362,4 +431,217 @@ var _ = Describe("OrderSpecs", func() {
 			}, MustPassRepeatedly(5))
 		})
 	})
+
+	Context("when specs declare dependencies", func() {
+		BeforeEach(func() {
+			conf.RandomizeAllSpecs = true
+			con1 := N(ntCon)
+			specs = Specs{
+				S(N("A", ntIt)),
+				S(N("B", ntIt, DependsOn("D"))),
+				S(N("C", ntIt)),
+				S(N("D", ntIt)),
+				S(N("E", ntIt, DependsOn("B"))),
+				S(con1, N("F", ntIt)),
+				S(con1, N("D", ntIt)),
+			}
+		})
+
+		It("groups specs connected by dependencies together and orders each spec after the specs it depends on", func() {
+			for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+				groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+				Ω(groupedSpecIndices).Should(HaveLen(5))
+				Ω(getTexts(specs, groupedSpecIndices).Join()).Should(ContainSubstring("DBE"))
+			}
+		})
+	})
+
+	Context("when spec costs are provided", func() {
+		BeforeEach(func() {
+			con1 := N(ntCon, Ordered)
+			specs = Specs{
+				S(N("A", ntIt)),
+				S(N("B", ntIt)),
+				S(con1, N("C", ntIt)),
+				S(con1, N("D", ntIt)),
+				S(N("E", ntIt)),
+				S(N("F", ntIt, Serial)),
+				S(N("G", ntIt)),
+			}
+			conf.SpecCosts = map[string]time.Duration{
+				"A": time.Second,
+				"B": 5 * time.Second,
+				"C": 2 * time.Second,
+				"D": 2 * time.Second,
+				"F": time.Hour,
+				"G": 3 * time.Second,
+			}
+		})
+
+		Context("and the tests are running in parallel", func() {
+			BeforeEach(func() {
+				conf.ParallelTotal = 2
+			})
+
+			It("dispatches the parallelizable groups longest-first, keeping ordered containers intact and serial specs in the serial group", func() {
+				for conf.RandomSeed = 1; conf.RandomSeed < 10; conf.RandomSeed += 1 {
+					groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+					Ω(getTexts(specs, groupedSpecIndices)).Should(Equal(SpecTexts{"B", "C", "D", "G", "A", "E"}))
+					Ω(getTexts(specs, serialSpecIndices)).Should(Equal(SpecTexts{"F"}))
+				}
+			})
+		})
+
+		Context("and the tests are not running in parallel", func() {
+			It("ignores the costs", func() {
+				conf.RandomSeed = 1
+				groupedSpecIndices1, _ := internal.OrderSpecs(specs, conf)
+				conf.RandomSeed = 2
+				groupedSpecIndices2, _ := internal.OrderSpecs(specs, conf)
+				Ω(getTexts(specs, groupedSpecIndices1)).ShouldNot(Equal(getTexts(specs, groupedSpecIndices2)))
+			})
+		})
+	})
+})
+
+var _ = Describe("ApplyFinalOrderHook", func() {
+	var conf types.SuiteConfig
+	var specs Specs
+
+	smokeFirst := func(ordered []types.SpecReport) []types.SpecReport {
+		smoke, rest := []types.SpecReport{}, []types.SpecReport{}
+		for _, report := range ordered {
+			if isSmoke, _ := report.MatchesLabelFilter("smoke"); isSmoke {
+				smoke = append(smoke, report)
+			} else {
+				rest = append(rest, report)
+			}
+		}
+		return append(smoke, rest...)
+	}
+
+	moveToFront := func(text string) func([]types.SpecReport) []types.SpecReport {
+		return func(ordered []types.SpecReport) []types.SpecReport {
+			out := []types.SpecReport{}
+			for _, report := range ordered {
+				if report.LeafNodeText == text {
+					out = append([]types.SpecReport{report}, out...)
+				} else {
+					out = append(out, report)
+				}
+			}
+			return out
+		}
+	}
+
+	BeforeEach(func() {
+		conf = types.SuiteConfig{}
+		conf.RandomSeed = 1
+		conf.ParallelTotal = 1
+
+		con1 := N(ntCon, Ordered)
+		specs = Specs{
+			S(N("A", ntIt)),
+			S(N("B", ntIt, Label("smoke"))),
+			S(con1, N("C", ntIt)),
+			S(con1, N("D", ntIt)),
+			S(con1, N("E", ntIt)),
+			S(N("F", ntIt, Label("smoke"))),
+			S(N("G", ntIt, Serial)),
+		}
+	})
+
+	apply := func() (internal.GroupedSpecIndices, internal.GroupedSpecIndices, error) {
+		groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+		return internal.ApplyFinalOrderHook(specs, groupedSpecIndices, serialSpecIndices, conf)
+	}
+
+	It("leaves the computed order alone when no hook is configured", func() {
+		groupedSpecIndices, serialSpecIndices := internal.OrderSpecs(specs, conf)
+		newGroupedSpecIndices, newSerialSpecIndices, err := apply()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(newGroupedSpecIndices).Should(Equal(groupedSpecIndices))
+		Ω(newSerialSpecIndices).Should(Equal(serialSpecIndices))
+	})
+
+	It("passes the computed order to the hook and runs specs in the order the hook returns", func() {
+		var received []string
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			for _, report := range ordered {
+				received = append(received, report.LeafNodeText)
+			}
+			return smokeFirst(ordered)
+		}
+		groupedSpecIndices, _ := internal.OrderSpecs(specs, conf)
+		newGroupedSpecIndices, serialSpecIndices, err := apply()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(received).Should(Equal([]string(getTexts(specs, groupedSpecIndices))))
+		Ω(serialSpecIndices).Should(BeEmpty())
+
+		texts := getTexts(specs, newGroupedSpecIndices)
+		Ω(texts[:2]).Should(ConsistOf("B", "F"))
+		Ω(texts.Join()).Should(ContainSubstring("CDE"))
+		Ω(newGroupedSpecIndices).Should(ContainElement(HaveLen(3)), "the ordered container remains a single group")
+	})
+
+	It("lets the hook move an entire ordered container", func() {
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			return moveToFront("C")(moveToFront("D")(moveToFront("E")(ordered)))
+		}
+		newGroupedSpecIndices, _, err := apply()
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(getTexts(specs, newGroupedSpecIndices).Join()).Should(HavePrefix("CDE"))
+	})
+
+	It("rejects orders that split up an ordered container", func() {
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			// results in C A D E ...
+			return moveToFront("C")(moveToFront("A")(moveToFront("D")(moveToFront("E")(ordered))))
+		}
+		_, _, err := apply()
+		Ω(err).Should(MatchError(ContainSubstring("split up the specs in an Ordered container")))
+	})
+
+	It("rejects orders that reorder the specs in an ordered container", func() {
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			return moveToFront("D")(moveToFront("C")(moveToFront("E")(ordered)))
+		}
+		_, _, err := apply()
+		Ω(err).Should(MatchError(ContainSubstring("changed the order of the specs in an Ordered container")))
+	})
+
+	It("rejects orders that drop or duplicate specs", func() {
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			return ordered[1:]
+		}
+		_, _, err := apply()
+		Ω(err).Should(MatchError("the hook was given 7 specs but returned 6"))
+
+		conf.FinalOrderHook = func(ordered []types.SpecReport) []types.SpecReport {
+			out := moveToFront("A")(ordered)
+			return append(out[:len(out)-1], out[0])
+		}
+		_, _, err = apply()
+		Ω(err).Should(MatchError(ContainSubstring("returned a spec more than once")))
+	})
+
+	Context("when running in parallel", func() {
+		BeforeEach(func() {
+			conf.ParallelTotal = 2
+		})
+
+		It("keeps serial specs in the serial group", func() {
+			conf.FinalOrderHook = smokeFirst
+			newGroupedSpecIndices, serialSpecIndices, err := apply()
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(getTexts(specs, newGroupedSpecIndices)[:2]).Should(ConsistOf("B", "F"))
+			Ω(getTexts(specs, serialSpecIndices)).Should(Equal(SpecTexts{"G"}))
+		})
+
+		It("rejects orders that move serial specs ahead of other specs", func() {
+			conf.FinalOrderHook = moveToFront("G")
+			_, _, err := apply()
+			Ω(err).Should(MatchError(ContainSubstring("Serial specs must run after all other specs")))
+		})
+	})
 })
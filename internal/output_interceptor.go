@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -48,9 +49,16 @@ type OutputInterceptor interface {
 	StartInterceptingOutput()
 	StartInterceptingOutputAndForwardTo(io.Writer)
 	StopInterceptingAndReturnOutput() string
+	TimestampedWrites() []TimestampedWrite
+
+	// SetSeparateStdoutStderr controls whether stdout and stderr are intercepted through separate pipes so that SeparatedOutput can tell them apart.  It takes effect the next time interception starts.
+	SetSeparateStdoutStderr(bool)
+	// SeparatedOutput returns the stdout and stderr output returned by StopInterceptingAndReturnOutput.  Both are empty unless stdout and stderr are being separated.
+	SeparatedOutput() (string, string)
 
 	PauseIntercepting()
 	ResumeIntercepting()
+	IsIntercepting() bool
 
 	Shutdown()
 }
@@ -60,8 +68,12 @@ type NoopOutputInterceptor struct{}
 func (interceptor NoopOutputInterceptor) StartInterceptingOutput()                      {}
 func (interceptor NoopOutputInterceptor) StartInterceptingOutputAndForwardTo(io.Writer) {}
 func (interceptor NoopOutputInterceptor) StopInterceptingAndReturnOutput() string       { return "" }
+func (interceptor NoopOutputInterceptor) TimestampedWrites() []TimestampedWrite         { return nil }
+func (interceptor NoopOutputInterceptor) SetSeparateStdoutStderr(bool)                  {}
+func (interceptor NoopOutputInterceptor) SeparatedOutput() (string, string)             { return "", "" }
 func (interceptor NoopOutputInterceptor) PauseIntercepting()                            {}
 func (interceptor NoopOutputInterceptor) ResumeIntercepting()                           {}
+func (interceptor NoopOutputInterceptor) IsIntercepting() bool                          { return false }
 func (interceptor NoopOutputInterceptor) Shutdown()                                     {}
 
 type pipePair struct {
@@ -89,25 +101,64 @@ func startPipeFactory(pipeChannel chan pipePair, shutdown chan interface{}) {
 
 type interceptorImplementation interface {
 	CreateStdoutStderrClones() (*os.File, *os.File)
-	ConnectPipeToStdoutStderr(*os.File)
+	ConnectPipesToStdoutStderr(*os.File, *os.File)
 	RestoreStdoutStderrFromClones(*os.File, *os.File)
 	ShutdownClones(*os.File, *os.File)
 }
 
+type interceptedContent struct {
+	output            string
+	stdout            string
+	stderr            string
+	timestampedWrites []TimestampedWrite
+}
+
+// lockedWriter serializes writes from the goroutines copying stdout and stderr off of their pipes
+type lockedWriter struct {
+	lock *sync.Mutex
+	w    io.Writer
+}
+
+func (w lockedWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.w.Write(p)
+}
+
+// timestampingBuffer records when each chunk of intercepted output is read off the pipe
+type timestampingBuffer struct {
+	bytes.Buffer
+	timestampedWrites []TimestampedWrite
+}
+
+func (b *timestampingBuffer) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		b.timestampedWrites = append(b.timestampedWrites, TimestampedWrite{Offset: b.Len(), Time: time.Now()})
+	}
+	return b.Buffer.Write(p)
+}
+
 type genericOutputInterceptor struct {
 	intercepting bool
 
 	stdoutClone *os.File
 	stderrClone *os.File
 	pipe        pipePair
+	stderrPipe  pipePair
+
+	separateStdoutStderr bool
+	separating           bool
 
 	shutdown           chan interface{}
 	emergencyBailout   chan interface{}
 	pipeChannel        chan pipePair
-	interceptedContent chan string
+	interceptedContent chan interceptedContent
 
 	forwardTo         io.Writer
 	accumulatedOutput string
+	accumulatedStdout string
+	accumulatedStderr string
+	timestampedWrites []TimestampedWrite
 
 	implementation interceptorImplementation
 }
@@ -121,6 +172,9 @@ func (interceptor *genericOutputInterceptor) StartInterceptingOutputAndForwardTo
 		return
 	}
 	interceptor.accumulatedOutput = ""
+	interceptor.accumulatedStdout = ""
+	interceptor.accumulatedStderr = ""
+	interceptor.timestampedWrites = nil
 	interceptor.forwardTo = w
 	interceptor.ResumeIntercepting()
 }
@@ -132,6 +186,19 @@ func (interceptor *genericOutputInterceptor) StopInterceptingAndReturnOutput() s
 	return interceptor.accumulatedOutput
 }
 
+// TimestampedWrites returns when each chunk of the output returned by StopInterceptingAndReturnOutput was read off the pipe
+func (interceptor *genericOutputInterceptor) TimestampedWrites() []TimestampedWrite {
+	return interceptor.timestampedWrites
+}
+
+func (interceptor *genericOutputInterceptor) SetSeparateStdoutStderr(separate bool) {
+	interceptor.separateStdoutStderr = separate
+}
+
+func (interceptor *genericOutputInterceptor) SeparatedOutput() (string, string) {
+	return interceptor.accumulatedStdout, interceptor.accumulatedStderr
+}
+
 func (interceptor *genericOutputInterceptor) ResumeIntercepting() {
 	if interceptor.intercepting {
 		return
@@ -146,29 +213,61 @@ func (interceptor *genericOutputInterceptor) ResumeIntercepting() {
 	// Now we make a pipe, we'll use this to redirect the input to the 1 and 2 file descriptors (this is how everything else in the world is string to log to stdout and stderr)
 	// we get the pipe from our pipe factory.  it runs in the background so we can request the next pipe while the spec being intercepted is running
 	interceptor.pipe = <-interceptor.pipeChannel
+	// when separating stdout and stderr we need a second pipe for stderr
+	interceptor.separating = interceptor.separateStdoutStderr
+	if interceptor.separating {
+		interceptor.stderrPipe = <-interceptor.pipeChannel
+	}
 
 	interceptor.emergencyBailout = make(chan interface{})
 
-	//Spin up a goroutine to copy data from the pipe into a buffer, this is how we capture any output the user is emitting
+	//Spin up a goroutine to copy data from the pipe(s) into buffers, this is how we capture any output the user is emitting
 	go func() {
-		buffer := &bytes.Buffer{}
-		destination := io.MultiWriter(buffer, interceptor.forwardTo)
+		buffer := &timestampingBuffer{}
+		var destination io.Writer = io.MultiWriter(buffer, interceptor.forwardTo)
+		readers := []*os.File{interceptor.pipe.reader}
+		separatedBuffers := []*bytes.Buffer{{}}
+		if interceptor.separating {
+			// stdout and stderr are copied concurrently, so writes to the shared buffer must be serialized.  The merged output is ordered by when each chunk was read off its pipe.
+			destination = lockedWriter{lock: &sync.Mutex{}, w: destination}
+			readers = append(readers, interceptor.stderrPipe.reader)
+			separatedBuffers = append(separatedBuffers, &bytes.Buffer{})
+		}
 		copyFinished := make(chan interface{})
-		reader := interceptor.pipe.reader
+		wg := &sync.WaitGroup{}
+		for i := range readers {
+			wg.Add(1)
+			go func(reader *os.File, separatedBuffer *bytes.Buffer) {
+				io.Copy(io.MultiWriter(separatedBuffer, destination), reader)
+				reader.Close() // close the read end of the pipe so we don't leak a file descriptor
+				wg.Done()
+			}(readers[i], separatedBuffers[i])
+		}
 		go func() {
-			io.Copy(destination, reader)
-			reader.Close() // close the read end of the pipe so we don't leak a file descriptor
+			wg.Wait()
 			close(copyFinished)
 		}()
 		select {
 		case <-copyFinished:
-			interceptor.interceptedContent <- buffer.String()
+			content := interceptedContent{output: buffer.String(), timestampedWrites: buffer.timestampedWrites}
+			if interceptor.separating {
+				content.stdout, content.stderr = separatedBuffers[0].String(), separatedBuffers[1].String()
+			}
+			interceptor.interceptedContent <- content
 		case <-interceptor.emergencyBailout:
-			interceptor.interceptedContent <- ""
+			interceptor.interceptedContent <- interceptedContent{}
 		}
 	}()
 
-	interceptor.implementation.ConnectPipeToStdoutStderr(interceptor.pipe.writer)
+	if interceptor.separating {
+		interceptor.implementation.ConnectPipesToStdoutStderr(interceptor.pipe.writer, interceptor.stderrPipe.writer)
+	} else {
+		interceptor.implementation.ConnectPipesToStdoutStderr(interceptor.pipe.writer, interceptor.pipe.writer)
+	}
+}
+
+func (interceptor *genericOutputInterceptor) IsIntercepting() bool {
+	return interceptor.intercepting
 }
 
 func (interceptor *genericOutputInterceptor) PauseIntercepting() {
@@ -178,12 +277,15 @@ func (interceptor *genericOutputInterceptor) PauseIntercepting() {
 	// first we have to close the write end of the pipe.  To do this we have to close all file descriptors pointing
 	// to the write end.  So that would be the pipewriter itself, and FD #1 and FD #2 if we've Dup2'd them
 	interceptor.pipe.writer.Close() // the pipewriter itself
+	if interceptor.separating {
+		interceptor.stderrPipe.writer.Close()
+	}
 
 	// we also need to stop intercepting. we do that by reconnecting the stdout and stderr file descriptions back to their respective #1 and #2 file descriptors;
 	// this also closes #1 and #2 before it points that their original stdout and stderr file descriptions
 	interceptor.implementation.RestoreStdoutStderrFromClones(interceptor.stdoutClone, interceptor.stderrClone)
 
-	var content string
+	var content interceptedContent
 	select {
 	case content = <-interceptor.interceptedContent:
 	case <-time.After(BAILOUT_TIME):
@@ -201,10 +303,21 @@ func (interceptor *genericOutputInterceptor) PauseIntercepting() {
 			We tack on a message to notify the user that they've hit this edgecase and encourage them to address it.
 		*/
 		close(interceptor.emergencyBailout)
-		content = <-interceptor.interceptedContent + BAILOUT_MESSAGE
+		content = <-interceptor.interceptedContent
+		content.timestampedWrites = append(content.timestampedWrites, TimestampedWrite{Offset: len(content.output), Time: time.Now()})
+		content.output += BAILOUT_MESSAGE
+		if interceptor.separating {
+			content.stderr += BAILOUT_MESSAGE
+		}
 	}
 
-	interceptor.accumulatedOutput += content
+	for _, write := range content.timestampedWrites {
+		write.Offset += len(interceptor.accumulatedOutput)
+		interceptor.timestampedWrites = append(interceptor.timestampedWrites, write)
+	}
+	interceptor.accumulatedOutput += content.output
+	interceptor.accumulatedStdout += content.stdout
+	interceptor.accumulatedStderr += content.stderr
 	interceptor.intercepting = false
 }
 
@@ -222,7 +335,7 @@ func (interceptor *genericOutputInterceptor) Shutdown() {
 /* This is used on windows builds but included here so it can be explicitly tested on unix systems too */
 func NewOSGlobalReassigningOutputInterceptor() OutputInterceptor {
 	return &genericOutputInterceptor{
-		interceptedContent: make(chan string),
+		interceptedContent: make(chan interceptedContent),
 		pipeChannel:        make(chan pipePair),
 		shutdown:           make(chan interface{}),
 		implementation:     &osGlobalReassigningOutputInterceptorImpl{},
@@ -235,9 +348,9 @@ func (impl *osGlobalReassigningOutputInterceptorImpl) CreateStdoutStderrClones()
 	return os.Stdout, os.Stderr
 }
 
-func (impl *osGlobalReassigningOutputInterceptorImpl) ConnectPipeToStdoutStderr(pipeWriter *os.File) {
-	os.Stdout = pipeWriter
-	os.Stderr = pipeWriter
+func (impl *osGlobalReassigningOutputInterceptorImpl) ConnectPipesToStdoutStderr(stdoutPipeWriter *os.File, stderrPipeWriter *os.File) {
+	os.Stdout = stdoutPipeWriter
+	os.Stderr = stderrPipeWriter
 }
 
 func (impl *osGlobalReassigningOutputInterceptorImpl) RestoreStdoutStderrFromClones(stdoutClone *os.File, stderrClone *os.File) {
@@ -37,6 +37,55 @@ var _ = Describe("OutputInterceptor", func() {
 			Ω(buffer).Should(gbytes.Say("hi stdout\nhi stderr\n"))
 		})
 
+		It("records when each chunk of intercepted output was written, across pauses", func() {
+			interceptor.StartInterceptingOutput()
+			fmt.Println("hi stdout")
+			interceptor.PauseIntercepting()
+			interceptor.ResumeIntercepting()
+			fmt.Fprintln(os.Stderr, "hi stderr")
+			output := interceptor.StopInterceptingAndReturnOutput()
+			Ω(output).Should(Equal("hi stdout\nhi stderr\n"))
+			writes := interceptor.TimestampedWrites()
+			Ω(writes).Should(HaveLen(2))
+			Ω(writes[0].Offset).Should(Equal(0))
+			Ω(writes[1].Offset).Should(Equal(len("hi stdout\n")))
+			Ω(writes[1].Time).Should(BeTemporally(">=", writes[0].Time))
+
+			interceptor.StartInterceptingOutput()
+			Ω(interceptor.StopInterceptingAndReturnOutput()).Should(BeEmpty())
+			Ω(interceptor.TimestampedWrites()).Should(BeEmpty())
+		})
+
+		It("can capture stdout and stderr separately", func() {
+			interceptor.SetSeparateStdoutStderr(true)
+			for i := 0; i < 256; i++ { //we loop here to make sure we aren't leaking any file descriptors when using two pipes
+				interceptor.StartInterceptingOutput()
+				fmt.Println("hi stdout")
+				fmt.Fprintln(os.Stderr, "hi stderr")
+				interceptor.PauseIntercepting()
+				fmt.Println("not captured")
+				interceptor.ResumeIntercepting()
+				fmt.Fprintln(os.Stderr, "hi stderr, again")
+				fmt.Println("hi stdout, again")
+				output := interceptor.StopInterceptingAndReturnOutput()
+				stdout, stderr := interceptor.SeparatedOutput()
+				Ω(stdout).Should(Equal("hi stdout\nhi stdout, again\n"))
+				Ω(stderr).Should(Equal("hi stderr\nhi stderr, again\n"))
+				Ω(output).Should(HaveLen(len(stdout) + len(stderr)))
+				Ω(output).Should(ContainSubstring("hi stdout\n"))
+				Ω(output).Should(ContainSubstring("hi stderr, again\n"))
+			}
+
+			interceptor.SetSeparateStdoutStderr(false)
+			interceptor.StartInterceptingOutput()
+			fmt.Println("hi stdout")
+			fmt.Fprintln(os.Stderr, "hi stderr")
+			Ω(interceptor.StopInterceptingAndReturnOutput()).Should(Equal("hi stdout\nhi stderr\n"))
+			stdout, stderr := interceptor.SeparatedOutput()
+			Ω(stdout).Should(BeEmpty())
+			Ω(stderr).Should(BeEmpty())
+		})
+
 		It("is stable across multiple shutdowns", func() {
 			numRoutines := runtime.NumGoroutine()
 			for i := 0; i < 2048; i++ { //we loop here to stress test and make sure we aren't leaking any file descriptors
@@ -11,7 +11,7 @@ import (
 
 func NewOutputInterceptor() OutputInterceptor {
 	return &genericOutputInterceptor{
-		interceptedContent: make(chan string),
+		interceptedContent: make(chan interceptedContent),
 		pipeChannel:        make(chan pipePair),
 		shutdown:           make(chan interface{}),
 		implementation:     &dupSyscallOutputInterceptorImpl{},
@@ -48,13 +48,13 @@ func (impl *dupSyscallOutputInterceptorImpl) CreateStdoutStderrClones() (*os.Fil
 	return stdoutClone, stderrClone
 }
 
-func (impl *dupSyscallOutputInterceptorImpl) ConnectPipeToStdoutStderr(pipeWriter *os.File) {
-	// To redirect output to our pipe we need to point the 1 and 2 file descriptors (which is how the world tries to log things)
-	// to the write end of the pipe.
-	// We do this with Dup2 (possibly Dup3 on some architectures) to have file descriptors 1 and 2 point to the same file description as the pipeWriter
-	// This effectively shunts data written to stdout and stderr to the write end of our pipe
-	unix.Dup2(int(pipeWriter.Fd()), 1)
-	unix.Dup2(int(pipeWriter.Fd()), 2)
+func (impl *dupSyscallOutputInterceptorImpl) ConnectPipesToStdoutStderr(stdoutPipeWriter *os.File, stderrPipeWriter *os.File) {
+	// To redirect output to our pipe(s) we need to point the 1 and 2 file descriptors (which is how the world tries to log things)
+	// to the write end of the pipe(s).  Unless stdout and stderr are being separated both pipe writers are the same pipe.
+	// We do this with Dup2 (possibly Dup3 on some architectures) to have file descriptors 1 and 2 point to the same file descriptions as the pipe writers
+	// This effectively shunts data written to stdout and stderr to the write end of our pipe(s)
+	unix.Dup2(int(stdoutPipeWriter.Fd()), 1)
+	unix.Dup2(int(stderrPipeWriter.Fd()), 2)
 }
 
 func (impl *dupSyscallOutputInterceptorImpl) RestoreStdoutStderrFromClones(stdoutClone *os.File, stderrClone *os.File) {
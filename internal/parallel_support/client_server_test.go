@@ -148,6 +148,49 @@ var _ = Describe("The Parallel Support Client & Server", func() {
 								})
 							})
 						})
+
+						Context("when the final SuiteDidEnd's outcome was determined by the SuiteSuccessPredicate", func() {
+							BeforeEach(func() {
+								endReport1.SuiteOutcomeDeterminedByPredicate = true
+								Ω(client.PostSuiteDidEnd(endReport3)).Should(Succeed())
+								Ω(client.PostSuiteDidEnd(endReport2)).Should(Succeed())
+								Ω(client.PostSuiteDidEnd(endReport1)).Should(Succeed())
+							})
+
+							It("forwards the aggregation with the outcome determined by the predicate", func() {
+								Ω(reporter.End.SuiteSucceeded).Should(BeTrue())
+								Ω(reporter.End.SuiteOutcomeDeterminedByPredicate).Should(BeTrue())
+								Ω(reporter.End.SpecReports).Should(ConsistOf(specReportA, specReportB, specReportC))
+							})
+						})
+					})
+				})
+
+				Context("when the parallel output mode is as-completed", func() {
+					BeforeEach(func() {
+						beginReport.SuiteConfig.ParallelOutputMode = types.ParallelOutputModeAsCompleted
+						Ω(client.PostSuiteWillBegin(beginReport)).Should(Succeed())
+						Ω(client.PostDidRun(specReportA)).Should(Succeed())
+					})
+
+					It("forwards the first SuiteWillBegin and any summaries immediately, without waiting for the other procs to begin", func() {
+						Ω(reporter.Begin).Should(Equal(beginReport))
+						Ω(reporter.Will.Names()).Should(ConsistOf("A"))
+						Ω(reporter.Did.Names()).Should(ConsistOf("A"))
+					})
+
+					Context("when the remaining procs report SuiteWillBegin", func() {
+						BeforeEach(func() {
+							Ω(client.PostSuiteWillBegin(beginReport)).Should(Succeed())
+							Ω(client.PostDidRun(specReportB)).Should(Succeed())
+							Ω(client.PostSuiteWillBegin(thirdBeginReport)).Should(Succeed())
+						})
+
+						It("does not forward SuiteWillBegin again, or repeat any summaries", func() {
+							Ω(reporter.Begin).Should(Equal(beginReport))
+							Ω(reporter.Will.Names()).Should(Equal([]string{"A", "B"}))
+							Ω(reporter.Did.Names()).Should(Equal([]string{"A", "B"}))
+						})
 					})
 				})
 			})
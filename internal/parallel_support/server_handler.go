@@ -34,6 +34,7 @@ type ServerHandler struct {
 	numSuiteDidEnds   int
 	aggregatedReport  types.Report
 	reportHoldingArea []types.SpecReport
+	emitAsCompleted   bool
 }
 
 func newServerHandler(parallelTotal int, reporter reporters.Reporter) *ServerHandler {
@@ -57,7 +58,11 @@ func (handler *ServerHandler) SpecSuiteWillBegin(report types.Report, _ *Void) e
 	handler.numSuiteDidBegins += 1
 
 	// all summaries are identical, so it's fine to simply emit the last one of these
-	if handler.numSuiteDidBegins == handler.parallelTotal {
+	// unless we're emitting output as it completes - in which case we emit the first one so that the processes that have started don't wait on those that haven't
+	if handler.numSuiteDidBegins == 1 && report.SuiteConfig.EmitsParallelOutputAsCompleted() {
+		handler.emitAsCompleted = true
+		handler.reporter.SuiteWillBegin(report)
+	} else if handler.numSuiteDidBegins == handler.parallelTotal && !handler.emitAsCompleted {
 		handler.reporter.SuiteWillBegin(report)
 
 		for _, summary := range handler.reportHoldingArea {
@@ -75,7 +80,7 @@ func (handler *ServerHandler) DidRun(report types.SpecReport, _ *Void) error {
 	handler.lock.Lock()
 	defer handler.lock.Unlock()
 
-	if handler.numSuiteDidBegins == handler.parallelTotal {
+	if handler.numSuiteDidBegins == handler.parallelTotal || handler.emitAsCompleted {
 		handler.reporter.WillRun(report)
 		handler.reporter.DidRun(report)
 	} else {
@@ -95,6 +100,10 @@ func (handler *ServerHandler) SpecSuiteDidEnd(report types.Report, _ *Void) erro
 	} else {
 		handler.aggregatedReport = handler.aggregatedReport.Add(report)
 	}
+	if report.SuiteOutcomeDeterminedByPredicate {
+		// proc 1 evaluates the SuiteSuccessPredicate over the aggregated report so its outcome stands
+		handler.aggregatedReport.SuiteSucceeded = report.SuiteSucceeded
+	}
 
 	if handler.numSuiteDidEnds == handler.parallelTotal {
 		handler.reporter.SuiteDidEnd(handler.aggregatedReport)
@@ -0,0 +1,106 @@
+//go:build go1.20
+// +build go1.20
+
+package internal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/coverage"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// perSpecCoverageRecorder uses Go's runtime coverage counters to determine which files a spec covered
+type perSpecCoverageRecorder struct {
+	dir string
+}
+
+// newPerSpecCoverageRecorder returns an error if the test binary was not built with -cover and -covermode=atomic
+func newPerSpecCoverageRecorder() (*perSpecCoverageRecorder, error) {
+	dir, err := os.MkdirTemp("", "ginkgo-per-spec-coverage")
+	if err != nil {
+		return nil, err
+	}
+	// go tool covdata expects the meta-data file to live alongside the counter data files
+	if err := coverage.WriteMetaDir(dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	// ClearCounters fails unless the binary was built with -covermode=atomic
+	if err := coverage.ClearCounters(); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return &perSpecCoverageRecorder{dir: dir}, nil
+}
+
+func (r *perSpecCoverageRecorder) reset() error {
+	return coverage.ClearCounters()
+}
+
+// coveredFiles returns the sorted list of files with at least one covered statement since the last call to reset
+func (r *perSpecCoverageRecorder) coveredFiles() ([]string, error) {
+	staleCounters, err := filepath.Glob(filepath.Join(r.dir, "covcounters.*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, staleCounter := range staleCounters {
+		os.Remove(staleCounter)
+	}
+	if err := coverage.WriteCountersDir(r.dir); err != nil {
+		return nil, err
+	}
+
+	profile := filepath.Join(r.dir, "profile.out")
+	output, err := exec.Command("go", "tool", "covdata", "textfmt", "-i="+r.dir, "-o="+profile).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("go tool covdata failed: %w\n%s", err, string(output))
+	}
+	f, err := os.Open(profile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseCoveredFiles(f)
+}
+
+func (r *perSpecCoverageRecorder) cleanup() {
+	os.RemoveAll(r.dir)
+}
+
+// parseCoveredFiles extracts the files with non-zero counts from a text-format cover profile.  Each line looks like:
+//
+//	github.com/org/pkg/file.go:12.34,14.2 3 1
+func parseCoveredFiles(profile io.Reader) ([]string, error) {
+	covered := map[string]bool{}
+	scanner := bufio.NewScanner(profile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		colon := strings.LastIndex(line, ":")
+		fields := strings.Fields(line)
+		if colon == -1 || len(fields) != 3 {
+			continue
+		}
+		if count, err := strconv.Atoi(fields[2]); err == nil && count > 0 {
+			covered[line[:colon]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for file := range covered {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files, nil
+}
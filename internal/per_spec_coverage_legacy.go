@@ -0,0 +1,19 @@
+//go:build !go1.20
+// +build !go1.20
+
+package internal
+
+import "errors"
+
+// per-spec coverage relies on the runtime/coverage package introduced in Go 1.20
+type perSpecCoverageRecorder struct{}
+
+func newPerSpecCoverageRecorder() (*perSpecCoverageRecorder, error) {
+	return nil, errors.New("per-spec coverage requires Go 1.20 or later")
+}
+
+func (r *perSpecCoverageRecorder) reset() error { return nil }
+
+func (r *perSpecCoverageRecorder) coveredFiles() ([]string, error) { return nil, nil }
+
+func (r *perSpecCoverageRecorder) cleanup() {}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// perSpecLogWriter writes the output captured by each spec to its own file
+type perSpecLogWriter struct {
+	dir             string
+	parallelProcess int
+	names           map[string]int
+}
+
+// newPerSpecLogWriter creates dir if necessary.  parallelProcess should be 0 when the suite is not running in parallel.
+func newPerSpecLogWriter(dir string, parallelProcess int) (*perSpecLogWriter, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &perSpecLogWriter{dir: dir, parallelProcess: parallelProcess, names: map[string]int{}}, nil
+}
+
+/*
+write writes the spec's captured stdout/stderr and GinkgoWriter output to <dir>/<spec-hash>.log and returns the path to the file.  It writes nothing, and returns an empty path, if the spec captured no output.
+
+The hash is computed from the spec's full text and location and, when running in parallel, the process the spec ran on - so processes never write to the same file.  Specs that share a text and location get a numeric suffix.
+*/
+func (w *perSpecLogWriter) write(report types.SpecReport) (string, error) {
+	if report.CapturedStdOutErr == "" && report.CapturedGinkgoWriterOutput == "" {
+		return "", nil
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d", report.FullText(), report.LeafNodeLocation, w.parallelProcess)))
+	name := hex.EncodeToString(hash[:8])
+	w.names[name] += 1
+	if w.names[name] > 1 {
+		name = fmt.Sprintf("%s-%d", name, w.names[name])
+	}
+	path := filepath.Join(w.dir, name+".log")
+	if err := os.WriteFile(path, []byte(report.InterleavedOutput()), 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -20,12 +20,18 @@ func NewReportEntry(name string, cl types.CodeLocation, args ...interface{}) (Re
 		switch x := arg.(type) {
 		case types.ReportEntryVisibility:
 			out.Visibility = x
+		case types.ReportEntrySeverity:
+			out.Severity = x
+		case types.ReportEntryTarget:
+			out.Target = x
 		case types.CodeLocation:
 			out.Location = x
 		case Offset:
 			out.Location = types.NewCodeLocation(2 + int(x))
 		case time.Time:
 			out.Time = x
+		case ReportEntry:
+			out.Children = append(out.Children, x)
 		default:
 			if didSetValue {
 				return ReportEntry{}, types.GinkgoErrors.TooManyReportEntryValues(out.Location, arg)
@@ -222,6 +222,47 @@ var _ = Describe("ReportEntry and ReportEntries", func() {
 		})
 	})
 
+	Context("with a ReportEntrySeverity", func() {
+		It("defaults to info", func() {
+			reportEntry, err = internal.NewReportEntry("name", cl)
+			Ω(reportEntry.Severity).Should(Equal(types.ReportEntrySeverityInfo))
+		})
+
+		It("uses the passed in severity", func() {
+			reportEntry, err = internal.NewReportEntry("name", cl, types.ReportEntrySeverityWarning)
+			Ω(reportEntry.GetRawValue()).Should(BeNil())
+			Ω(reportEntry.Severity).Should(Equal(types.ReportEntrySeverityWarning))
+		})
+
+		It("round-trips through JSON correctly", func() {
+			reportEntry, err = internal.NewReportEntry("name", cl, types.ReportEntrySeverityError)
+			data, err := json.Marshal(reportEntry)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).Should(ContainSubstring(`"Severity":"error"`))
+			Ω(reportEntryJSONRoundTrip(reportEntry).Severity).Should(Equal(types.ReportEntrySeverityError))
+		})
+	})
+
+	Context("with a ReportEntryTarget", func() {
+		It("defaults to none and omits the target from JSON", func() {
+			reportEntry, err = internal.NewReportEntry("name", cl)
+			Ω(reportEntry.Target).Should(Equal(types.ReportEntryTargetNone))
+			data, err := json.Marshal(reportEntry)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).ShouldNot(ContainSubstring(`"Target"`))
+		})
+
+		It("uses the passed in target and round-trips through JSON correctly", func() {
+			reportEntry, err = internal.NewReportEntry("name", cl, 3, types.ReportEntryTargetJUnitProperty)
+			Ω(reportEntry.GetRawValue()).Should(Equal(3))
+			Ω(reportEntry.Target).Should(Equal(types.ReportEntryTargetJUnitProperty))
+			data, err := json.Marshal(reportEntry)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).Should(ContainSubstring(`"Target":"junit-property"`))
+			Ω(reportEntryJSONRoundTrip(reportEntry).Target).Should(Equal(types.ReportEntryTargetJUnitProperty))
+		})
+	})
+
 	Context("with a time", func() {
 		It("uses the passed in time", func() {
 			t := time.Date(1984, 3, 7, 0, 0, 0, 0, time.Local)
@@ -231,6 +272,30 @@ var _ = Describe("ReportEntry and ReportEntries", func() {
 		})
 	})
 
+	Context("with nested ReportEntries", func() {
+		It("attaches them as children, in order, without treating them as the value", func() {
+			grandchild, _ := internal.NewReportEntry("grandchild", cl, "c")
+			childA, _ := internal.NewReportEntry("child-a", cl, "a", grandchild)
+			childB, _ := internal.NewReportEntry("child-b", cl)
+			reportEntry, err = internal.NewReportEntry("name", cl, childA, 17, childB)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(reportEntry.GetRawValue()).Should(Equal(17))
+			Ω(reportEntry.Children).Should(HaveLen(2))
+			Ω(reportEntry.Children[0].Name).Should(Equal("child-a"))
+			Ω(reportEntry.Children[0].Children[0].Name).Should(Equal("grandchild"))
+			Ω(reportEntry.Children[1].Name).Should(Equal("child-b"))
+		})
+
+		It("round-trips through JSON correctly", func() {
+			child, _ := internal.NewReportEntry("child", cl, "a")
+			reportEntry, err = internal.NewReportEntry("name", cl, child)
+			rtEntry := reportEntryJSONRoundTrip(reportEntry)
+			Ω(rtEntry.Children).Should(HaveLen(1))
+			Ω(rtEntry.Children[0].Name).Should(Equal("child"))
+			Ω(rtEntry.Children[0].StringRepresentation()).Should(Equal("a"))
+		})
+	})
+
 	Describe("ReportEntries.HasVisibility", func() {
 		It("is true when the ReportEntries have the requested visibilities", func() {
 			entries := types.ReportEntries{
@@ -260,6 +325,22 @@ var _ = Describe("ReportEntry and ReportEntries", func() {
 		})
 	})
 
+	Describe("ReportEntries.WithMinSeverity", func() {
+		It("returns the subset of report entries at or above the requested severity", func() {
+			entries := types.ReportEntries{
+				types.ReportEntry{Name: "A", Severity: types.ReportEntrySeverityInfo},
+				types.ReportEntry{Name: "B", Severity: types.ReportEntrySeverityWarning},
+				types.ReportEntry{Name: "C", Severity: types.ReportEntrySeverityError},
+			}
+			Ω(entries.WithMinSeverity(types.ReportEntrySeverityWarning)).Should(Equal(
+				types.ReportEntries{
+					types.ReportEntry{Name: "B", Severity: types.ReportEntrySeverityWarning},
+					types.ReportEntry{Name: "C", Severity: types.ReportEntrySeverityError},
+				},
+			))
+		})
+	})
+
 	Describe("mini-integration test - validating that the DSL correctly wires into the suite", func() {
 		Context("when passed a value", func() {
 			It("works!", func() {
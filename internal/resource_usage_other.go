@@ -0,0 +1,9 @@
+//go:build !linux && !freebsd && !openbsd && !netbsd && !dragonfly && !darwin
+// +build !linux,!freebsd,!openbsd,!netbsd,!dragonfly,!darwin
+
+package internal
+
+// PeakRSS is not supported on this platform and always returns -1
+func PeakRSS() int64 {
+	return -1
+}
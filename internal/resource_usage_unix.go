@@ -0,0 +1,15 @@
+//go:build linux || freebsd || openbsd || netbsd || dragonfly
+// +build linux freebsd openbsd netbsd dragonfly
+
+package internal
+
+import "syscall"
+
+// PeakRSS returns the peak resident set size of the current process, in bytes
+func PeakRSS() int64 {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return -1
+	}
+	return int64(usage.Maxrss) * 1024 //Maxrss is reported in kilobytes
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+slowSpecStackCapture captures the spec's goroutine if a spec decorated with CaptureStackIfSlowerThan is still running when its threshold elapses.
+
+By the time a slow spec completes its goroutine has exited - so the stack is captured while the spec is still running, at the moment the threshold elapses, and attached to the spec's report when the spec completes.
+*/
+type slowSpecStackCapture struct {
+	timer *time.Timer
+	done  chan interface{}
+	stack *types.SlowSpecStack
+}
+
+// startSlowSpecStackCapture arms a capture for the current spec.  It returns nil if threshold is not positive.
+func (suite *Suite) startSlowSpecStackCapture(threshold time.Duration) *slowSpecStackCapture {
+	if threshold <= 0 {
+		return nil
+	}
+	capture := &slowSpecStackCapture{done: make(chan interface{})}
+	capture.timer = time.AfterFunc(threshold, func() {
+		capture.stack = suite.captureSlowSpecStack(threshold)
+		close(capture.done)
+	})
+	return capture
+}
+
+// stop disarms the capture and returns the captured stack - or nil if the threshold did not elapse
+func (capture *slowSpecStackCapture) stop() *types.SlowSpecStack {
+	if capture == nil || capture.timer.Stop() {
+		return nil
+	}
+	<-capture.done
+	return capture.stack
+}
+
+func (suite *Suite) captureSlowSpecStack(threshold time.Duration) *types.SlowSpecStack {
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+	pr, err := NewProgressReport(suite.isRunningInParallel(), suite.currentSpecReport, suite.currentNode, suite.currentNodeStartTime, suite.currentByStep, "", types.TimelineLocation{}, nil, suite.config.SourceRoots, false)
+	if err != nil || pr.SpecGoroutine().IsZero() {
+		// the threshold elapsed while Ginkgo was between nodes (e.g. between the attempts of a retried spec)
+		return nil
+	}
+	return &types.SlowSpecStack{
+		Threshold:           threshold,
+		CurrentNodeType:     suite.currentNode.NodeType,
+		CurrentNodeText:     suite.currentNode.Text,
+		CurrentNodeLocation: suite.currentNode.CodeLocation,
+		Goroutine:           pr.SpecGoroutine(),
+	}
+}
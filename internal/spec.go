@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 type Spec struct {
 	Nodes Nodes
 	Skip  bool
+	ID    string
 }
 
 func (s Spec) SubjectID() uint {
@@ -58,6 +62,28 @@ func (s Spec) SpecTimeout() time.Duration {
 
 type Specs []Spec
 
+/*
+assignSpecIDs sets the ID of each spec to a stable identifier computed from the texts and code locations of the spec's containers and subject.
+
+Only the base name of each file is used so that IDs are the same across machines.  Specs that would otherwise share an ID (e.g. specs generated in a loop) are told apart by the order in which they appear in the tree.
+*/
+func assignSpecIDs(specs Specs) Specs {
+	seen := map[string]int{}
+	for i := range specs {
+		hash := fnv.New64a()
+		for _, node := range specs[i].Nodes.WithType(types.NodeTypesForContainerAndIt) {
+			fmt.Fprintf(hash, "%s\x00%s:%d\x00", node.Text, filepath.Base(node.CodeLocation.FileName), node.CodeLocation.LineNumber)
+		}
+		id := fmt.Sprintf("%016x", hash.Sum64())
+		seen[id] += 1
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%s-%d", id, seen[id])
+		}
+		specs[i].ID = id
+	}
+	return specs
+}
+
 func (s Specs) HasAnySpecsMarkedPending() bool {
 	for i := range s {
 		if s[i].Nodes.HasNodeMarkedPending() {
@@ -78,6 +104,27 @@ func (s Specs) CountWithoutSkip() int {
 	return n
 }
 
+// CountContainersAndSetupNodes returns the number of distinct container nodes and setup nodes (BeforeEach, JustBeforeEach, AfterEach, JustAfterEach, BeforeAll, and AfterAll) across all specs
+func (s Specs) CountContainersAndSetupNodes() (int, int) {
+	setupNodeTypes := types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach | types.NodeTypeAfterEach | types.NodeTypeJustAfterEach | types.NodeTypeBeforeAll | types.NodeTypeAfterAll
+	seen := map[uint]bool{}
+	containers, setupNodes := 0, 0
+	for i := range s {
+		for _, node := range s[i].Nodes {
+			if seen[node.ID] {
+				continue
+			}
+			seen[node.ID] = true
+			if node.NodeType.Is(types.NodeTypeContainer) {
+				containers += 1
+			} else if node.NodeType.Is(setupNodeTypes) {
+				setupNodes += 1
+			}
+		}
+	}
+	return containers, setupNodes
+}
+
 func (s Specs) AtIndices(indices SpecIndices) Specs {
 	out := make(Specs, len(indices))
 	for i, idx := range indices {
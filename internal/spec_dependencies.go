@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+validateSpecDependencies checks the DependsOn decorations of the subject nodes among children, the nodes of a single container (or the top level of the suite).
+
+A spec can only depend on specs that share its immediate container.  Dependencies are identified by the text of the spec's It node and must not form a cycle.
+*/
+func validateSpecDependencies(children TreeNodes) error {
+	itsByText := map[string]Nodes{}
+	its := Nodes{}
+	for _, child := range children {
+		if child.Node.NodeType.Is(types.NodeTypeIt) {
+			itsByText[child.Node.Text] = append(itsByText[child.Node.Text], child.Node)
+			its = append(its, child.Node)
+		}
+	}
+
+	prerequisites := map[uint]Nodes{}
+	for _, it := range its {
+		for _, dependency := range it.SpecDependencies {
+			if len(itsByText[dependency]) == 0 {
+				return types.GinkgoErrors.UnknownSpecDependency(it.CodeLocation, dependency)
+			}
+			prerequisites[it.ID] = append(prerequisites[it.ID], itsByText[dependency]...)
+		}
+	}
+
+	// depth-first search for a cycle, tracking the path so that the cycle can be reported
+	const unvisited, visiting, visited = 0, 1, 2
+	state := map[uint]int{}
+	path := Nodes{}
+	var visit func(node Node) error
+	visit = func(node Node) error {
+		state[node.ID] = visiting
+		path = append(path, node)
+		for _, prerequisite := range prerequisites[node.ID] {
+			switch state[prerequisite.ID] {
+			case visiting:
+				cycle := []string{}
+				for i := range path {
+					if path[i].ID == prerequisite.ID {
+						cycle = path[i:].Texts()
+						break
+					}
+				}
+				return types.GinkgoErrors.CyclicSpecDependencies(node.CodeLocation, append(cycle, prerequisite.Text))
+			case unvisited:
+				if err := visit(prerequisite); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[node.ID] = visited
+		return nil
+	}
+	for _, it := range its {
+		if state[it.ID] == unvisited {
+			if err := visit(it); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/*
+specDependencies resolves the DependsOn decorations of specs.  For the index of each spec that declares dependencies it returns the indices of the specs it depends on.
+
+Dependencies only resolve to specs that share the dependent spec's immediate container.  Since specs are resolved by text, a dependency on text shared by several specs is a dependency on all of them.
+*/
+func specDependencies(specs Specs) map[int][]int {
+	dependencies := map[int][]int{}
+	for i := range specs {
+		it := specs[i].FirstNodeWithType(types.NodeTypeIt)
+		if len(it.SpecDependencies) == 0 {
+			continue
+		}
+		container := immediateContainerID(specs[i])
+		for _, dependency := range it.SpecDependencies {
+			for j := range specs {
+				if i != j && immediateContainerID(specs[j]) == container && specs[j].FirstNodeWithType(types.NodeTypeIt).Text == dependency {
+					dependencies[i] = append(dependencies[i], j)
+				}
+			}
+		}
+	}
+	return dependencies
+}
+
+// immediateContainerID returns the ID of the container the spec's It node is declared in, or 0 for specs declared at the top level
+func immediateContainerID(spec Spec) uint {
+	containers := spec.Nodes.WithType(types.NodeTypeContainer)
+	if len(containers) == 0 {
+		return 0
+	}
+	return containers[len(containers)-1].ID
+}
+
+/*
+dependencyGroupIDs assigns each spec that declares, or is the target of, a dependency to a group.  Specs connected by dependencies share a group and must run together, on the same process.
+The ID of each group is the ID of the It node of the group's lowest-indexed spec.
+*/
+func dependencyGroupIDs(specs Specs, dependencies map[int][]int) map[int]uint {
+	parent := map[int]int{}
+	var find func(i int) int
+	find = func(i int) int {
+		if _, ok := parent[i]; !ok {
+			parent[i] = i
+		}
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	// the root of each group is always its lowest index so that the groups are the same regardless of the order in which the dependencies are visited
+	for i, prerequisites := range dependencies {
+		for _, j := range prerequisites {
+			a, b := find(i), find(j)
+			if a < b {
+				parent[b] = a
+			} else {
+				parent[a] = b
+			}
+		}
+	}
+
+	groupIDs := map[int]uint{}
+	for i := range parent {
+		groupIDs[i] = specs[find(i)].SubjectID()
+	}
+	return groupIDs
+}
+
+// orderByDependencies reorders specIndices so that every spec runs after the specs it depends on.  Otherwise, specs retain their relative order.
+func orderByDependencies(specIndices SpecIndices, dependencies map[int][]int) SpecIndices {
+	inGroup, placed := map[int]bool{}, map[int]bool{}
+	for _, idx := range specIndices {
+		inGroup[idx] = true
+	}
+	isReady := func(idx int) bool {
+		for _, prerequisite := range dependencies[idx] {
+			if inGroup[prerequisite] && !placed[prerequisite] {
+				return false
+			}
+		}
+		return true
+	}
+
+	out := SpecIndices{}
+	for len(out) < len(specIndices) {
+		progressed := false
+		for _, idx := range specIndices {
+			if !placed[idx] && isReady(idx) {
+				out = append(out, idx)
+				placed[idx] = true
+				progressed = true
+				break
+			}
+		}
+		if !progressed {
+			// cycles are rejected when the tree is built so this should never happen - but we'd rather run the remaining specs than hang
+			for _, idx := range specIndices {
+				if !placed[idx] {
+					out = append(out, idx)
+					placed[idx] = true
+				}
+			}
+		}
+	}
+	return out
+}
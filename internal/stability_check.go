@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// attemptOutputOffsets records how much output the current spec report had captured when an attempt began
+type attemptOutputOffsets struct {
+	ginkgoWriter int
+	stdOutErr    int
+	stdOut       int
+	stdErr       int
+}
+
+func (suite *Suite) currentAttemptOutputOffsets() attemptOutputOffsets {
+	return attemptOutputOffsets{
+		ginkgoWriter: len(suite.currentSpecReport.CapturedGinkgoWriterOutput),
+		stdOutErr:    len(suite.currentSpecReport.CapturedStdOutErr),
+		stdOut:       len(suite.currentSpecReport.CapturedStdOut),
+		stdErr:       len(suite.currentSpecReport.CapturedStdErr),
+	}
+}
+
+/*
+specReportForAttempt returns a copy of the current spec report whose captured output only includes the output emitted since offsets were recorded.
+
+Captured output accumulates across the attempts of a spec - so this is what allows a StabilityCheck to compare the output of two attempts.
+*/
+func (suite *Suite) specReportForAttempt(offsets attemptOutputOffsets) types.SpecReport {
+	report := suite.currentSpecReport
+	report.CapturedGinkgoWriterOutput = report.CapturedGinkgoWriterOutput[offsets.ginkgoWriter:]
+	report.CapturedStdOutErr = report.CapturedStdOutErr[offsets.stdOutErr:]
+	report.CapturedStdOut = report.CapturedStdOut[offsets.stdOut:]
+	report.CapturedStdErr = report.CapturedStdErr[offsets.stdErr:]
+	// the segments point into the accumulated output
+	report.CapturedOutputSegments = nil
+	return report
+}
+
+// stabilityCheckFailureMessage returns the failure message for a spec whose StabilityCheck rejected attempt curr when compared with the preceding attempt
+func stabilityCheckFailureMessage(curr types.SpecReport, err error) string {
+	return fmt.Sprintf("Spec was unstable: attempts %d and %d both passed but the StabilityCheck failed:\n%s", curr.NumAttempts-1, curr.NumAttempts, err.Error())
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +31,8 @@ type Suite struct {
 
 	phase Phase
 
+	treeConstructionErrors []error
+
 	suiteNodes   Nodes
 	cleanupNodes Nodes
 
@@ -40,15 +43,40 @@ type Suite struct {
 	interruptHandler  interrupt_handler.InterruptHandlerInterface
 	config            types.SuiteConfig
 	deadline          time.Time
+	clock             Clock
+
+	goroutineLeakDetector goroutineLeakDetector
+	perSpecCoverage       *perSpecCoverageRecorder
+	perSpecLog            *perSpecLogWriter
 
 	skipAll              bool
+	numSpecsStarted      int
 	report               types.Report
 	currentSpecReport    types.SpecReport
 	currentNode          Node
 	currentNodeStartTime time.Time
+	currentSpecTimeout   time.Duration
 
 	currentSpecContext *specContext
 
+	// the report passed to the ReportAfterSuite node that is currently running, if any
+	reportAfterSuiteReport *types.Report
+
+	forwardingUncapturedOutput bool
+	recordOutputSegments       bool
+	reporterConfig             types.ReporterConfig
+	captureEnvironment         bool
+	environmentAllowlist       []string
+
+	networkProbed   bool
+	networkProbeErr error
+
+	featureChecker func(string) bool
+
+	specHooks          []specHooks
+	specRetryCallbacks []func(types.SpecReport, int, types.Failure)
+	faultInjectors     []faultInjector
+
 	currentByStep types.SpecEvent
 	timelineOrder int
 
@@ -72,6 +100,7 @@ func NewSuite() *Suite {
 		tree:                    &TreeNode{},
 		phase:                   PhaseBuildTopLevel,
 		ProgressReporterManager: NewProgressReporterManager(),
+		clock:                   RealClock{},
 
 		selectiveLock: &sync.Mutex{},
 	}
@@ -87,10 +116,133 @@ func (suite *Suite) Clone() (*Suite, error) {
 		ProgressReporterManager: NewProgressReporterManager(),
 		topLevelContainers:      suite.topLevelContainers.Clone(),
 		suiteNodes:              suite.suiteNodes.Clone(),
+		treeConstructionErrors:  suite.treeConstructionErrors,
+		clock:                   suite.clock,
+		specHooks:               suite.specHooks,
+		specRetryCallbacks:      suite.specRetryCallbacks,
+		faultInjectors:          suite.faultInjectors,
+		featureChecker:          suite.featureChecker,
 		selectiveLock:           &sync.Mutex{},
 	}, nil
 }
 
+type specHooks struct {
+	before func(types.SpecReport)
+	after  func(types.SpecReport)
+}
+
+// RegisterSpecHooks registers functions that are called immediately before and after every spec.  Either function may be nil.
+func (suite *Suite) RegisterSpecHooks(before func(types.SpecReport), after func(types.SpecReport)) {
+	suite.specHooks = append(suite.specHooks, specHooks{before: before, after: after})
+}
+
+func (suite *Suite) runSpecHooksBefore(report types.SpecReport) {
+	for _, hooks := range suite.specHooks {
+		if hooks.before != nil {
+			hooks.before(report)
+		}
+	}
+}
+
+func (suite *Suite) runSpecHooksAfter(report types.SpecReport) {
+	for _, hooks := range suite.specHooks {
+		if hooks.after != nil {
+			hooks.after(report)
+		}
+	}
+}
+
+type faultInjector struct {
+	labelFilter types.LabelFilter
+	before      Node
+	after       Node
+}
+
+// RegisterFaultInjector registers functions that run around every spec whose labels match labelFilter.  The functions are run as top-level BeforeEach and AfterEach nodes and either may be nil.
+func (suite *Suite) RegisterFaultInjector(labelFilter string, before func(SpecContext), after func(SpecContext), cl types.CodeLocation) error {
+	filter, err := types.ParseLabelFilter(labelFilter)
+	if err != nil {
+		return err
+	}
+	injector := faultInjector{labelFilter: filter}
+	newInjectorNode := func(nodeType types.NodeType, body func(SpecContext)) Node {
+		if body == nil {
+			return Node{}
+		}
+		return Node{
+			ID:                   UniqueNodeID(),
+			NodeType:             nodeType,
+			Body:                 body,
+			HasContext:           true,
+			CodeLocation:         cl,
+			NestingLevel:         -1,
+			Labels:               Labels{},
+			PollProgressAfter:    -1,
+			PollProgressInterval: -1,
+			GracePeriod:          -1,
+		}
+	}
+	injector.before = newInjectorNode(types.NodeTypeBeforeEach, before)
+	injector.after = newInjectorNode(types.NodeTypeAfterEach, after)
+	suite.faultInjectors = append(suite.faultInjectors, injector)
+	return nil
+}
+
+// faultInjectorsFor returns the registered fault injectors, in registration order, whose label filter matches spec
+func (suite *Suite) faultInjectorsFor(spec Spec) []faultInjector {
+	if len(suite.faultInjectors) == 0 {
+		return nil
+	}
+	labels := UnionOfLabels(suite.report.SuiteLabels, spec.Nodes.UnionOfLabels())
+	out := []faultInjector{}
+	for _, injector := range suite.faultInjectors {
+		if injector.labelFilter(labels) {
+			out = append(out, injector)
+		}
+	}
+	return out
+}
+
+// OnSpecRetry registers a callback that is called each time a spec is about to be retried due to FlakeAttempts
+func (suite *Suite) OnSpecRetry(callback func(types.SpecReport, int, types.Failure)) {
+	suite.specRetryCallbacks = append(suite.specRetryCallbacks, callback)
+}
+
+// reportAttempt notifies the reporter of a failed attempt of a retried spec if the reporter implements reporters.AttemptReporter
+func (suite *Suite) reportAttempt(report types.SpecReport) {
+	if attemptReporter, ok := suite.reporter.(reporters.AttemptReporter); ok {
+		attemptReporter.DidRunAttempt(report)
+	}
+}
+
+// reportSpecOrder notifies the reporter of the specs that will run, in the order they will run, if the reporter implements reporters.SpecOrderReporter
+func (suite *Suite) reportSpecOrder(specs Specs, groupedSpecIndices GroupedSpecIndices, serialGroupedSpecIndices GroupedSpecIndices) {
+	orderReporter, ok := suite.reporter.(reporters.SpecOrderReporter)
+	if !ok {
+		return
+	}
+	reports := []types.SpecReport{}
+	for _, specIndices := range append(append(GroupedSpecIndices{}, groupedSpecIndices...), serialGroupedSpecIndices...) {
+		for _, spec := range specs.AtIndices(specIndices) {
+			if !spec.Skip {
+				reports = append(reports, specReportForSpec(spec))
+			}
+		}
+	}
+	orderReporter.SpecsWillRun(reports)
+}
+
+func (suite *Suite) runSpecRetryCallbacks(report types.SpecReport, attempt int, failure types.Failure) {
+	for _, callback := range suite.specRetryCallbacks {
+		callback(report, attempt, failure)
+	}
+}
+
+// SetClock replaces the Clock the suite uses to tell time.  It is intended for use in tests and must be called before the suite runs.
+func (suite *Suite) SetClock(clock Clock) {
+	suite.clock = clock
+}
+
 func (suite *Suite) BuildTree() error {
 	// During PhaseBuildTopLevel, the top level containers are stored in suite.topLevelCotainers and entered
 	// We now enter PhaseBuildTree where these top level containers are entered and added to the spec tree
@@ -101,10 +253,39 @@ func (suite *Suite) BuildTree() error {
 			return err
 		}
 	}
+	if len(suite.treeConstructionErrors) > 0 {
+		return suite.treeConstructionErrors[0]
+	}
+	return validateSpecDependencies(suite.tree.Children)
+}
+
+// RecordTreeConstructionError records an error encountered by a DSL helper (e.g. TableFromFile) that runs while the spec tree is being constructed but does not push a node itself.  BuildTree returns the first recorded error.
+func (suite *Suite) RecordTreeConstructionError(err error) {
+	suite.treeConstructionErrors = append(suite.treeConstructionErrors, err)
+}
+
+// ValidateTree performs the validations of the spec tree that depend on the suite's configuration.  It must be called after BuildTree.
+func (suite *Suite) ValidateTree(suiteConfig types.SuiteConfig) error {
+	if suiteConfig.RequireLabelsOnTopLevel {
+		texts, cls := []string{}, []types.CodeLocation{}
+		for _, child := range suite.tree.Children {
+			if child.Node.NodeType.Is(types.NodeTypeContainer) && len(child.Node.Labels) == 0 {
+				texts, cls = append(texts, child.Node.Text), append(cls, child.Node.CodeLocation)
+			}
+		}
+		if len(texts) > 0 {
+			return types.GinkgoErrors.TopLevelContainersMissingLabels(texts, cls)
+		}
+	}
+	if suiteConfig.MaxNestingDepth > 0 {
+		if deepest, depth := DeepestContainer(suite.tree); depth > suiteConfig.MaxNestingDepth {
+			return types.GinkgoErrors.MaxNestingDepthExceeded(deepest.Node.Text, deepest.Node.CodeLocation, depth, suiteConfig.MaxNestingDepth)
+		}
+	}
 	return nil
 }
 
-func (suite *Suite) Run(description string, suiteLabels Labels, suitePath string, failer *Failer, reporter reporters.Reporter, writer WriterInterface, outputInterceptor OutputInterceptor, interruptHandler interrupt_handler.InterruptHandlerInterface, client parallel_support.Client, progressSignalRegistrar ProgressSignalRegistrar, suiteConfig types.SuiteConfig) (bool, bool) {
+func (suite *Suite) Run(description string, suiteLabels Labels, reportedSuiteLabels Labels, suitePath string, failer *Failer, reporter reporters.Reporter, writer WriterInterface, outputInterceptor OutputInterceptor, interruptHandler interrupt_handler.InterruptHandlerInterface, client parallel_support.Client, progressSignalRegistrar ProgressSignalRegistrar, suiteConfig types.SuiteConfig) (bool, bool) {
 	if suite.phase != PhaseBuildTree {
 		panic("cannot run before building the tree = call suite.BuildTree() first")
 	}
@@ -118,16 +299,21 @@ func (suite *Suite) Run(description string, suiteLabels Labels, suitePath string
 	suite.reporter = reporter
 	suite.writer = writer
 	suite.outputInterceptor = outputInterceptor
+	suite.outputInterceptor.SetSeparateStdoutStderr(suiteConfig.SeparateStdoutStderr)
 	suite.interruptHandler = interruptHandler
 	suite.config = suiteConfig
 
 	if suite.config.Timeout > 0 {
-		suite.deadline = time.Now().Add(suite.config.Timeout)
+		suite.deadline = suite.clock.Now().Add(suite.config.Timeout)
+	}
+
+	if suite.config.FailOnGoroutineLeak {
+		suite.goroutineLeakDetector = newGoroutineLeakDetector(suite.config.GoroutineLeakSettleTime, suite.config.GoroutineLeakAllowlist)
 	}
 
 	cancelProgressHandler := progressSignalRegistrar(suite.handleProgressSignal)
 
-	success := suite.runSpecs(description, suiteLabels, suitePath, hasProgrammaticFocus, specs)
+	success := suite.runSpecs(description, UnionOfLabels(suiteLabels, reportedSuiteLabels), suitePath, hasProgrammaticFocus, specs)
 
 	cancelProgressHandler()
 
@@ -149,7 +335,7 @@ func (suite *Suite) PushNode(node Node) error {
 		return suite.pushCleanupNode(node)
 	}
 
-	if node.NodeType.Is(types.NodeTypeBeforeSuite | types.NodeTypeAfterSuite | types.NodeTypeSynchronizedBeforeSuite | types.NodeTypeSynchronizedAfterSuite | types.NodeTypeBeforeSuite | types.NodeTypeReportBeforeSuite | types.NodeTypeReportAfterSuite) {
+	if node.NodeType.Is(types.NodeTypeBeforeSuite | types.NodeTypeAfterSuite | types.NodeTypeSynchronizedBeforeSuite | types.NodeTypeSynchronizedAfterSuite | types.NodeTypeBeforeSuite | types.NodeTypeReportBeforeSuite | types.NodeTypeReportAfterSuite | types.NodeTypeAfterAllProcesses) {
 		return suite.pushSuiteNode(node)
 	}
 
@@ -178,6 +364,13 @@ func (suite *Suite) PushNode(node Node) error {
 		}
 	}
 
+	if len(node.SpecDependencies) > 0 {
+		firstOrderedNode := suite.tree.AncestorNodeChain().FirstNodeMarkedOrdered()
+		if !firstOrderedNode.IsZero() {
+			return types.GinkgoErrors.SpecDependencyInOrderedContainer(node.CodeLocation)
+		}
+	}
+
 	if node.NodeType == types.NodeTypeContainer {
 		// During PhaseBuildTopLevel we only track the top level containers without entering them
 		// We only enter the top level container nodes during PhaseBuildTree
@@ -202,6 +395,9 @@ func (suite *Suite) PushNode(node Node) error {
 				node.Body(nil)
 				return err
 			}()
+			if err == nil {
+				err = validateSpecDependencies(suite.tree.Children)
+			}
 			suite.tree = parentTree
 			return err
 		}
@@ -245,7 +441,7 @@ func (suite *Suite) pushCleanupNode(node Node) error {
 	}
 
 	switch suite.currentNode.NodeType {
-	case types.NodeTypeBeforeSuite, types.NodeTypeSynchronizedBeforeSuite, types.NodeTypeAfterSuite, types.NodeTypeSynchronizedAfterSuite:
+	case types.NodeTypeBeforeSuite, types.NodeTypeSynchronizedBeforeSuite, types.NodeTypeAfterSuite, types.NodeTypeSynchronizedAfterSuite, types.NodeTypeAfterAllProcesses:
 		node.NodeType = types.NodeTypeCleanupAfterSuite
 	case types.NodeTypeBeforeAll, types.NodeTypeAfterAll:
 		node.NodeType = types.NodeTypeCleanupAfterAll
@@ -274,7 +470,7 @@ func (suite *Suite) generateTimelineLocation() types.TimelineLocation {
 	return types.TimelineLocation{
 		Offset: len(suite.currentSpecReport.CapturedGinkgoWriterOutput) + suite.writer.Len(),
 		Order:  suite.timelineOrder,
-		Time:   time.Now(),
+		Time:   suite.clock.Now(),
 	}
 }
 
@@ -365,6 +561,52 @@ func (suite *Suite) AddReportEntry(entry ReportEntry) error {
 	return nil
 }
 
+func (suite *Suite) CollectValue(value types.CollectedValue) error {
+	if suite.phase != PhaseRun {
+		return types.GinkgoErrors.CollectValueNotDuringRunPhase(value.Location)
+	}
+	suite.selectiveLock.Lock()
+	suite.currentSpecReport.CollectedValues = append(suite.currentSpecReport.CollectedValues, value)
+	suite.selectiveLock.Unlock()
+	return nil
+}
+
+// ReportAfterSuiteReport returns the report passed to the running ReportAfterSuite node.  When running in parallel this report includes the specs that ran on every process.
+func (suite *Suite) ReportAfterSuiteReport(cl types.CodeLocation) (types.Report, error) {
+	suite.selectiveLock.Lock()
+	defer suite.selectiveLock.Unlock()
+	if suite.reportAfterSuiteReport == nil {
+		return types.Report{}, types.GinkgoErrors.ExpectUniqueOutsideReportAfterSuite(cl)
+	}
+	return *suite.reportAfterSuiteReport, nil
+}
+
+// FailSuite marks the suite as failed and records reason in the suite's SpecialSuiteFailureReasons.  It can be called from any node while the suite is running, including AfterSuite and ReportAfterSuite.
+func (suite *Suite) FailSuite(reason string, cl types.CodeLocation) error {
+	if suite.phase != PhaseRun {
+		return types.GinkgoErrors.FailSuiteNotDuringRunPhase(cl)
+	}
+	suite.selectiveLock.Lock()
+	suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, reason)
+	suite.report.SuiteSucceeded = false
+	suite.selectiveLock.Unlock()
+	return nil
+}
+
+// OutputIsCaptured returns true if stdout and stderr are currently being intercepted and attached to the running spec.  It returns false if no node is running.
+func (suite *Suite) OutputIsCaptured() bool {
+	suite.selectiveLock.Lock()
+	running := suite.phase == PhaseRun && !suite.currentNode.IsZero()
+	suite.selectiveLock.Unlock()
+	return running && !suite.forwardingUncapturedOutput && suite.outputInterceptor.IsIntercepting()
+}
+
+func (suite *Suite) RecordBenchmarkStats(stats types.BenchmarkStats) {
+	suite.selectiveLock.Lock()
+	suite.currentSpecReport.BenchmarkStats = &stats
+	suite.selectiveLock.Unlock()
+}
+
 func (suite *Suite) generateProgressReport(fullReport bool) types.ProgressReport {
 	timelineLocation := suite.generateTimelineLocation()
 	suite.selectiveLock.Lock()
@@ -398,6 +640,9 @@ func (suite *Suite) emitProgressReport(report types.ProgressReport) {
 	suite.selectiveLock.Unlock()
 
 	suite.reporter.EmitProgressReport(report)
+	for _, sink := range suite.config.ProgressReportSinks {
+		sink.Emit(report)
+	}
 	if suite.isRunningInParallel() {
 		err := suite.client.PostEmitProgressReport(report)
 		if err != nil {
@@ -406,6 +651,45 @@ func (suite *Suite) emitProgressReport(report types.ProgressReport) {
 	}
 }
 
+/*
+emitCompletionProgressReport sends a final progress report for the spec that just completed to the configured ProgressReportSinks.
+
+Completion progress reports are not sent to the reporter (and so never appear in the console) nor recorded in the spec's report.  The report's current node is the spec's It node and it includes every running goroutine.
+*/
+func (suite *Suite) emitCompletionProgressReport(spec Spec) {
+	if len(suite.config.ProgressReportSinks) == 0 {
+		return
+	}
+	timelineLocation := suite.generateTimelineLocation()
+	suite.selectiveLock.Lock()
+	specReport := suite.currentSpecReport
+	suite.selectiveLock.Unlock()
+
+	report, err := NewProgressReport(suite.isRunningInParallel(), specReport, spec.FirstNodeWithType(types.NodeTypeIt), specReport.StartTime, types.SpecEvent{}, specReport.CapturedGinkgoWriterOutput, timelineLocation, nil, suite.config.SourceRoots, true)
+	if err != nil {
+		fmt.Printf("{{red}}Failed to generate progress report:{{/}}\n%s\n", err.Error())
+	}
+	report.Message = fmt.Sprintf("{{bold}}Spec %s after %s:{{/}}", specReport.State, specReport.RunTime.Round(time.Millisecond))
+	for _, sink := range suite.config.ProgressReportSinks {
+		sink.Emit(report)
+	}
+}
+
+// recordAdditionalFailures appends the failures a node recorded after calling FailSoft to the current spec's AdditionalFailures.  nodeFailure provides the details of the node that recorded them.
+func (suite *Suite) recordAdditionalFailures(nodeFailure types.Failure, timelineLocation types.TimelineLocation, additionalFailures []types.AdditionalFailure) {
+	for _, additionalFailure := range additionalFailures {
+		failure := types.Failure{
+			FailureNodeContext:        nodeFailure.FailureNodeContext,
+			FailureNodeType:           nodeFailure.FailureNodeType,
+			FailureNodeLocation:       nodeFailure.FailureNodeLocation,
+			FailureNodeContainerIndex: nodeFailure.FailureNodeContainerIndex,
+		}
+		failure.Message, failure.Location, failure.ForwardedPanic, failure.PanicValue, failure.TimelineLocation = additionalFailure.Failure.Message, additionalFailure.Failure.Location, additionalFailure.Failure.ForwardedPanic, additionalFailure.Failure.PanicValue, timelineLocation
+		suite.reporter.EmitFailure(additionalFailure.State, failure)
+		suite.currentSpecReport.AdditionalFailures = append(suite.currentSpecReport.AdditionalFailures, types.AdditionalFailure{State: additionalFailure.State, Failure: failure})
+	}
+}
+
 func (suite *Suite) isRunningInParallel() bool {
 	return suite.config.ParallelTotal > 1
 }
@@ -417,7 +701,9 @@ func (suite *Suite) processCurrentSpecReport() {
 	}
 	suite.report.SpecReports = append(suite.report.SpecReports, suite.currentSpecReport)
 
-	if suite.currentSpecReport.State.Is(types.SpecStateFailureStates) {
+	// informational specs never fail the suite - unless they abort it, in which case the remaining specs are skipped and the suite can't be said to have succeeded
+	countsTowardsSuccess := !suite.currentSpecReport.IsInformational || suite.currentSpecReport.State.Is(types.SpecStateAborted)
+	if suite.currentSpecReport.State.Is(types.SpecStateFailureStates) && countsTowardsSuccess {
 		suite.report.SuiteSucceeded = false
 		if suite.config.FailFast || suite.currentSpecReport.State.Is(types.SpecStateAborted) {
 			suite.skipAll = true
@@ -430,19 +716,28 @@ func (suite *Suite) processCurrentSpecReport() {
 
 func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath string, hasProgrammaticFocus bool, specs Specs) bool {
 	numSpecsThatWillBeRun := specs.CountWithoutSkip()
+	numContainers, numSetupNodes := specs.CountContainersAndSetupNodes()
+	_, maxNestingDepth := DeepestContainer(suite.tree)
 
 	suite.report = types.Report{
 		SuitePath:                 suitePath,
 		SuiteDescription:          description,
 		SuiteLabels:               suiteLabels,
 		SuiteConfig:               suite.config,
+		ReporterConfig:            suite.reporterConfig,
 		SuiteHasProgrammaticFocus: hasProgrammaticFocus,
 		PreRunStats: types.PreRunStats{
 			TotalSpecs:       len(specs),
 			SpecsThatWillRun: numSpecsThatWillBeRun,
+			TotalContainers:  numContainers,
+			TotalSetupNodes:  numSetupNodes,
 		},
-		StartTime: time.Now(),
+		TotalSpecsToRun: len(specs),
+		StartTime:       suite.clock.Now(),
+		SpecTree:        GenerateSpecTree(suite.tree, specs),
+		MaxNestingDepth: maxNestingDepth,
 	}
+	suite.numSpecsStarted = 0
 
 	suite.reporter.SuiteWillBegin(suite.report)
 	if suite.isRunningInParallel() {
@@ -451,6 +746,45 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 
 	suite.report.SuiteSucceeded = true
 
+	if suite.config.FailOnEmptySuite && len(specs) == 0 {
+		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, "No specs defined and --fail-on-empty-suite is set")
+		suite.report.SuiteSucceeded = false
+	}
+
+	if suite.config.MinSpecsToRun > 0 && numSpecsThatWillBeRun < suite.config.MinSpecsToRun {
+		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("Only %d specs will run but --min-specs-to-run requires at least %d", numSpecsThatWillBeRun, suite.config.MinSpecsToRun))
+		suite.report.SuiteSucceeded = false
+	}
+
+	if suite.config.PerSpecCoverage && suite.report.SuiteSucceeded {
+		recorder, err := newPerSpecCoverageRecorder()
+		if err != nil {
+			suite.failPerSpecCoverage(err)
+		} else {
+			suite.perSpecCoverage = recorder
+			defer func() {
+				recorder.cleanup()
+				suite.perSpecCoverage = nil
+			}()
+		}
+	}
+
+	if suite.config.PerSpecLogDir != "" && suite.report.SuiteSucceeded {
+		parallelProcess := 0
+		if suite.isRunningInParallel() {
+			parallelProcess = suite.config.ParallelProcess
+		}
+		writer, err := newPerSpecLogWriter(suite.config.PerSpecLogDir, parallelProcess)
+		if err != nil {
+			suite.failPerSpecLog(err)
+		} else {
+			suite.perSpecLog = writer
+			defer func() {
+				suite.perSpecLog = nil
+			}()
+		}
+	}
+
 	suite.runReportSuiteNodesIfNeedBe(types.NodeTypeReportBeforeSuite)
 
 	ranBeforeSuite := suite.report.SuiteSucceeded
@@ -460,6 +794,14 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 
 	if suite.report.SuiteSucceeded {
 		groupedSpecIndices, serialGroupedSpecIndices := OrderSpecs(specs, suite.config)
+		groupedSpecIndices, serialGroupedSpecIndices, err := ApplyFinalOrderHook(specs, groupedSpecIndices, serialGroupedSpecIndices, suite.config)
+		if err != nil {
+			suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("FinalOrderHook returned an invalid order:\n%s", err.Error()))
+			suite.report.SuiteSucceeded = false
+			groupedSpecIndices, serialGroupedSpecIndices = GroupedSpecIndices{}, GroupedSpecIndices{}
+		} else {
+			suite.reportSpecOrder(specs, groupedSpecIndices, serialGroupedSpecIndices)
+		}
 		nextIndex := MakeIncrementingIndexCounter()
 		if suite.isRunningInParallel() {
 			nextIndex = suite.client.FetchNextCounter
@@ -504,22 +846,80 @@ func (suite *Suite) runSpecs(description string, suiteLabels Labels, suitePath s
 		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, interruptStatus.Cause.String())
 		suite.report.SuiteSucceeded = false
 	}
-	suite.report.EndTime = time.Now()
+	suite.report.EndTime = suite.clock.Now()
 	suite.report.RunTime = suite.report.EndTime.Sub(suite.report.StartTime)
 	if !suite.deadline.IsZero() && suite.report.EndTime.After(suite.deadline) {
 		suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, "Suite Timeout Elapsed")
 		suite.report.SuiteSucceeded = false
 	}
 
+	if suite.config.SuiteSuccessPredicate != nil {
+		suite.applySuiteSuccessPredicate()
+	}
+
+	suite.report.FlakyReports = suite.report.SpecReports.FlakyReports()
+	if suite.isRunningInParallel() {
+		efficiency := types.NewParallelEfficiency(suite.report)
+		suite.report.ParallelEfficiency = &efficiency
+	}
+	if suite.captureEnvironment {
+		environment := CaptureSuiteEnvironment(suite.environmentAllowlist)
+		suite.report.Environment = &environment
+	}
+
 	suite.runReportSuiteNodesIfNeedBe(types.NodeTypeReportAfterSuite)
 	suite.reporter.SuiteDidEnd(suite.report)
 	if suite.isRunningInParallel() {
 		suite.client.PostSuiteDidEnd(suite.report)
 	}
 
+	// proc 1 evaluates the SuiteSuccessPredicate over the aggregated report and so determines the outcome of the suite - the other procs defer to it unless they failed for a special reason
+	if suite.config.SuiteSuccessPredicate != nil && suite.isRunningInParallel() && suite.config.ParallelProcess != 1 && len(suite.report.SpecialSuiteFailureReasons) == 0 {
+		return true
+	}
+
 	return suite.report.SuiteSucceeded
 }
 
+// applySuiteSuccessPredicate lets the configured SuiteSuccessPredicate determine whether the suite succeeded.  When running in parallel the predicate is evaluated once, on proc 1, over the report aggregated across all procs.
+func (suite *Suite) applySuiteSuccessPredicate() {
+	if suite.isRunningInParallel() && suite.config.ParallelProcess != 1 {
+		return
+	}
+	report := suite.report
+	if suite.isRunningInParallel() {
+		aggregatedReport, err := suite.client.BlockUntilAggregatedNonprimaryProcsReport()
+		if err != nil {
+			suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("Failed to aggregate reports for the SuiteSuccessPredicate:\n%s", err.Error()))
+			suite.report.SuiteSucceeded = false
+			return
+		}
+		report = report.Add(aggregatedReport)
+	}
+	if len(report.SpecialSuiteFailureReasons) > 0 {
+		return
+	}
+	succeeded := suite.config.SuiteSuccessPredicate(report)
+	suite.report.SuiteOutcomeDeterminedByPredicate = succeeded != report.SuiteSucceeded
+	suite.report.SuiteSucceeded = succeeded
+}
+
+// failPerSpecCoverage fails the suite and stops recording per-spec coverage
+func (suite *Suite) failPerSpecCoverage(err error) {
+	suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("Failed to record per-spec coverage:\n%s", err.Error()))
+	suite.report.SuiteSucceeded = false
+	if suite.perSpecCoverage != nil {
+		suite.perSpecCoverage.cleanup()
+		suite.perSpecCoverage = nil
+	}
+}
+
+func (suite *Suite) failPerSpecLog(err error) {
+	suite.report.SpecialSuiteFailureReasons = append(suite.report.SpecialSuiteFailureReasons, fmt.Sprintf("Failed to write per-spec logs:\n%s", err.Error()))
+	suite.report.SuiteSucceeded = false
+	suite.perSpecLog = nil
+}
+
 func (suite *Suite) runBeforeSuite(numSpecsThatWillBeRun int) {
 	beforeSuiteNode := suite.suiteNodes.FirstNodeWithType(types.NodeTypeBeforeSuite | types.NodeTypeSynchronizedBeforeSuite)
 	if !beforeSuiteNode.IsZero() && numSpecsThatWillBeRun > 0 {
@@ -559,6 +959,24 @@ func (suite *Suite) runAfterSuiteCleanup(numSpecsThatWillBeRun int) {
 		suite.processCurrentSpecReport()
 	}
 
+	// AfterAllProcesses nodes only run on process #1
+	if numSpecsThatWillBeRun > 0 && suite.config.ParallelProcess == 1 {
+		for _, afterAllProcessesNode := range suite.suiteNodes.WithType(types.NodeTypeAfterAllProcesses) {
+			suite.selectiveLock.Lock()
+			suite.currentSpecReport = types.SpecReport{
+				LeafNodeType:      afterAllProcessesNode.NodeType,
+				LeafNodeLocation:  afterAllProcessesNode.CodeLocation,
+				ParallelProcess:   suite.config.ParallelProcess,
+				RunningInParallel: suite.isRunningInParallel(),
+			}
+			suite.selectiveLock.Unlock()
+
+			suite.reporter.WillRun(suite.currentSpecReport)
+			suite.runSuiteNode(afterAllProcessesNode)
+			suite.processCurrentSpecReport()
+		}
+	}
+
 	afterSuiteCleanup := suite.cleanupNodes.WithType(types.NodeTypeCleanupAfterSuite).Reverse()
 	if len(afterSuiteCleanup) > 0 {
 		for _, cleanupNode := range afterSuiteCleanup {
@@ -592,7 +1010,7 @@ func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 
 	for i := range nodes {
 		suite.writer.Truncate()
-		suite.outputInterceptor.StartInterceptingOutput()
+		suite.startInterceptingOutputForSpec(spec)
 		report := suite.currentSpecReport
 		nodes[i].Body = func(SpecContext) {
 			nodes[i].ReportEachBody(report)
@@ -605,8 +1023,91 @@ func (suite *Suite) reportEach(spec Spec, nodeType types.NodeType) {
 			suite.currentSpecReport.State = state
 			suite.currentSpecReport.Failure = failure
 		}
-		suite.currentSpecReport.CapturedGinkgoWriterOutput += string(suite.writer.Bytes())
-		suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
+		suite.captureGinkgoWriterOutput()
+		suite.captureStdOutErr(suite.stopInterceptingOutputForSpec(spec))
+	}
+}
+
+/*
+startInterceptingOutputForSpec starts intercepting output on behalf of spec.
+
+Specs marked NoCapture don't have their output captured.  When running in parallel their output is forwarded straight to the Ginkgo CLI; otherwise it is not intercepted at all.
+*/
+func (suite *Suite) startInterceptingOutputForSpec(spec Spec) {
+	if !spec.Nodes.HasNodeMarkedNoCapture() {
+		suite.outputInterceptor.StartInterceptingOutput()
+	} else if suite.isRunningInParallel() {
+		suite.forwardingUncapturedOutput = true
+		suite.outputInterceptor.StartInterceptingOutputAndForwardTo(suite.client)
+	}
+}
+
+// stopInterceptingOutputForSpec stops intercepting output on behalf of spec and returns the captured output.  This is always empty for specs marked NoCapture.
+func (suite *Suite) stopInterceptingOutputForSpec(spec Spec) string {
+	output := suite.outputInterceptor.StopInterceptingAndReturnOutput()
+	suite.forwardingUncapturedOutput = false
+	if spec.Nodes.HasNodeMarkedNoCapture() {
+		return ""
+	}
+	return output
+}
+
+// SetReporterConfig records the reporter configuration in effect for the run so that it is included in the suite's report
+func (suite *Suite) SetReporterConfig(reporterConfig types.ReporterConfig) {
+	suite.reporterConfig = reporterConfig
+}
+
+// SetRecordOutputSegments controls whether the suite records when each chunk of captured output was written so that reporters can interleave stdout/stderr and GinkgoWriter output chronologically
+func (suite *Suite) SetRecordOutputSegments(record bool) {
+	suite.recordOutputSegments = record
+}
+
+// SetCaptureEnvironment controls whether the suite records a SuiteEnvironment, including the environment variables named in allowlist, in its report when the suite ends
+func (suite *Suite) SetCaptureEnvironment(capture bool, allowlist []string) {
+	suite.captureEnvironment = capture
+	suite.environmentAllowlist = allowlist
+}
+
+// captureGinkgoWriterOutput appends the GinkgoWriter's buffered output to the current spec report
+func (suite *Suite) captureGinkgoWriterOutput() {
+	output := string(suite.writer.Bytes())
+	suite.appendOutputSegments(types.CapturedOutputSourceGinkgoWriter, len(suite.currentSpecReport.CapturedGinkgoWriterOutput), len(output), suite.writer.TimestampedWrites())
+	suite.currentSpecReport.CapturedGinkgoWriterOutput += output
+}
+
+// captureStdOutErr appends output, which must have just been returned by the output interceptor, to the current spec report
+func (suite *Suite) captureStdOutErr(output string) {
+	suite.appendOutputSegments(types.CapturedOutputSourceStdOutErr, len(suite.currentSpecReport.CapturedStdOutErr), len(output), suite.outputInterceptor.TimestampedWrites())
+	suite.currentSpecReport.CapturedStdOutErr += output
+	// output is empty when the spec is marked NoCapture, in which case there is no separated output to capture either
+	if output != "" {
+		stdout, stderr := suite.outputInterceptor.SeparatedOutput()
+		suite.currentSpecReport.CapturedStdOut += stdout
+		suite.currentSpecReport.CapturedStdErr += stderr
+	}
+}
+
+func (suite *Suite) appendOutputSegments(source types.CapturedOutputSource, base int, length int, writes []TimestampedWrite) {
+	if !suite.recordOutputSegments {
+		return
+	}
+	for i, write := range writes {
+		start, end := write.Offset, length
+		if i < len(writes)-1 {
+			end = writes[i+1].Offset
+		}
+		if end > length {
+			end = length
+		}
+		if start >= end {
+			continue
+		}
+		suite.currentSpecReport.CapturedOutputSegments = append(suite.currentSpecReport.CapturedOutputSegments, types.CapturedOutputSegment{
+			Source: source,
+			Start:  base + start,
+			End:    base + end,
+			Time:   write.Time,
+		})
 	}
 }
 
@@ -618,13 +1119,13 @@ func (suite *Suite) runSuiteNode(node Node) {
 
 	suite.writer.Truncate()
 	suite.outputInterceptor.StartInterceptingOutput()
-	suite.currentSpecReport.StartTime = time.Now()
+	suite.currentSpecReport.StartTime = suite.clock.Now()
 
 	var err error
 	switch node.NodeType {
 	case types.NodeTypeBeforeSuite, types.NodeTypeAfterSuite:
 		suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, "")
-	case types.NodeTypeCleanupAfterSuite:
+	case types.NodeTypeCleanupAfterSuite, types.NodeTypeAfterAllProcesses:
 		if suite.config.ParallelTotal > 1 && suite.config.ParallelProcess == 1 {
 			err = suite.client.BlockUntilNonprimaryProcsHaveFinished()
 		}
@@ -643,7 +1144,7 @@ func (suite *Suite) runSuiteNode(node Node) {
 			node.HasContext = node.SynchronizedBeforeSuiteProc1BodyHasContext
 			suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, "")
 			if suite.config.ParallelTotal > 1 {
-				suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
+				suite.captureStdOutErr(suite.outputInterceptor.StopInterceptingAndReturnOutput())
 				suite.outputInterceptor.StartInterceptingOutput()
 				if suite.currentSpecReport.State.Is(types.SpecStatePassed) {
 					err = suite.client.PostSynchronizedBeforeSuiteCompleted(types.SpecStatePassed, data)
@@ -679,7 +1180,7 @@ func (suite *Suite) runSuiteNode(node Node) {
 			}
 			if err == nil {
 				if suite.config.ParallelTotal > 1 {
-					suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
+					suite.captureStdOutErr(suite.outputInterceptor.StopInterceptingAndReturnOutput())
 					suite.outputInterceptor.StartInterceptingOutputAndForwardTo(suite.client)
 				}
 
@@ -698,14 +1199,17 @@ func (suite *Suite) runSuiteNode(node Node) {
 		suite.reporter.EmitFailure(suite.currentSpecReport.State, suite.currentSpecReport.Failure)
 	}
 
-	suite.currentSpecReport.EndTime = time.Now()
+	suite.currentSpecReport.EndTime = suite.clock.Now()
 	suite.currentSpecReport.RunTime = suite.currentSpecReport.EndTime.Sub(suite.currentSpecReport.StartTime)
-	suite.currentSpecReport.CapturedGinkgoWriterOutput = string(suite.writer.Bytes())
-	suite.currentSpecReport.CapturedStdOutErr += suite.outputInterceptor.StopInterceptingAndReturnOutput()
+	suite.captureGinkgoWriterOutput()
+	suite.captureStdOutErr(suite.outputInterceptor.StopInterceptingAndReturnOutput())
 }
 
 func (suite *Suite) runReportSuiteNodesIfNeedBe(nodeType types.NodeType) {
 	nodes := suite.suiteNodes.WithType(nodeType)
+	if nodeType.Is(types.NodeTypeReportAfterSuite) {
+		nodes = nodes.SortedByReportPriority()
+	}
 	// only run ReportAfterSuite on proc 1
 	if nodeType.Is(types.NodeTypeReportAfterSuite) && suite.config.ParallelProcess != 1 {
 		return
@@ -748,7 +1252,7 @@ func (suite *Suite) runReportSuiteNodesIfNeedBe(nodeType types.NodeType) {
 func (suite *Suite) runReportSuiteNode(node Node, report types.Report) {
 	suite.writer.Truncate()
 	suite.outputInterceptor.StartInterceptingOutput()
-	suite.currentSpecReport.StartTime = time.Now()
+	suite.currentSpecReport.StartTime = suite.clock.Now()
 
 	// if we're running a ReportAfterSuite in parallel (on proc 1) we (a) wait until other procs have exited and
 	// (b) always fetch the latest report as prior ReportAfterSuites will contribute to it
@@ -759,16 +1263,55 @@ func (suite *Suite) runReportSuiteNode(node Node, report types.Report) {
 			suite.reporter.EmitFailure(suite.currentSpecReport.State, suite.currentSpecReport.Failure)
 			return
 		}
+		succeeded := report.SuiteSucceeded
 		report = report.Add(aggregatedReport)
+		if report.SuiteOutcomeDeterminedByPredicate {
+			// the SuiteSuccessPredicate has already been evaluated over the aggregated report
+			report.SuiteSucceeded = succeeded
+		}
 	}
 
 	node.Body = func(SpecContext) { node.ReportSuiteBody(report) }
+	if node.NodeType.Is(types.NodeTypeReportAfterSuite) {
+		suite.selectiveLock.Lock()
+		suite.reportAfterSuiteReport = &report
+		suite.selectiveLock.Unlock()
+	}
 	suite.currentSpecReport.State, suite.currentSpecReport.Failure = suite.runNode(node, time.Time{}, "")
+	suite.selectiveLock.Lock()
+	suite.reportAfterSuiteReport = nil
+	suite.selectiveLock.Unlock()
 
-	suite.currentSpecReport.EndTime = time.Now()
+	suite.currentSpecReport.EndTime = suite.clock.Now()
 	suite.currentSpecReport.RunTime = suite.currentSpecReport.EndTime.Sub(suite.currentSpecReport.StartTime)
-	suite.currentSpecReport.CapturedGinkgoWriterOutput = string(suite.writer.Bytes())
-	suite.currentSpecReport.CapturedStdOutErr = suite.outputInterceptor.StopInterceptingAndReturnOutput()
+	suite.captureGinkgoWriterOutput()
+	suite.captureStdOutErr(suite.outputInterceptor.StopInterceptingAndReturnOutput())
+}
+
+// timeoutMultiplier returns the factor by which the timeouts of the current spec are scaled: the largest of the configured LabelTimeoutMultipliers that apply to the spec's (or the suite's) labels, or 1 if none apply.  Nodes that run outside of a spec are never scaled.
+func (suite *Suite) timeoutMultiplier() float64 {
+	if len(suite.config.LabelTimeoutMultipliers) == 0 || !suite.currentSpecReport.LeafNodeType.Is(types.NodeTypeIt) {
+		return 1
+	}
+	multiplier, matched := 0.0, false
+	for _, label := range UnionOfLabels(suite.report.SuiteLabels, suite.currentSpecReport.Labels()) {
+		for configuredLabel, m := range suite.config.LabelTimeoutMultipliers {
+			if strings.EqualFold(label, configuredLabel) && (!matched || m > multiplier) {
+				multiplier, matched = m, true
+			}
+		}
+	}
+	if !matched {
+		return 1
+	}
+	return multiplier
+}
+
+func scaleTimeout(timeout time.Duration, multiplier float64) time.Duration {
+	if timeout <= 0 || multiplier == 1 {
+		return timeout
+	}
+	return time.Duration(float64(timeout) * multiplier)
 }
 
 func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (types.SpecState, types.Failure) {
@@ -789,7 +1332,7 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 
 	suite.selectiveLock.Lock()
 	suite.currentNode = node
-	suite.currentNodeStartTime = time.Now()
+	suite.currentNodeStartTime = suite.clock.Now()
 	suite.currentByStep = types.SpecEvent{}
 	suite.selectiveLock.Unlock()
 	defer func() {
@@ -828,21 +1371,22 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 		gracePeriod = node.GracePeriod
 	}
 
-	now := time.Now()
+	now := suite.clock.Now()
 	deadline := suite.deadline
 	timeoutInPlay := "suite"
 	if deadline.IsZero() || (!specDeadline.IsZero() && specDeadline.Before(deadline)) {
 		deadline = specDeadline
 		timeoutInPlay = "spec"
 	}
-	if node.NodeTimeout > 0 && (deadline.IsZero() || deadline.Sub(now) > node.NodeTimeout) {
-		deadline = now.Add(node.NodeTimeout)
+	nodeTimeout := scaleTimeout(node.NodeTimeout, suite.timeoutMultiplier())
+	if nodeTimeout > 0 && (deadline.IsZero() || deadline.Sub(now) > nodeTimeout) {
+		deadline = now.Add(nodeTimeout)
 		timeoutInPlay = "node"
 	}
 	if (!deadline.IsZero() && deadline.Before(now)) || interruptStatus.Interrupted() {
 		//we're out of time already.  let's wait for a NodeTimeout if we have it, or GracePeriod if we don't
-		if node.NodeTimeout > 0 {
-			deadline = now.Add(node.NodeTimeout)
+		if nodeTimeout > 0 {
+			deadline = now.Add(nodeTimeout)
 			timeoutInPlay = "node"
 		} else {
 			deadline = now.Add(gracePeriod)
@@ -850,6 +1394,24 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 		}
 	}
 
+	// timeoutDetails attributes a timeout or interrupt that occurs now to the time budget in play
+	timeoutDetails := func() *types.TimeoutDetails {
+		details := &types.TimeoutDetails{Elapsed: suite.clock.Now().Sub(now), NodeType: node.NodeType, NodeText: text}
+		if deadline.IsZero() {
+			return details
+		}
+		details.Timeout, details.Budget = timeoutInPlay, deadline.Sub(now)
+		switch timeoutInPlay {
+		case "suite":
+			details.Budget = suite.config.Timeout
+			details.Elapsed = suite.clock.Now().Sub(suite.deadline.Add(-suite.config.Timeout))
+		case "spec":
+			details.Budget = suite.currentSpecTimeout
+			details.Elapsed = suite.clock.Now().Sub(specDeadline.Add(-suite.currentSpecTimeout))
+		}
+		return details
+	}
+
 	if !node.HasContext {
 		// this maps onto the pre-context behavior:
 		// - an interrupted node exits immediately.  with this, context-less nodes that are in a spec with a SpecTimeout and/or are interrupted by other means will simply exit immediately after the timeout/interrupt
@@ -868,10 +1430,16 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 	if !deadline.IsZero() {
 		deadlineChannel = time.After(deadline.Sub(now))
 	}
+	// a WarnAt decorator schedules an early warning once the node has used up the requested fraction of its time budget
+	var warnChannel <-chan time.Time
+	if node.WarnAt > 0 && !deadline.IsZero() && timeoutInPlay != "grace period" {
+		warnChannel = time.After(time.Duration(node.WarnAt * float64(deadline.Sub(now))))
+	}
 	var gracePeriodChannel <-chan time.Time
 
 	outcomeC := make(chan types.SpecState)
 	failureC := make(chan types.Failure)
+	additionalFailuresC := make(chan []types.AdditionalFailure)
 
 	go func() {
 		finished := false
@@ -880,10 +1448,11 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 				suite.failer.Panic(types.NewCodeLocationWithStackTrace(2), e)
 			}
 
-			outcomeFromRun, failureFromRun := suite.failer.Drain()
+			outcomeFromRun, failureFromRun, additionalFailuresFromRun := suite.failer.DrainWithAdditionalFailures()
 			failureFromRun.TimelineLocation = suite.generateTimelineLocation()
 			outcomeC <- outcomeFromRun
 			failureC <- failureFromRun
+			additionalFailuresC <- additionalFailuresFromRun
 		}()
 
 		node.Body(sc)
@@ -911,6 +1480,7 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 		select {
 		case outcomeFromRun := <-outcomeC:
 			failureFromRun := <-failureC
+			additionalFailuresFromRun := <-additionalFailuresC
 			if outcome.Is(types.SpecStateInterrupted | types.SpecStateTimedout) {
 				// we've already been interrupted/timed out.  we just managed to actually exit
 				// before the grace period elapsed
@@ -921,8 +1491,8 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 						Failure: failure, //we make a copy - this will include all the configuration set up above...
 					}
 					//...and then we update the failure with the details from failureFromRun
-					additionalFailure.Failure.Location, additionalFailure.Failure.ForwardedPanic, additionalFailure.Failure.TimelineLocation = failureFromRun.Location, failureFromRun.ForwardedPanic, failureFromRun.TimelineLocation
-					additionalFailure.Failure.ProgressReport = types.ProgressReport{}
+					additionalFailure.Failure.Location, additionalFailure.Failure.ForwardedPanic, additionalFailure.Failure.PanicValue, additionalFailure.Failure.TimelineLocation = failureFromRun.Location, failureFromRun.ForwardedPanic, failureFromRun.PanicValue, failureFromRun.TimelineLocation
+					additionalFailure.Failure.ProgressReport, additionalFailure.Failure.TimeoutDetails = types.ProgressReport{}, nil
 					if outcome == types.SpecStateTimedout {
 						additionalFailure.Failure.Message = fmt.Sprintf("A %s timeout occurred and then the following failure was recorded in the timedout node before it exited:\n%s", timeoutInPlay, failureFromRun.Message)
 					} else {
@@ -931,13 +1501,16 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 					suite.reporter.EmitFailure(additionalFailure.State, additionalFailure.Failure)
 					failure.AdditionalFailure = &additionalFailure
 				}
+				suite.recordAdditionalFailures(failure, failureFromRun.TimelineLocation, additionalFailuresFromRun)
 				return outcome, failure
 			}
 			if outcomeFromRun.Is(types.SpecStatePassed) {
 				return outcomeFromRun, types.Failure{}
 			} else {
-				failure.Message, failure.Location, failure.ForwardedPanic, failure.TimelineLocation = failureFromRun.Message, failureFromRun.Location, failureFromRun.ForwardedPanic, failureFromRun.TimelineLocation
+				failure.Message, failure.Location, failure.ForwardedPanic, failure.PanicValue, failure.TimelineLocation = failureFromRun.Message, failureFromRun.Location, failureFromRun.ForwardedPanic, failureFromRun.PanicValue, failureFromRun.TimelineLocation
 				suite.reporter.EmitFailure(outcomeFromRun, failure)
+				// failures recorded after a FailSoft are reported after the node's own failure
+				suite.recordAdditionalFailures(failure, failureFromRun.TimelineLocation, additionalFailuresFromRun)
 				return outcomeFromRun, failure
 			}
 		case <-gracePeriodChannel:
@@ -951,9 +1524,10 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 			// we're out of time - the outcome is a timeout and we capture the failure and progress report
 			outcome = types.SpecStateTimedout
 			failure.Message, failure.Location, failure.TimelineLocation = fmt.Sprintf("A %s timeout occurred", timeoutInPlay), node.CodeLocation, suite.generateTimelineLocation()
+			failure.TimeoutDetails = timeoutDetails()
 			failure.ProgressReport = suite.generateProgressReport(false).WithoutCapturedGinkgoWriterOutput()
 			failure.ProgressReport.Message = fmt.Sprintf("{{bold}}This is the Progress Report generated when the %s timeout occurred:{{/}}", timeoutInPlay)
-			deadlineChannel = nil
+			deadlineChannel, warnChannel = nil, nil
 			suite.reporter.EmitFailure(outcome, failure)
 
 			// tell the spec to stop.  it's important we generate the progress report first to make sure we capture where
@@ -969,7 +1543,7 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 				continue
 			}
 
-			deadlineChannel = nil // don't worry about deadlines, time's up now
+			deadlineChannel, warnChannel = nil, nil // don't worry about deadlines, time's up now
 
 			failureTimelineLocation := suite.generateTimelineLocation()
 			progressReport := suite.generateProgressReport(true)
@@ -977,6 +1551,7 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 			if outcome == types.SpecStateInvalid {
 				outcome = types.SpecStateInterrupted
 				failure.Message, failure.Location, failure.TimelineLocation = interruptStatus.Message(), node.CodeLocation, failureTimelineLocation
+				failure.TimeoutDetails = timeoutDetails()
 				if interruptStatus.ShouldIncludeProgressReport() {
 					failure.ProgressReport = progressReport.WithoutCapturedGinkgoWriterOutput()
 					failure.ProgressReport.Message = "{{bold}}This is the Progress Report generated when the interrupt was received:{{/}}"
@@ -1010,6 +1585,11 @@ func (suite *Suite) runNode(node Node, specDeadline time.Time, text string) (typ
 				// we've already given grace.  time's up.  now.
 				return outcome, failure
 			}
+		case <-warnChannel:
+			warnChannel = nil
+			report := suite.generateProgressReport(false)
+			report.Message = fmt.Sprintf("{{bold}}{{orange}}[TIMEOUT WARNING]{{/}} {{bold}}This node has used %.0f%% of its time budget and will hit the %s timeout in %s:{{/}}", node.WarnAt*100, timeoutInPlay, deadline.Sub(suite.clock.Now()).Round(time.Millisecond))
+			suite.emitProgressReport(report)
 		case <-emitProgressNow:
 			report := suite.generateProgressReport(false)
 			report.Message = "{{bold}}Automatically polling progress:{{/}}"
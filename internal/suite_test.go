@@ -1,7 +1,9 @@
 package internal_test
 
 import (
+	"errors"
 	"io"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/internal"
@@ -42,6 +44,105 @@ var _ = Describe("Suite", func() {
 		suite = internal.NewSuite()
 	})
 
+	Describe("Recording output segments", func() {
+		BeforeEach(func() {
+			suite.PushNode(N(ntIt, "alternating output", func() {
+				outputInterceptor.AppendInterceptedOutput("stdout 1\n")
+				time.Sleep(time.Millisecond)
+				writer.Print("gw 1\n")
+				time.Sleep(time.Millisecond)
+				outputInterceptor.AppendInterceptedOutput("stdout 2\n")
+				time.Sleep(time.Millisecond)
+				writer.Print("gw 2\n")
+			}))
+			Ω(suite.BuildTree()).Should(Succeed())
+		})
+
+		It("does not record segments by default", func() {
+			suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+			report := reporter.Did.Find("alternating output")
+			Ω(report.CapturedOutputSegments).Should(BeEmpty())
+			Ω(report.InterleavedOutput()).Should(Equal("stdout 1\nstdout 2\n\ngw 1\ngw 2\n"))
+		})
+
+		It("records when each chunk of output was written so that the output can be interleaved chronologically", func() {
+			suite.SetRecordOutputSegments(true)
+			suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+			report := reporter.Did.Find("alternating output")
+			Ω(report.CapturedStdOutErr).Should(Equal("stdout 1\nstdout 2\n"))
+			Ω(report.CapturedGinkgoWriterOutput).Should(Equal("gw 1\ngw 2\n"))
+			Ω(report.CapturedOutputSegments).Should(HaveLen(4))
+			Ω(report.InterleavedOutput()).Should(Equal("stdout 1\ngw 1\nstdout 2\ngw 2\n"))
+		})
+	})
+
+	Describe("Recording the reporter config", func() {
+		BeforeEach(func() {
+			suite.PushNode(N(ntIt, "a spec", func() {}))
+			Ω(suite.BuildTree()).Should(Succeed())
+		})
+
+		It("includes the reporter config in the report", func() {
+			suite.SetReporterConfig(types.ReporterConfig{Succinct: true, JUnitReport: "junit.xml"})
+			suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+			Ω(reporter.Begin.ReporterConfig.Verbosity()).Should(Equal(types.VerbosityLevelSuccinct))
+			Ω(reporter.End.ReporterConfig.JUnitReport).Should(Equal("junit.xml"))
+		})
+	})
+
+	Describe("Registering fault injectors", func() {
+		It("returns an error if the label filter is invalid", func() {
+			err := suite.RegisterFaultInjector("chaos &&", func(internal.SpecContext) {}, nil, cl)
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("chaos &&"))
+		})
+
+		It("succeeds otherwise", func() {
+			Ω(suite.RegisterFaultInjector("chaos || !slow", func(internal.SpecContext) {}, nil, cl)).Should(Succeed())
+		})
+	})
+
+	Describe("Validating Trees", func() {
+		var labeledCl, nestedCl, unlabeledCl, otherUnlabeledCl types.CodeLocation
+		BeforeEach(func() {
+			labeledCl, nestedCl, unlabeledCl, otherUnlabeledCl = types.NewCustomCodeLocation("labeled"), types.NewCustomCodeLocation("nested"), types.NewCustomCodeLocation("unlabeled"), types.NewCustomCodeLocation("other unlabeled")
+			suite.PushNode(N(ntCon, "labeled", labeledCl, Label("books"), func() {
+				suite.PushNode(N(ntCon, "nested and unlabeled", nestedCl, func() {
+					suite.PushNode(N(ntIt, "an it", func() {}))
+				}))
+			}))
+			suite.PushNode(N(ntCon, "unlabeled", unlabeledCl, func() {
+				suite.PushNode(N(ntIt, "an it", func() {}))
+			}))
+			suite.PushNode(N(ntIt, "a top-level it", func() {}))
+			suite.PushNode(N(ntCon, "other unlabeled", otherUnlabeledCl, func() {}))
+			Ω(suite.BuildTree()).Should(Succeed())
+		})
+
+		It("does not require top-level labels by default", func() {
+			Ω(suite.ValidateTree(conf)).Should(Succeed())
+		})
+
+		It("lists every unlabeled top-level container when RequireLabelsOnTopLevel is set", func() {
+			conf.RequireLabelsOnTopLevel = true
+			err := suite.ValidateTree(conf)
+			Ω(err).Should(Equal(types.GinkgoErrors.TopLevelContainersMissingLabels([]string{"unlabeled", "other unlabeled"}, []types.CodeLocation{unlabeledCl, otherUnlabeledCl})))
+			Ω(err.Error()).Should(ContainSubstring(`"unlabeled" at unlabeled`))
+			Ω(err.Error()).ShouldNot(ContainSubstring("nested and unlabeled"))
+		})
+
+		It("does not limit the nesting depth by default", func() {
+			Ω(suite.ValidateTree(conf)).Should(Succeed())
+		})
+
+		It("fails if a container is nested more deeply than MaxNestingDepth", func() {
+			conf.MaxNestingDepth = 2
+			Ω(suite.ValidateTree(conf)).Should(Succeed())
+			conf.MaxNestingDepth = 1
+			Ω(suite.ValidateTree(conf)).Should(MatchError(types.GinkgoErrors.MaxNestingDepthExceeded("nested and unlabeled", nestedCl, 2, 1)))
+		})
+	})
+
 	Describe("Constructing Trees", func() {
 		Describe("PhaseBuildTopLevel vs PhaseBuildTree", func() {
 			var err1, err2, err3 error
@@ -61,7 +162,7 @@ var _ = Describe("Suite", func() {
 				Ω(rt).Should(HaveTracked("traversing outer", "traversing nested"))
 
 				rt.Reset()
-				suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 				Ω(rt).Should(HaveTracked("running it"))
 
 				Ω(err1).ShouldNot(HaveOccurred())
@@ -98,7 +199,7 @@ var _ = Describe("Suite", func() {
 				Ω(suite.BuildTree()).Should(Succeed())
 				Ω(rt).Should(HaveTracked("traversing outer", "traversing nested"))
 				rt.Reset()
-				suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 				Ω(rt).Should(HaveTracked("before-suite", "running it"))
 
 				Ω(err1).ShouldNot(HaveOccurred())
@@ -111,7 +212,7 @@ var _ = Describe("Suite", func() {
 				Ω(clone.BuildTree()).Should(Succeed())
 				Ω(rt).Should(HaveTracked("traversing outer", "traversing nested"))
 				rt.Reset()
-				clone.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				clone.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 				Ω(rt).Should(HaveTracked("before-suite", "running it"))
 
 				Ω(err1).ShouldNot(HaveOccurred())
@@ -119,6 +220,78 @@ var _ = Describe("Suite", func() {
 				Ω(err3).ShouldNot(HaveOccurred())
 			})
 
+			It("carries over the registered spec hooks", func() {
+				suite.RegisterSpecHooks(func(report types.SpecReport) { rt.Run("before " + report.LeafNodeText) }, func(report types.SpecReport) { rt.Run("after " + report.LeafNodeText) })
+				clone, err := suite.Clone()
+				Ω(err).ShouldNot(HaveOccurred())
+				suite = clone
+
+				Ω(clone.BuildTree()).Should(Succeed())
+				rt.Reset()
+				clone.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				Ω(rt).Should(HaveTracked("before-suite", "before an it", "running it", "after an it"))
+			})
+
+			It("carries over the registered spec retry callbacks", func() {
+				attempts := 0
+				suite.PushNode(N(ntCon, "a flaky container", func() {
+					suite.PushNode(N(ntIt, "flaky", FlakeAttempts(2), func() {
+						attempts += 1
+						if attempts == 1 {
+							failer.Fail("fail", cl)
+						}
+					}))
+				}))
+				suite.OnSpecRetry(func(report types.SpecReport, attempt int, failure types.Failure) {
+					rt.Run("retrying " + report.LeafNodeText + " after " + failure.Message)
+				})
+				clone, err := suite.Clone()
+				Ω(err).ShouldNot(HaveOccurred())
+				suite = clone
+
+				Ω(clone.BuildTree()).Should(Succeed())
+				rt.Reset()
+				clone.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				Ω(rt.TrackedRuns()).Should(ContainElement("retrying flaky after fail"))
+				Ω(reporter.Did.Find("flaky")).Should(HavePassed())
+			})
+
+			It("carries over the registered fault injectors", func() {
+				Ω(suite.RegisterFaultInjector("", func(internal.SpecContext) { rt.Run("injecting before") }, func(internal.SpecContext) { rt.Run("injecting after") }, cl)).Should(Succeed())
+				clone, err := suite.Clone()
+				Ω(err).ShouldNot(HaveOccurred())
+				suite = clone
+
+				Ω(clone.BuildTree()).Should(Succeed())
+				rt.Reset()
+				clone.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				Ω(rt).Should(HaveTracked("before-suite", "injecting before", "running it", "injecting after"))
+			})
+
+			It("carries over the registered feature checker", func() {
+				suite.PushNode(N(ntCon, "a container that requires features", func() {
+					suite.PushNode(N(ntIt, "needs feature-x", RequireFeature("feature-x"), rt.T("running feature-x")))
+				}))
+				suite.SetFeatureChecker(func(feature string) bool { return feature == "feature-x" })
+				clone, err := suite.Clone()
+				Ω(err).ShouldNot(HaveOccurred())
+				suite = clone
+
+				Ω(clone.BuildTree()).Should(Succeed())
+				rt.Reset()
+				clone.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				Ω(rt.TrackedRuns()).Should(ContainElement("running feature-x"))
+				Ω(reporter.Did.Find("needs feature-x")).Should(HavePassed())
+			})
+
+			It("carries over the tree construction errors recorded at the top level", func() {
+				suite.RecordTreeConstructionError(errors.New("bad table file"))
+				clone, err := suite.Clone()
+				Ω(err).ShouldNot(HaveOccurred())
+				suite = clone
+
+				Ω(clone.BuildTree()).Should(MatchError("bad table file"))
+			})
 		})
 
 		Describe("InRunPhase", func() {
@@ -134,7 +307,7 @@ var _ = Describe("Suite", func() {
 				}))
 
 				Ω(suite.BuildTree()).Should(Succeed())
-				suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 
 				Ω(err).ShouldNot(HaveOccurred())
 				Ω(truey).Should(BeTrue())
@@ -158,7 +331,7 @@ var _ = Describe("Suite", func() {
 			})
 
 			It("errors", func() {
-				suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+				suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 				Ω(pushNodeErrDuringRun).Should(HaveOccurred())
 				Ω(rt).Should(HaveTracked("in it"))
 			})
@@ -320,6 +493,57 @@ var _ = Describe("Suite", func() {
 				})
 			})
 
+			Context("when pushing specs with dependencies", func() {
+				It("succeeds when the dependencies are in the same container", func() {
+					var errors = make([]error, 3)
+					errors[0] = suite.PushNode(N(ntCon, "container", func() {
+						errors[1] = suite.PushNode(N(ntIt, "B", DependsOn("A"), func() {}))
+						errors[2] = suite.PushNode(N(ntIt, "A", func() {}))
+					}))
+					Ω(errors[0]).ShouldNot(HaveOccurred())
+					Ω(suite.BuildTree()).Should(Succeed())
+					Ω(errors[1]).ShouldNot(HaveOccurred())
+					Ω(errors[2]).ShouldNot(HaveOccurred())
+				})
+
+				It("errors when a dependency does not match a spec in the same container", func() {
+					var err error
+					suite.PushNode(N(ntCon, "container", func() {
+						suite.PushNode(N(ntIt, "A", func() {}))
+						err = suite.PushNode(N(ntCon, "nested", func() {
+							suite.PushNode(N(ntIt, "B", cl, DependsOn("A"), func() {}))
+						}))
+					}))
+					Ω(suite.BuildTree()).Should(Succeed())
+					Ω(err).Should(MatchError(types.GinkgoErrors.UnknownSpecDependency(cl, "A")))
+				})
+
+				It("errors when top-level specs have a dependency that does not match", func() {
+					suite.PushNode(N(ntIt, "B", cl, DependsOn("C"), func() {}))
+					Ω(suite.BuildTree()).Should(MatchError(types.GinkgoErrors.UnknownSpecDependency(cl, "C")))
+				})
+
+				It("errors when the dependencies form a cycle", func() {
+					suite.PushNode(N(ntCon, "container", func() {
+						suite.PushNode(N(ntIt, "A", DependsOn("C"), func() {}))
+						suite.PushNode(N(ntIt, "B", cl, DependsOn("A"), func() {}))
+						suite.PushNode(N(ntIt, "C", DependsOn("B"), func() {}))
+						suite.PushNode(N(ntIt, "D", DependsOn("A"), func() {}))
+					}))
+					Ω(suite.BuildTree()).Should(MatchError(types.GinkgoErrors.CyclicSpecDependencies(cl, []string{"A", "C", "B", "A"})))
+				})
+
+				It("errors when the spec is in an Ordered container", func() {
+					var err error
+					suite.PushNode(N(ntCon, "container", Ordered, func() {
+						suite.PushNode(N(ntIt, "A", func() {}))
+						err = suite.PushNode(N(ntIt, "B", cl, DependsOn("A"), func() {}))
+					}))
+					Ω(suite.BuildTree()).Should(Succeed())
+					Ω(err).Should(MatchError(types.GinkgoErrors.SpecDependencyInOrderedContainer(cl)))
+				})
+			})
+
 			Context("when pushing a suite node during PhaseBuildTree", func() {
 				It("errors", func() {
 					var pushSuiteNodeErr error
@@ -342,7 +566,7 @@ var _ = Describe("Suite", func() {
 
 					Ω(err).ShouldNot(HaveOccurred())
 					Ω(suite.BuildTree()).Should(Succeed())
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(pushSuiteNodeErr).Should(HaveOccurred())
 				})
 			})
@@ -385,7 +609,7 @@ var _ = Describe("Suite", func() {
 					Ω(errors[1]).ShouldNot(HaveOccurred())
 					Ω(errors[2]).ShouldNot(HaveOccurred())
 
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(errors[3]).Should(MatchError(types.GinkgoErrors.PushingCleanupInReportingNode(cl, types.NodeTypeReportBeforeEach)))
 				})
 			})
@@ -407,7 +631,7 @@ var _ = Describe("Suite", func() {
 					Ω(errors[1]).ShouldNot(HaveOccurred())
 					Ω(errors[2]).ShouldNot(HaveOccurred())
 
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(errors[3]).Should(MatchError(types.GinkgoErrors.PushingCleanupInReportingNode(cl, types.NodeTypeReportAfterEach)))
 				})
 			})
@@ -429,7 +653,7 @@ var _ = Describe("Suite", func() {
 					Ω(suite.BuildTree()).Should(Succeed())
 					Ω(errors[2]).ShouldNot(HaveOccurred())
 
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(errors[3]).Should(MatchError(types.GinkgoErrors.PushingCleanupInReportingNode(cl, types.NodeTypeReportBeforeSuite)))
 				})
 			})
@@ -451,7 +675,7 @@ var _ = Describe("Suite", func() {
 					Ω(suite.BuildTree()).Should(Succeed())
 					Ω(errors[2]).ShouldNot(HaveOccurred())
 
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(errors[3]).Should(MatchError(types.GinkgoErrors.PushingCleanupInReportingNode(cl, types.NodeTypeReportAfterSuite)))
 				})
 			})
@@ -468,7 +692,7 @@ var _ = Describe("Suite", func() {
 					}))
 					Ω(errors[0]).ShouldNot(HaveOccurred())
 					Ω(suite.BuildTree()).Should(Succeed())
-					suite.Run("suite", Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
+					suite.Run("suite", Labels{}, Labels{}, "/path/to/suite", failer, reporter, writer, outputInterceptor, interruptHandler, client, internal.RegisterForProgressSignal, conf)
 					Ω(errors[1]).ShouldNot(HaveOccurred())
 					Ω(errors[2]).Should(MatchError(types.GinkgoErrors.PushingCleanupInCleanupNode(cl)))
 				})
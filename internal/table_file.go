@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TableRecord is a single record decoded from a table data file, along with the line on which the record begins
+type TableRecord struct {
+	Value reflect.Value
+	Line  int
+}
+
+/*
+LoadTableRecords reads the JSON or YAML file at path and decodes it into a list of records of type recordType.
+
+The file must contain a top-level array (JSON) or sequence (YAML) of records.  The format is determined by the file's extension: .json, .yaml, or .yml.
+*/
+func LoadTableRecords(path string, recordType reflect.Type) ([]TableRecord, error) {
+	var load func([]byte, reflect.Type) ([]TableRecord, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		load = loadJSONTableRecords
+	case ".yaml", ".yml":
+		load = loadYAMLTableRecords
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q - use .json, .yaml, or .yml", filepath.Ext(path))
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return load(content, recordType)
+}
+
+func loadJSONTableRecords(content []byte, recordType reflect.Type) ([]TableRecord, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("expected a top-level JSON array of records")
+	}
+	records := []TableRecord{}
+	for decoder.More() {
+		line := lineAt(content, decoder.InputOffset())
+		value := reflect.New(recordType)
+		if err := decoder.Decode(value.Interface()); err != nil {
+			return nil, fmt.Errorf("failed to decode record #%d: %w", len(records), err)
+		}
+		records = append(records, TableRecord{Value: value.Elem(), Line: line})
+	}
+	return records, nil
+}
+
+// lineAt returns the line of the first character at or after offset that is not whitespace or a comma
+func lineAt(content []byte, offset int64) int {
+	for int(offset) < len(content) && strings.ContainsRune(" \t\r\n,", rune(content[offset])) {
+		offset++
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
+
+func loadYAMLTableRecords(content []byte, recordType reflect.Type) ([]TableRecord, error) {
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) != 1 || document.Content[0].Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("expected a top-level YAML sequence of records")
+	}
+	records := []TableRecord{}
+	for _, node := range document.Content[0].Content {
+		value := reflect.New(recordType)
+		if err := node.Decode(value.Interface()); err != nil {
+			return nil, fmt.Errorf("failed to decode record #%d: %w", len(records), err)
+		}
+		records = append(records, TableRecord{Value: value.Elem(), Line: node.Line})
+	}
+	return records, nil
+}
@@ -0,0 +1,74 @@
+package internal_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/internal"
+)
+
+var _ = Describe("LoadTableRecords", func() {
+	type record struct {
+		Name string `json:"name" yaml:"name"`
+	}
+	recordType := reflect.TypeOf(record{})
+
+	var dir string
+	write := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		Ω(os.WriteFile(path, []byte(content), 0644)).Should(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("decodes YAML records and tracks the line on which each record begins", func() {
+		records, err := internal.LoadTableRecords(write("cases.yml", "- name: A\n\n- name: B\n"), recordType)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(records).Should(HaveLen(2))
+		Ω(records[0].Value.Interface()).Should(Equal(record{Name: "A"}))
+		Ω(records[0].Line).Should(Equal(1))
+		Ω(records[1].Value.Interface()).Should(Equal(record{Name: "B"}))
+		Ω(records[1].Line).Should(Equal(3))
+	})
+
+	It("decodes JSON records and tracks the line on which each record begins", func() {
+		records, err := internal.LoadTableRecords(write("cases.json", "[\n  {\"name\": \"A\"},\n\n  {\"name\": \"B\"}\n]"), recordType)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(records).Should(HaveLen(2))
+		Ω(records[0].Value.Interface()).Should(Equal(record{Name: "A"}))
+		Ω(records[0].Line).Should(Equal(2))
+		Ω(records[1].Value.Interface()).Should(Equal(record{Name: "B"}))
+		Ω(records[1].Line).Should(Equal(4))
+	})
+
+	It("errors when the file does not exist", func() {
+		path := filepath.Join(dir, "missing.json")
+		_, err := internal.LoadTableRecords(path, recordType)
+		Ω(err).Should(MatchError(ContainSubstring(path)))
+	})
+
+	It("errors when the file has an unsupported extension", func() {
+		_, err := internal.LoadTableRecords(write("cases.txt", "- name: A"), recordType)
+		Ω(err).Should(MatchError(ContainSubstring(`unsupported file extension ".txt" - use .json, .yaml, or .yml`)))
+	})
+
+	It("errors when the file does not contain a list of records", func() {
+		_, err := internal.LoadTableRecords(write("cases.yaml", "name: A"), recordType)
+		Ω(err).Should(MatchError(ContainSubstring("expected a top-level YAML sequence")))
+
+		_, err = internal.LoadTableRecords(write("cases.json", `{"name": "A"}`), recordType)
+		Ω(err).Should(MatchError(ContainSubstring("expected a top-level JSON array")))
+	})
+
+	It("errors when a record cannot be decoded", func() {
+		_, err := internal.LoadTableRecords(write("cases.json", `[{"name": "A"}, {"name": 3}]`), recordType)
+		Ω(err).Should(MatchError(ContainSubstring("failed to decode record #1")))
+	})
+})
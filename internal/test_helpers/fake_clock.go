@@ -0,0 +1,31 @@
+package test_helpers
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a Clock that only moves forward when Advance is called
+type FakeClock struct {
+	now  time.Time
+	lock *sync.Mutex
+}
+
+func NewFakeClock() *FakeClock {
+	return &FakeClock{
+		now:  time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		lock: &sync.Mutex{},
+	}
+}
+
+func (clock *FakeClock) Now() time.Time {
+	clock.lock.Lock()
+	defer clock.lock.Unlock()
+	return clock.now
+}
+
+func (clock *FakeClock) Advance(d time.Duration) {
+	clock.lock.Lock()
+	defer clock.lock.Unlock()
+	clock.now = clock.now.Add(d)
+}
@@ -3,12 +3,19 @@ package test_helpers
 import (
 	"io"
 	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/internal"
 )
 
 type FakeOutputInterceptor struct {
 	intercepting      bool
 	forwardingWriter  io.Writer
 	interceptedOutput string
+	interceptedStdout string
+	interceptedStderr string
+	separate          bool
+	timestampedWrites []internal.TimestampedWrite
 	lock              *sync.Mutex
 }
 
@@ -19,9 +26,28 @@ func NewFakeOutputInterceptor() *FakeOutputInterceptor {
 	}
 }
 
+// AppendInterceptedOutput simulates output written to stdout
 func (interceptor *FakeOutputInterceptor) AppendInterceptedOutput(s string) {
 	interceptor.lock.Lock()
 	defer interceptor.lock.Unlock()
+	interceptor.appendInterceptedOutput(s)
+	if interceptor.separate {
+		interceptor.interceptedStdout += s
+	}
+}
+
+// AppendInterceptedStderr simulates output written to stderr
+func (interceptor *FakeOutputInterceptor) AppendInterceptedStderr(s string) {
+	interceptor.lock.Lock()
+	defer interceptor.lock.Unlock()
+	interceptor.appendInterceptedOutput(s)
+	if interceptor.separate {
+		interceptor.interceptedStderr += s
+	}
+}
+
+func (interceptor *FakeOutputInterceptor) appendInterceptedOutput(s string) {
+	interceptor.timestampedWrites = append(interceptor.timestampedWrites, internal.TimestampedWrite{Offset: len(interceptor.interceptedOutput), Time: time.Now()})
 	interceptor.interceptedOutput += s
 	interceptor.forwardingWriter.Write([]byte(s))
 }
@@ -36,6 +62,9 @@ func (interceptor *FakeOutputInterceptor) StartInterceptingOutputAndForwardTo(w
 	interceptor.forwardingWriter = w
 	interceptor.intercepting = true
 	interceptor.interceptedOutput = ""
+	interceptor.interceptedStdout = ""
+	interceptor.interceptedStderr = ""
+	interceptor.timestampedWrites = nil
 }
 
 func (interceptor *FakeOutputInterceptor) PauseIntercepting() {
@@ -50,6 +79,12 @@ func (interceptor *FakeOutputInterceptor) ResumeIntercepting() {
 	interceptor.intercepting = true
 }
 
+func (interceptor *FakeOutputInterceptor) IsIntercepting() bool {
+	interceptor.lock.Lock()
+	defer interceptor.lock.Unlock()
+	return interceptor.intercepting
+}
+
 func (interceptor *FakeOutputInterceptor) StopInterceptingAndReturnOutput() string {
 	interceptor.lock.Lock()
 	defer interceptor.lock.Unlock()
@@ -57,5 +92,23 @@ func (interceptor *FakeOutputInterceptor) StopInterceptingAndReturnOutput() stri
 	return interceptor.interceptedOutput
 }
 
+func (interceptor *FakeOutputInterceptor) TimestampedWrites() []internal.TimestampedWrite {
+	interceptor.lock.Lock()
+	defer interceptor.lock.Unlock()
+	return interceptor.timestampedWrites
+}
+
+func (interceptor *FakeOutputInterceptor) SetSeparateStdoutStderr(separate bool) {
+	interceptor.lock.Lock()
+	defer interceptor.lock.Unlock()
+	interceptor.separate = separate
+}
+
+func (interceptor *FakeOutputInterceptor) SeparatedOutput() (string, string) {
+	interceptor.lock.Lock()
+	defer interceptor.lock.Unlock()
+	return interceptor.interceptedStdout, interceptor.interceptedStderr
+}
+
 func (interceptor *FakeOutputInterceptor) Shutdown() {
 }
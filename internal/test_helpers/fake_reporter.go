@@ -96,6 +96,8 @@ type FakeReporter struct {
 	Begin           types.Report
 	Will            Reports
 	Did             Reports
+	Attempts        Reports
+	Planned         Reports
 	End             types.Report
 	ProgressReports []types.ProgressReport
 	ReportEntries   []types.ReportEntry
@@ -128,6 +130,18 @@ func (r *FakeReporter) DidRun(report types.SpecReport) {
 	r.Did = append(r.Did, report)
 }
 
+func (r *FakeReporter) DidRunAttempt(report types.SpecReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Attempts = append(r.Attempts, report)
+}
+
+func (r *FakeReporter) SpecsWillRun(specs []types.SpecReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Planned = append(Reports{}, specs...)
+}
+
 func (r *FakeReporter) SuiteDidEnd(report types.Report) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -1,6 +1,10 @@
 package internal
 
-import "github.com/onsi/ginkgo/v2/types"
+import (
+	"sort"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
 
 type TreeNode struct {
 	Node     Node
@@ -73,5 +77,79 @@ func GenerateSpecsFromTreeRoot(tree *TreeNode) Specs {
 		return tests
 	}
 
-	return walkTree(0, Nodes{}, Nodes{}, tree.Children)
+	return assignSpecIDs(walkTree(0, Nodes{}, Nodes{}, tree.Children))
+}
+
+/*
+GenerateSpecTree returns the static structure of the suite rooted at tree - every container and subject node, nested as they were defined.
+
+specs must be the specs generated from the tree after focus has been applied.  They are used to determine which subject nodes were skipped and to look up their spec IDs.
+*/
+func GenerateSpecTree(tree *TreeNode, specs Specs) []types.SpecTreeNode {
+	specsBySubjectID := map[uint]Spec{}
+	for _, spec := range specs {
+		specsBySubjectID[spec.SubjectID()] = spec
+	}
+
+	var walkTree func(trees TreeNodes, pending bool) []types.SpecTreeNode
+	walkTree = func(trees TreeNodes, pending bool) []types.SpecTreeNode {
+		// top-level containers are entered after any top-level subject nodes have been added to the tree - sorting by ID restores the order in which the nodes were defined
+		trees = append(TreeNodes{}, trees...)
+		sort.SliceStable(trees, func(i, j int) bool { return trees[i].Node.ID < trees[j].Node.ID })
+
+		var out []types.SpecTreeNode
+		for _, tn := range trees {
+			node := tn.Node
+			if !node.NodeType.Is(types.NodeTypesForContainerAndIt) {
+				continue
+			}
+			specTreeNode := types.SpecTreeNode{
+				NodeType:     node.NodeType,
+				Text:         node.Text,
+				CodeLocation: node.CodeLocation,
+				Pending:      pending || node.MarkedPending,
+			}
+			if len(node.Labels) > 0 {
+				specTreeNode.Labels = node.Labels
+			}
+			if node.NodeType.Is(types.NodeTypeIt) {
+				spec := specsBySubjectID[node.ID]
+				specTreeNode.SpecID = spec.ID
+				specTreeNode.Skipped = spec.Skip && !specTreeNode.Pending
+			} else {
+				specTreeNode.Children = walkTree(tn.Children, specTreeNode.Pending)
+				specTreeNode.Skipped = !specTreeNode.Pending
+				for _, child := range specTreeNode.Children {
+					if child.WillRun() {
+						specTreeNode.Skipped = false
+						break
+					}
+				}
+			}
+			out = append(out, specTreeNode)
+		}
+		return out
+	}
+
+	return walkTree(tree.Children, false)
+}
+
+// DeepestContainer returns the most deeply nested container in the tree along with its nesting depth - top-level containers have a depth of 1.  It returns nil and 0 if the tree has no containers.
+func DeepestContainer(tree *TreeNode) (*TreeNode, int) {
+	var deepest *TreeNode
+	maxDepth := 0
+	var walkTree func(trees TreeNodes, depth int)
+	walkTree = func(trees TreeNodes, depth int) {
+		for _, tn := range trees {
+			if !tn.Node.NodeType.Is(types.NodeTypeContainer) {
+				continue
+			}
+			if depth > maxDepth {
+				deepest, maxDepth = tn, depth
+			}
+			walkTree(tn.Children, depth+1)
+		}
+	}
+	walkTree(tree.Children, 1)
+	return deepest, maxDepth
 }
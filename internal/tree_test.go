@@ -183,5 +183,31 @@ var _ = Describe("Trees (TreeNode and TreeNodes)", func() {
 				}
 			})
 		})
+
+		Context("when specs share the same texts", func() {
+			generate := func(dir string) Specs {
+				return internal.GenerateSpecsFromTreeRoot(TN(Node{},
+					TN(N(ntCon, "container", CL(dir+"/file.go", 1)),
+						TN(N(ntIt, "spec", CL(dir+"/file.go", 3))),
+						TN(N(ntIt, "spec", CL(dir+"/file.go", 3))),
+						TN(N(ntIt, "spec", CL(dir+"/file.go", 7))),
+					),
+				))
+			}
+
+			It("assigns each spec a unique ID", func() {
+				tests := generate("/path/to")
+				Ω(tests[0].ID).ShouldNot(BeEmpty())
+				Ω(tests[1].ID).Should(Equal(tests[0].ID + "-2"))
+				Ω(tests[2].ID).ShouldNot(HavePrefix(tests[0].ID))
+			})
+
+			It("assigns the same IDs each time, regardless of the directory the files are in", func() {
+				tests := generate("/path/to")
+				for i, test := range generate("/elsewhere") {
+					Ω(test.ID).Should(Equal(tests[i].ID))
+				}
+			})
+		})
 	})
 })
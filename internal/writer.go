@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/funcr"
@@ -23,6 +24,13 @@ type WriterInterface interface {
 	Truncate()
 	Bytes() []byte
 	Len() int
+	TimestampedWrites() []TimestampedWrite
+}
+
+// TimestampedWrite records when the chunk of output beginning at Offset was written
+type TimestampedWrite struct {
+	Offset int
+	Time   time.Time
 }
 
 // Writer implements WriterInterface and GinkgoWriterInterface
@@ -36,6 +44,8 @@ type Writer struct {
 	indentNext   bool
 
 	teeWriters []io.Writer
+
+	timestampedWrites []TimestampedWrite
 }
 
 func NewWriter(outWriter io.Writer) *Writer {
@@ -55,6 +65,13 @@ func (w *Writer) SetMode(mode WriterMode) {
 	w.mode = mode
 }
 
+// SetOutWriter changes the writer that GinkgoWriter streams to when in WriterModeStreamAndBuffer
+func (w *Writer) SetOutWriter(outWriter io.Writer) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.outWriter = outWriter
+}
+
 func (w *Writer) Len() int {
 	w.lock.Lock()
 	defer w.lock.Unlock()
@@ -88,6 +105,9 @@ func (w *Writer) Write(b []byte) (n int, err error) {
 			}
 		}
 	}
+	if len(b) > 0 {
+		w.timestampedWrites = append(w.timestampedWrites, TimestampedWrite{Offset: w.buffer.Len(), Time: time.Now()})
+	}
 	return w.buffer.Write(b)
 }
 
@@ -95,6 +115,16 @@ func (w *Writer) Truncate() {
 	w.lock.Lock()
 	defer w.lock.Unlock()
 	w.buffer.Reset()
+	w.timestampedWrites = nil
+}
+
+// TimestampedWrites returns when each write to the buffer since the last Truncate occurred
+func (w *Writer) TimestampedWrites() []TimestampedWrite {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	copied := make([]TimestampedWrite, len(w.timestampedWrites))
+	copy(copied, w.timestampedWrites)
+	return copied
 }
 
 func (w *Writer) Bytes() []byte {
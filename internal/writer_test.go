@@ -72,6 +72,22 @@ var _ = Describe("Writer", func() {
 				Ω(writer.Bytes()).Should(Equal([]byte("bar")))
 			})
 		})
+
+		Describe("TimestampedWrites()", func() {
+			It("records the offset and time of each write until told to truncate", func() {
+				writer.Write([]byte("foo"))
+				writer.Write([]byte{})
+				writer.Write([]byte("bar"))
+				writes := writer.TimestampedWrites()
+				Ω(writes).Should(HaveLen(2))
+				Ω(writes[0].Offset).Should(Equal(0))
+				Ω(writes[1].Offset).Should(Equal(3))
+				Ω(writes[1].Time).Should(BeTemporally(">=", writes[0].Time))
+
+				writer.Truncate()
+				Ω(writer.TimestampedWrites()).Should(BeEmpty())
+			})
+		})
 	})
 
 	Describe("Teeing to additional writers", func() {
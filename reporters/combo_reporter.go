@@ -0,0 +1,60 @@
+package reporters
+
+import (
+	"io"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+ComboReporter renders Ginkgo's default human-readable output and writes a JSON report when the suite ends.  Both are driven by the same callbacks,
+so the human-readable summary and the JSON report always describe the same types.Report.
+
+When attached to a suite via ReporterSet.Reporters a ComboReporter runs in each parallel process, rendering the specs that run on that process as they complete.
+When running in parallel it only emits the summary and writes the JSON report once, on process #1, from the report aggregated across all processes (see AggregatedReportReporter).
+*/
+type ComboReporter struct {
+	*DefaultReporter
+	jsonPath string
+	jsonErr  error
+}
+
+// NewComboReporter returns a ComboReporter that emits human-readable output to humanOut (configured by conf) and writes a JSON report to jsonPath when the suite ends
+func NewComboReporter(humanOut io.Writer, jsonPath string, conf types.ReporterConfig) *ComboReporter {
+	return &ComboReporter{
+		DefaultReporter: NewDefaultReporter(conf, humanOut),
+		jsonPath:        jsonPath,
+	}
+}
+
+func (r *ComboReporter) SuiteDidEnd(report types.Report) {
+	// when running in parallel report only describes the specs that ran on this process - SuiteDidEndAcrossProcesses summarizes the suite instead
+	if report.SuiteConfig.ParallelTotal > 1 {
+		return
+	}
+	r.suiteDidEnd(report)
+}
+
+// SuiteDidEndAcrossProcesses emits the summary and writes the JSON report for the report aggregated across all parallel processes.  It does nothing when running in series as SuiteDidEnd has already done so.
+func (r *ComboReporter) SuiteDidEndAcrossProcesses(report types.Report) {
+	if report.SuiteConfig.ParallelTotal <= 1 {
+		return
+	}
+	r.suiteDidEnd(report)
+}
+
+func (r *ComboReporter) suiteDidEnd(report types.Report) {
+	r.DefaultReporter.SuiteDidEnd(report)
+	if !r.conf.KeepANSIInCaptured {
+		report = StripANSIFromCapturedOutput(report)
+	}
+	r.jsonErr = GenerateJSONReport(report, r.jsonPath)
+	if r.jsonErr != nil {
+		r.emitBlock(r.f("{{red}}Failed to generate JSON report at %s:\n%s{{/}}", r.jsonPath, r.jsonErr.Error()))
+	}
+}
+
+// JSONReportError returns the error, if any, encountered while writing the JSON report
+func (r *ComboReporter) JSONReportError() error {
+	return r.jsonErr
+}
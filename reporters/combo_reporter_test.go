@@ -0,0 +1,95 @@
+package reporters_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("ComboReporter", func() {
+	var buf *gbytes.Buffer
+	var jsonPath string
+	var reporter *reporters.ComboReporter
+	var report types.Report
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		jsonPath = filepath.Join(GinkgoT().TempDir(), "out", "report.json")
+		reporter = reporters.NewComboReporter(buf, jsonPath, types.ReporterConfig{NoColor: true})
+
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuiteSucceeded:   false,
+			PreRunStats:      types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 4},
+			RunTime:          time.Minute,
+			SpecReports: types.SpecReports{
+				S("A", types.SpecStatePassed),
+				S("B", types.SpecStatePassed),
+				S("C", types.SpecStateFailed, F("boom", types.FailureNodeIsLeafNode, types.NodeTypeIt, cl0)),
+				S("D", types.SpecStateSkipped),
+			},
+		}
+	})
+
+	It("emits human-readable output and a JSON report describing the same report", func() {
+		reporter.SuiteWillBegin(report)
+		for _, spec := range report.SpecReports {
+			reporter.WillRun(spec)
+			reporter.DidRun(spec)
+		}
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.JSONReportError()).ShouldNot(HaveOccurred())
+
+		data, err := os.ReadFile(jsonPath)
+		Ω(err).ShouldNot(HaveOccurred())
+		var decoded []types.Report
+		Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+		Ω(decoded).Should(HaveLen(1))
+		specs := decoded[0].SpecReports
+		Ω(specs.CountWithState(types.SpecStatePassed)).Should(Equal(2))
+		Ω(specs.CountWithState(types.SpecStateFailureStates)).Should(Equal(1))
+		Ω(specs.CountWithState(types.SpecStateSkipped)).Should(Equal(1))
+		Ω(decoded[0].SuiteSucceeded).Should(BeFalse())
+
+		Ω(buf).Should(gbytes.Say("Running Suite: My Suite"))
+		Ω(buf).Should(gbytes.Say(`Ran 3 of 4 Specs in 60.000 seconds`))
+		Ω(buf).Should(gbytes.Say(`FAIL! -- 2 Passed \| 1 Failed \| 0 Pending \| 1 Skipped`))
+	})
+
+	It("reports failures to write the JSON report", func() {
+		Ω(os.WriteFile(filepath.Dir(jsonPath), []byte("not a directory"), 0644)).Should(Succeed())
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.JSONReportError()).Should(HaveOccurred())
+		Ω(buf).Should(gbytes.Say("Failed to generate JSON report at " + jsonPath))
+	})
+
+	Context("when running in parallel", func() {
+		BeforeEach(func() {
+			report.SuiteConfig.ParallelTotal = 2
+		})
+
+		It("only emits the summary and writes the JSON report for the report aggregated across processes", func() {
+			reporter.SuiteDidEnd(report)
+			Ω(jsonPath).ShouldNot(BeAnExistingFile())
+			Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Ran 3 of 4 Specs"))
+
+			reporter.SuiteDidEndAcrossProcesses(report)
+			Ω(jsonPath).Should(BeAnExistingFile())
+			Ω(buf).Should(gbytes.Say(`Ran 3 of 4 Specs in 60.000 seconds`))
+		})
+	})
+
+	It("does nothing when given the aggregated report while running in series, as SuiteDidEnd has already written the report", func() {
+		reporter.SuiteDidEndAcrossProcesses(report)
+		Ω(jsonPath).ShouldNot(BeAnExistingFile())
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+})
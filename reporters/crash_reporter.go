@@ -0,0 +1,91 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// CRASH_FAILURE_REASON is the SpecialSuiteFailureReason recorded in the partial reports written by a CrashReporter
+const CRASH_FAILURE_REASON = "Suite process exited unexpectedly while a spec was running"
+
+// CRASH_FAILURE_MESSAGE is the failure message attached to the in-progress spec in the partial reports written by a CrashReporter
+const CRASH_FAILURE_MESSAGE = "The suite process exited while this spec was running.  This usually means the spec, or code it called, called os.Exit."
+
+/*
+CrashReporter is a best-effort, last-ditch, reporter that guards against the suite process exiting (e.g. because user code called os.Exit) while a spec is running.
+
+Go provides no hook that runs when os.Exit is called so, instead, when running with --partial-reports-on-crash CrashReporter writes a minimal partial report to the configured
+--json-report and --junit-report destinations just before each spec runs.  The partial report contains only the in-progress spec, marked as aborted with CRASH_FAILURE_MESSAGE.
+If the suite runs to completion the autogenerated ReportAfterSuite node overwrites the partial report with the full report.
+
+CrashReporter only applies when running in series - when running in parallel the reports are generated by the first parallel process and the other processes must not write to them.
+
+If a partial report cannot be written CrashReporter emits a warning to its writer (once) and records the error - see Err().
+*/
+type CrashReporter struct {
+	NoopReporter
+	conf   types.ReporterConfig
+	writer io.Writer
+	report types.Report
+	err    error
+}
+
+// NewCrashReporter returns a CrashReporter that writes partial reports to the destinations configured in conf and emits any errors it encounters to writer
+func NewCrashReporter(conf types.ReporterConfig, writer io.Writer) *CrashReporter {
+	return &CrashReporter{conf: conf, writer: writer}
+}
+
+// WillGenerateReport returns true if conf enables PartialReportsOnCrash and configures a report that a CrashReporter can write to
+func (r *CrashReporter) WillGenerateReport() bool {
+	return r.conf.PartialReportsOnCrash && ((r.conf.JSONReport != "" && !r.conf.JSONReportToStdout()) || r.conf.JUnitReport != "")
+}
+
+// Err returns the first error, if any, the CrashReporter encountered while writing a partial report
+func (r *CrashReporter) Err() error {
+	return r.err
+}
+
+func (r *CrashReporter) SuiteWillBegin(report types.Report) {
+	r.report = report
+}
+
+func (r *CrashReporter) WillRun(spec types.SpecReport) {
+	if spec.LeafNodeType.Is(types.NodeTypeReportBeforeSuite|types.NodeTypeReportAfterSuite) || spec.State.Is(types.SpecStatePending|types.SpecStateSkipped) {
+		return
+	}
+	spec.State = types.SpecStateAborted
+	spec.Failure = types.Failure{
+		Message:             CRASH_FAILURE_MESSAGE,
+		Location:            spec.LeafNodeLocation,
+		FailureNodeContext:  types.FailureNodeIsLeafNode,
+		FailureNodeType:     spec.LeafNodeType,
+		FailureNodeLocation: spec.LeafNodeLocation,
+	}
+
+	report := r.report
+	report.SuiteSucceeded = false
+	report.SpecialSuiteFailureReasons = append(append([]string{}, report.SpecialSuiteFailureReasons...), CRASH_FAILURE_REASON)
+	report.SpecReports = types.SpecReports{spec}
+	if !r.conf.KeepANSIInCaptured {
+		report = StripANSIFromCapturedOutput(report)
+	}
+
+	if r.conf.JSONReport != "" && !r.conf.JSONReportToStdout() {
+		r.handleErr(GenerateJSONReport(report, r.conf.JSONReport))
+	}
+	if r.conf.JUnitReport != "" {
+		r.handleErr(GenerateJUnitReportWithConfig(report, r.conf.JUnitReport, JunitReportConfig{CodeLocationFormatter: r.conf.CodeLocationFormatter}))
+	}
+}
+
+func (r *CrashReporter) handleErr(err error) {
+	if err == nil || r.err != nil {
+		return
+	}
+	r.err = err
+	if r.writer != nil {
+		fmt.Fprintf(r.writer, "Ginkgo failed to write a partial report and will not be able to identify the running spec if the suite process crashes:\n%s\n", err.Error())
+	}
+}
@@ -0,0 +1,91 @@
+package reporters_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("CrashReporter", func() {
+	var dir, jsonPath, junitPath string
+	var reporter *reporters.CrashReporter
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		jsonPath = filepath.Join(dir, "report.json")
+		junitPath = filepath.Join(dir, "report.xml")
+		reporter = reporters.NewCrashReporter(types.ReporterConfig{JSONReport: jsonPath, JUnitReport: junitPath, PartialReportsOnCrash: true}, nil)
+		reporter.SuiteWillBegin(types.Report{SuiteDescription: "My Suite", SuiteSucceeded: true})
+	})
+
+	loadJSONReport := func() types.Report {
+		data, err := os.ReadFile(jsonPath)
+		Ω(err).ShouldNot(HaveOccurred())
+		reports := []types.Report{}
+		Ω(json.Unmarshal(data, &reports)).Should(Succeed())
+		return reports[0]
+	}
+
+	It("only generates reports to files, and only when enabled", func() {
+		Ω(reporter.WillGenerateReport()).Should(BeTrue())
+		Ω(reporters.NewCrashReporter(types.ReporterConfig{JSONReport: jsonPath}, nil).WillGenerateReport()).Should(BeFalse())
+		Ω(reporters.NewCrashReporter(types.ReporterConfig{JSONReport: types.STDOUT_REPORT_DESTINATION, PartialReportsOnCrash: true}, nil).WillGenerateReport()).Should(BeFalse())
+		Ω(reporters.NewCrashReporter(types.ReporterConfig{TeamcityReport: "report.tc", PartialReportsOnCrash: true}, nil).WillGenerateReport()).Should(BeFalse())
+	})
+
+	It("writes a partial report marking the spec that is about to run as aborted", func() {
+		reporter.WillRun(S(types.NodeTypeIt, CTS("container"), "exits", cl0))
+
+		report := loadJSONReport()
+		Ω(report.SuiteDescription).Should(Equal("My Suite"))
+		Ω(report.SuiteSucceeded).Should(BeFalse())
+		Ω(report.SpecialSuiteFailureReasons).Should(Equal([]string{reporters.CRASH_FAILURE_REASON}))
+		Ω(report.SpecReports).Should(HaveLen(1))
+		Ω(report.SpecReports[0].FullText()).Should(Equal("container exits"))
+		Ω(report.SpecReports[0].State).Should(Equal(types.SpecStateAborted))
+		Ω(report.SpecReports[0].Failure.Message).Should(Equal(reporters.CRASH_FAILURE_MESSAGE))
+		Ω(report.SpecReports[0].Failure.Location).Should(Equal(cl0))
+
+		Ω(junitPath).Should(BeAnExistingFile())
+	})
+
+	It("replaces the partial report as each spec runs", func() {
+		reporter.WillRun(S(types.NodeTypeIt, "A", cl0))
+		reporter.WillRun(S(types.NodeTypeIt, "B", cl1))
+
+		report := loadJSONReport()
+		Ω(report.SpecReports).Should(HaveLen(1))
+		Ω(report.SpecReports[0].LeafNodeText).Should(Equal("B"))
+	})
+
+	It("does not write partial reports for pending or skipped specs or for ReportBeforeSuite and ReportAfterSuite nodes", func() {
+		reporter.WillRun(S(types.NodeTypeIt, "pending", cl0, types.SpecStatePending))
+		reporter.WillRun(S(types.NodeTypeIt, "skipped", cl0, types.SpecStateSkipped))
+		reporter.WillRun(S(types.NodeTypeReportBeforeSuite, cl0))
+		reporter.WillRun(S(types.NodeTypeReportAfterSuite, cl0))
+
+		Ω(jsonPath).ShouldNot(BeAnExistingFile())
+		Ω(junitPath).ShouldNot(BeAnExistingFile())
+	})
+
+	It("emits a warning, once, and records the error when a partial report cannot be written", func() {
+		buf := gbytes.NewBuffer()
+		blocker := filepath.Join(dir, "not-a-directory")
+		Ω(os.WriteFile(blocker, []byte{}, 0644)).Should(Succeed())
+		reporter = reporters.NewCrashReporter(types.ReporterConfig{JSONReport: filepath.Join(blocker, "report.json"), PartialReportsOnCrash: true}, buf)
+		reporter.WillRun(S(types.NodeTypeIt, "A", cl0))
+		reporter.WillRun(S(types.NodeTypeIt, "B", cl1))
+
+		Ω(reporter.Err()).Should(HaveOccurred())
+		Ω(string(buf.Contents())).Should(HavePrefix("Ginkgo failed to write a partial report"))
+		Ω(strings.Count(string(buf.Contents()), "Ginkgo failed to write a partial report")).Should(Equal(1))
+	})
+})
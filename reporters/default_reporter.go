@@ -8,9 +8,12 @@ These are documented [here](http://onsi.github.io/ginkgo/#running_tests)
 package reporters
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +22,9 @@ import (
 	"github.com/onsi/ginkgo/v2/types"
 )
 
+// highNestingDepth is the container nesting depth above which the DefaultReporter warns that a suite is nested deeply.  Suites that set SuiteConfig.MaxNestingDepth enforce their own limit and are not warned.
+const highNestingDepth = 6
+
 type DefaultReporter struct {
 	conf   types.ReporterConfig
 	writer io.Writer
@@ -28,12 +34,19 @@ type DefaultReporter struct {
 	lastEmissionWasDelimiter bool
 
 	// rendering
-	specDenoter  string
-	retryDenoter string
-	formatter    formatter.Formatter
+	specDenoter      string
+	retryDenoter     string
+	specStartDenoter string
+	formatter        formatter.Formatter
 
 	runningInParallel bool
 	lock              *sync.Mutex
+
+	// set when parallel output is emitted as specs complete so that each block identifies the process that produced it
+	tagParallelProcess bool
+
+	// the name of the GitLab CI section that is currently open, if any
+	gitLabSection string
 }
 
 func NewDefaultReporterUnderTest(conf types.ReporterConfig, writer io.Writer) *DefaultReporter {
@@ -51,14 +64,16 @@ func NewDefaultReporter(conf types.ReporterConfig, writer io.Writer) *DefaultRep
 		lastCharWasNewline:       true,
 		lastEmissionWasDelimiter: false,
 
-		specDenoter:  "•",
-		retryDenoter: "↺",
-		formatter:    formatter.NewWithNoColorBool(conf.NoColor),
-		lock:         &sync.Mutex{},
+		specDenoter:      "•",
+		retryDenoter:     "↺",
+		specStartDenoter: "→",
+		formatter:        formatter.NewWithColorBools(conf.NoColor, conf.ForceColor),
+		lock:             &sync.Mutex{},
 	}
 	if runtime.GOOS == "windows" {
 		reporter.specDenoter = "+"
 		reporter.retryDenoter = "R"
+		reporter.specStartDenoter = "->"
 	}
 
 	return reporter
@@ -67,6 +82,10 @@ func NewDefaultReporter(conf types.ReporterConfig, writer io.Writer) *DefaultRep
 /* The Reporter Interface */
 
 func (r *DefaultReporter) SuiteWillBegin(report types.Report) {
+	r.tagParallelProcess = report.SuiteConfig.ParallelTotal > 1 && report.SuiteConfig.EmitsParallelOutputAsCompleted()
+	if r.conf.FailuresOnly || r.conf.SuppressSuiteHeader {
+		return
+	}
 	if r.conf.Verbosity().Is(types.VerbosityLevelSuccinct) {
 		r.emit(r.f("[%d] {{bold}}%s{{/}} ", report.SuiteConfig.RandomSeed, report.SuiteDescription))
 		if len(report.SuiteLabels) > 0 {
@@ -92,18 +111,34 @@ func (r *DefaultReporter) SuiteWillBegin(report types.Report) {
 		out := r.f("Random Seed: {{bold}}%d{{/}}", report.SuiteConfig.RandomSeed)
 		if report.SuiteConfig.RandomizeAllSpecs {
 			out += r.f(" - will randomize all specs")
+		} else if report.SuiteConfig.RandomizeWithinContainers {
+			out += r.f(" - will randomize specs within containers")
 		}
 		r.emitBlock(out)
 		r.emit("\n")
-		r.emitBlock(r.f("Will run {{bold}}%d{{/}} of {{bold}}%d{{/}} specs", report.PreRunStats.SpecsThatWillRun, report.PreRunStats.TotalSpecs))
+		if report.PreRunStats.TotalSpecs == 0 {
+			r.emitBlock(r.f("{{orange}}{{bold}}No specs defined{{/}}"))
+		} else {
+			r.emitBlock(r.f("Will run {{bold}}%d{{/}} of {{bold}}%d{{/}} specs", report.PreRunStats.SpecsThatWillRun, report.PreRunStats.TotalSpecs))
+		}
+		if r.conf.Verbosity().GTE(types.VerbosityLevelVerbose) && (report.PreRunStats.TotalContainers > 0 || report.PreRunStats.TotalSetupNodes > 0) {
+			r.emitBlock(r.f("{{gray}}Specs are organized in {{bold}}%d{{/}}{{gray}} containers and use {{bold}}%d{{/}}{{gray}} setup nodes{{/}}", report.PreRunStats.TotalContainers, report.PreRunStats.TotalSetupNodes))
+		}
 		if report.SuiteConfig.ParallelTotal > 1 {
 			r.emitBlock(r.f("Running in parallel across {{bold}}%d{{/}} processes", report.SuiteConfig.ParallelTotal))
 		}
+		if report.SuiteConfig.MaxNestingDepth == 0 && report.MaxNestingDepth > highNestingDepth {
+			r.emitBlock(r.f("{{orange}}Containers in this suite are nested {{bold}}%d{{/}}{{orange}} deep - consider flattening them or setting --max-nesting-depth{{/}}", report.MaxNestingDepth))
+		}
 	}
 }
 
 func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
-	failures := report.SpecReports.WithState(types.SpecStateFailureStates)
+	if r.conf.SpecCountSummary {
+		r.emitSpecCountSummary(report.SpecReports.CountSummary())
+	}
+
+	failures := report.SpecReports.WithoutInformational().WithState(types.SpecStateFailureStates)
 	if len(failures) > 0 {
 		r.emitBlock("\n")
 		if len(failures) > 1 {
@@ -112,24 +147,35 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 			r.emitBlock(r.f("{{red}}{{bold}}Summarizing 1 Failure:{{/}}"))
 		}
 		for _, specReport := range failures {
-			highlightColor, heading := "{{red}}", "[FAIL]"
-			switch specReport.State {
-			case types.SpecStatePanicked:
-				highlightColor, heading = "{{magenta}}", "[PANICKED!]"
-			case types.SpecStateAborted:
-				highlightColor, heading = "{{coral}}", "[ABORTED]"
-			case types.SpecStateTimedout:
-				highlightColor, heading = "{{orange}}", "[TIMEDOUT]"
-			case types.SpecStateInterrupted:
-				highlightColor, heading = "{{orange}}", "[INTERRUPTED]"
-			}
-			locationBlock := r.codeLocationBlock(specReport, highlightColor, false, true)
-			r.emitBlock(r.fi(1, highlightColor+"%s{{/}} %s", heading, locationBlock))
+			r.emitSummaryLine(specReport)
+		}
+	}
+
+	informational := report.SpecReports.Informational().WithState(types.SpecStatePassed | types.SpecStateFailureStates)
+	if len(informational) > 0 {
+		r.emitBlock("\n")
+		r.emitBlock(r.f("{{cyan}}{{bold}}Informational Specs (these never fail the suite):{{/}}"))
+		for _, specReport := range informational {
+			r.emitSummaryLine(specReport)
 		}
 	}
 
+	if r.conf.ReportFlakes && len(report.FlakyReports) > 0 {
+		r.emitFlakyReports(report.FlakyReports)
+	}
+
+	if r.conf.ListPendingSpecs {
+		if pending := report.SpecReports.WithLeafNodeType(types.NodeTypeIt).WithState(types.SpecStatePending); len(pending) > 0 {
+			r.emitPendingSpecs(pending)
+		}
+	}
+
+	if r.conf.PrintReproducibleFlags {
+		r.emitReproducibleFlags(report.SuiteConfig)
+	}
+
 	//summarize the suite
-	if r.conf.Verbosity().Is(types.VerbosityLevelSuccinct) && report.SuiteSucceeded {
+	if r.conf.Verbosity().Is(types.VerbosityLevelSuccinct) && report.SuiteSucceeded && !report.SuiteOutcomeDeterminedByPredicate {
 		r.emit(r.f(" {{green}}SUCCESS!{{/}} %s ", report.RunTime))
 		return
 	}
@@ -159,8 +205,12 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 	if len(specs) == 0 && report.SpecReports.WithLeafNodeType(types.NodeTypeBeforeSuite|types.NodeTypeSynchronizedBeforeSuite).CountWithState(types.SpecStateFailureStates) > 0 {
 		r.emit(r.f("{{cyan}}{{bold}}A BeforeSuite node failed so all tests were skipped.{{/}}\n"))
 	} else {
-		r.emit(r.f("{{green}}{{bold}}%d Passed{{/}} | ", specs.CountWithState(types.SpecStatePassed)))
-		r.emit(r.f("{{red}}{{bold}}%d Failed{{/}} | ", specs.CountWithState(types.SpecStateFailureStates)))
+		nonInformational := specs.WithoutInformational()
+		r.emit(r.f("{{green}}{{bold}}%d Passed{{/}} | ", nonInformational.CountWithState(types.SpecStatePassed)))
+		r.emit(r.f("{{red}}{{bold}}%d Failed{{/}} | ", nonInformational.CountWithState(types.SpecStateFailureStates)))
+		if numInformational := specs.Informational().CountWithState(types.SpecStatePassed | types.SpecStateFailureStates); numInformational > 0 {
+			r.emit(r.f("{{cyan}}{{bold}}%d Informational{{/}} | ", numInformational))
+		}
 		if specs.CountOfFlakedSpecs() > 0 {
 			r.emit(r.f("{{light-yellow}}{{bold}}%d Flaked{{/}} | ", specs.CountOfFlakedSpecs()))
 		}
@@ -170,20 +220,235 @@ func (r *DefaultReporter) SuiteDidEnd(report types.Report) {
 		r.emit(r.f("{{yellow}}{{bold}}%d Pending{{/}} | ", specs.CountWithState(types.SpecStatePending)))
 		r.emit(r.f("{{cyan}}{{bold}}%d Skipped{{/}}\n", specs.CountWithState(types.SpecStateSkipped)))
 	}
+
+	if report.SuiteOutcomeDeterminedByPredicate {
+		r.emit(r.f("{{yellow}}The suite's outcome was determined by the configured SuiteSuccessPredicate{{/}}\n"))
+	}
+
+	if report.ParallelEfficiency != nil && r.conf.Verbosity().GTE(types.VerbosityLevelVerbose) {
+		r.emitParallelEfficiency(*report.ParallelEfficiency)
+	}
+
+	if r.conf.EmitEnvironmentOnFailure && !report.SuiteSucceeded && report.Environment != nil {
+		r.emitEnvironment(*report.Environment, report.SuiteConfig)
+	}
+}
+
+// emitSummaryLine emits the one-line summary of specReport used in the end-of-suite failure and informational summaries
+func (r *DefaultReporter) emitSummaryLine(specReport types.SpecReport) {
+	highlightColor, heading := "{{red}}", "[FAIL]"
+	switch specReport.State {
+	case types.SpecStatePassed:
+		highlightColor, heading = "{{green}}", "[PASSED]"
+	case types.SpecStatePanicked:
+		highlightColor, heading = "{{magenta}}", "[PANICKED!]"
+	case types.SpecStateAborted:
+		highlightColor, heading = "{{coral}}", "[ABORTED]"
+	case types.SpecStateTimedout:
+		highlightColor, heading = "{{orange}}", "[TIMEDOUT]"
+	case types.SpecStateInterrupted:
+		highlightColor, heading = "{{orange}}", "[INTERRUPTED]"
+	}
+	locationBlock := r.codeLocationBlock(specReport, highlightColor, false, specReport.State.Is(types.SpecStateFailureStates))
+	r.emitBlock(r.fi(1, highlightColor+"%s{{/}} %s", heading, locationBlock))
+}
+
+// emitReproducibleFlags emits a ginkgo invocation, quoted for a POSIX shell, that reproduces the run
+func (r *DefaultReporter) emitReproducibleFlags(suiteConfig types.SuiteConfig) {
+	flags, err := types.GenerateReproducibleFlags(suiteConfig, r.conf)
+	if err != nil {
+		r.emitBlock(r.f("\n{{red}}Failed to generate reproducible flags: %s{{/}}", err.Error()))
+		return
+	}
+	for i := range flags {
+		flags[i] = shellQuote(flags[i])
+	}
+	r.emitBlock("\n")
+	r.emitBlock(r.f("{{bold}}To reproduce this run:{{/}}"))
+	r.emitBlock(r.fi(1, "ginkgo %s", strings.Join(flags, " ")))
+}
+
+var shellSafeCharacters = regexp.MustCompile(`^[a-zA-Z0-9_./:=,+@%-]+$`)
+
+func shellQuote(s string) string {
+	if shellSafeCharacters.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (r *DefaultReporter) emitEnvironment(environment types.SuiteEnvironment, suiteConfig types.SuiteConfig) {
+	availableMemory := "unknown"
+	if environment.AvailableMemory > 0 {
+		availableMemory = fmt.Sprintf("%.1f GiB", float64(environment.AvailableMemory)/(1<<30))
+	}
+
+	r.emitBlock("\n")
+	r.emitBlock(r.f("{{bold}}Suite Environment{{/}}"))
+	r.emitBlock(r.fi(1, "Go: %s %s/%s", environment.GoVersion, environment.GOOS, environment.GOARCH))
+	r.emitBlock(r.fi(1, "GOMAXPROCS: %d | CPUs: %d | Available Memory: %s", environment.GOMAXPROCS, environment.NumCPU, availableMemory))
+	r.emitBlock(r.fi(1, "Parallel Processes: %d", suiteConfig.ParallelTotal))
+	seed := fmt.Sprintf("Random Seed: %d", suiteConfig.RandomSeed)
+	if suiteConfig.RandomizeAllSpecs {
+		seed += " - randomizing all specs"
+	}
+	r.emitBlock(r.fi(1, "%s", seed))
+	for _, setting := range []struct {
+		name  string
+		value string
+	}{
+		{"Label Filter", suiteConfig.LabelFilter},
+		{"Focus", strings.Join(suiteConfig.FocusStrings, ", ")},
+		{"Skip", strings.Join(suiteConfig.SkipStrings, ", ")},
+		{"Focus Files", strings.Join(suiteConfig.FocusFiles, ", ")},
+		{"Skip Files", strings.Join(suiteConfig.SkipFiles, ", ")},
+	} {
+		if setting.value != "" {
+			r.emitBlock(r.fi(1, "%s: %s", setting.name, setting.value))
+		}
+	}
+	if suiteConfig.FlakeAttempts > 0 {
+		r.emitBlock(r.fi(1, "Flake Attempts: %d", suiteConfig.FlakeAttempts))
+	}
+	if suiteConfig.Timeout > 0 {
+		r.emitBlock(r.fi(1, "Timeout: %s", suiteConfig.Timeout))
+	}
+	if len(environment.EnvironmentVariables) > 0 {
+		names := []string{}
+		for name := range environment.EnvironmentVariables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		r.emitBlock(r.fi(1, "Environment Variables:"))
+		for _, name := range names {
+			r.emitBlock(r.fi(2, "%s=%s", name, environment.EnvironmentVariables[name]))
+		}
+	}
+}
+
+func (r *DefaultReporter) emitParallelEfficiency(efficiency types.ParallelEfficiency) {
+	idleTimes := []string{}
+	for process := 1; process <= efficiency.NumProcesses; process++ {
+		idleTimes = append(idleTimes, fmt.Sprintf("#%d %s", process, efficiency.IdleTime(process).Round(time.Millisecond)))
+	}
+	r.emit(r.f("{{gray}}Parallel Efficiency: %.1f%% - %s of spec time across %d processes in %s (idle: %s){{/}}\n",
+		efficiency.Efficiency()*100,
+		efficiency.TotalSpecTime.Round(time.Millisecond),
+		efficiency.NumProcesses,
+		efficiency.WallTime.Round(time.Millisecond),
+		strings.Join(idleTimes, ", "),
+	))
+}
+
+func (r *DefaultReporter) emitFlakyReports(flakyReports []types.FlakyReport) {
+	r.emitBlock("\n")
+	if len(flakyReports) > 1 {
+		r.emitBlock(r.f("{{light-yellow}}{{bold}}Summarizing %d Flaky Specs:{{/}}", len(flakyReports)))
+	} else {
+		r.emitBlock(r.f("{{light-yellow}}{{bold}}Summarizing 1 Flaky Spec:{{/}}"))
+	}
+	for _, flakyReport := range flakyReports {
+		r.emitBlock(r.fi(1, "{{light-yellow}}[FLAKEY - TOOK %d ATTEMPTS TO PASS]{{/}} %s {{gray}}%s{{/}}", flakyReport.NumAttempts, flakyReport.FullText(), r.cl(flakyReport.LeafNodeLocation)))
+		for _, attemptFailure := range flakyReport.AttemptFailures {
+			r.emitBlock(r.fi(2, "{{red}}Attempt %d [%s]{{/}} {{gray}}%s{{/}}", attemptFailure.Attempt, strings.ToUpper(attemptFailure.State.String()), r.cl(attemptFailure.Failure.Location)))
+			r.emitBlock(r.fi(3, "%s", attemptFailure.Failure.Message))
+		}
+	}
+}
+
+func (r *DefaultReporter) emitPendingSpecs(pending types.SpecReports) {
+	r.emitBlock("\n")
+	if len(pending) > 1 {
+		r.emitBlock(r.f("{{yellow}}{{bold}}Summarizing %d Pending Specs:{{/}}", len(pending)))
+	} else {
+		r.emitBlock(r.f("{{yellow}}{{bold}}Summarizing 1 Pending Spec:{{/}}"))
+	}
+	for _, specReport := range pending {
+		r.emitBlock(r.fi(1, "{{yellow}}[PENDING]{{/}} %s {{gray}}%s{{/}}", specReport.FullText(), r.cl(specReport.LeafNodeLocation)))
+	}
+}
+
+func (r *DefaultReporter) emitSpecCountSummary(summary types.SpecCountSummary) {
+	if r.conf.SpecCountSummaryJSON {
+		encoded, err := json.Marshal(summary)
+		if err != nil {
+			r.emitBlock(r.f("{{red}}Failed to encode spec count summary: %s{{/}}", err.Error()))
+			return
+		}
+		r.emitBlock(string(encoded))
+		return
+	}
+
+	width := len("(no container)")
+	for _, counts := range append(summary.ByContainer, summary.ByLabel...) {
+		if len(counts.Name) > width {
+			width = len(counts.Name)
+		}
+	}
+	row := func(name string, counts types.SpecCounts) {
+		r.emitBlock(r.fi(1, "%-*s %7d %8d %7d %7d %7d", width, name, counts.Total, counts.WillRun, counts.Pending, counts.Skipped, counts.Focused))
+	}
+	header := func(title string) {
+		r.emitBlock(r.fi(1, "{{bold}}%-*s   Total Will Run Pending Skipped Focused{{/}}", width, title))
+	}
+
+	r.emitBlock("\n")
+	r.emitBlock(r.f("{{bold}}Spec Count Summary{{/}}"))
+	header("")
+	row("Total", summary.Total)
+	if len(summary.ByContainer) > 0 {
+		r.emitBlock("\n")
+		header("By Container")
+		for _, counts := range summary.ByContainer {
+			name := counts.Name
+			if name == "" {
+				name = "(no container)"
+			}
+			row(name, counts)
+		}
+	}
+	if len(summary.ByLabel) > 0 {
+		r.emitBlock("\n")
+		header("By Label")
+		for _, counts := range summary.ByLabel {
+			row(counts.Name, counts)
+		}
+	}
 }
 
 func (r *DefaultReporter) WillRun(report types.SpecReport) {
 	v := r.conf.Verbosity()
-	if v.LT(types.VerbosityLevelVerbose) || report.State.Is(types.SpecStatePending|types.SpecStateSkipped) || report.RunningInParallel {
+	if report.State.Is(types.SpecStatePending|types.SpecStateSkipped) || report.RunningInParallel {
+		return
+	}
+	if v.LT(types.VerbosityLevelVerbose) {
+		if r.conf.EmitSpecStart {
+			// emit a minimal marker so that a hung spec can be identified from the log
+			r.emitBlock(r.f("{{gray}}%s %s{{/}}", r.specStartDenoter, r.truncate(report.FullText())))
+		}
 		return
 	}
 
+	r.openGitLabSection(report, false)
 	r.emitDelimiter(0)
 	r.emitBlock(r.f(r.codeLocationBlock(report, "{{/}}", v.Is(types.VerbosityLevelVeryVerbose), false)))
 }
 
-func (r *DefaultReporter) DidRun(report types.SpecReport) {
+// specVerbosity returns the verbosity to render report with - specs decorated with VerboseOutput are rendered at least as verbosely as -v
+func (r *DefaultReporter) specVerbosity(report types.SpecReport) types.VerbosityLevel {
 	v := r.conf.Verbosity()
+	if report.IsVerbose && v.LT(types.VerbosityLevelVerbose) {
+		return types.VerbosityLevelVerbose
+	}
+	return v
+}
+
+func (r *DefaultReporter) DidRun(report types.SpecReport) {
+	defer r.closeGitLabSection(report)
+	if r.conf.FailuresOnly && !report.Failed() {
+		return
+	}
+	v := r.specVerbosity(report)
 	inParallel := report.RunningInParallel
 
 	header := r.specDenoter
@@ -193,13 +458,34 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 	highlightColor := r.highlightColorForState(report.State)
 
 	// have we already been streaming the timeline?
-	timelineHasBeenStreaming := v.GTE(types.VerbosityLevelVerbose) && !inParallel
+	// specs decorated with VerboseOutput are only rendered verbosely once they complete, so their timeline has not been streaming unless the reporter itself is verbose
+	timelineHasBeenStreaming := r.conf.Verbosity().GTE(types.VerbosityLevelVerbose) && !inParallel
+
+	// did the spec fail in a setup node that produced no output?
+	// this must be checked before the captured output is moved to a log file or into the interleaved output section
+	showSilentSetupFailureHint := r.conf.HintOnSilentSetupFailure && report.Failed() && isSilentSetupFailure(report)
+
+	// was the captured output written to a per-spec log file?
+	// if so, we point to the file instead of inlining the output and the timeline only carries events
+	showLogFilePath := report.LogFilePath != "" && !timelineHasBeenStreaming && (v.GTE(types.VerbosityLevelVerbose) || report.Failed() || (inParallel && report.CapturedStdOutErr != ""))
+	if showLogFilePath {
+		report.CapturedGinkgoWriterOutput, report.CapturedStdOutErr = "", ""
+	}
+
+	// should we merge captured stdout/stderr and GinkgoWriter output into a single chronological section?
+	// if so, the timeline only carries events
+	interleavedOutput := ""
+	if r.conf.InterleaveOutput && !showLogFilePath && !timelineHasBeenStreaming && (v.GTE(types.VerbosityLevelVerbose) || report.Failed() || (inParallel && report.CapturedStdOutErr != "")) {
+		interleavedOutput = report.InterleavedOutput()
+		report.CapturedGinkgoWriterOutput, report.CapturedStdOutErr = "", ""
+	}
+	showInterleavedOutputSection := interleavedOutput != ""
 
 	// should we show the timeline?
 	var timeline types.Timeline
 	showTimeline := !timelineHasBeenStreaming && (v.GTE(types.VerbosityLevelVerbose) || report.Failed())
 	if showTimeline {
-		timeline = report.Timeline().WithoutHiddenReportEntries()
+		timeline = report.Timeline().WithoutHiddenReportEntries().WithoutReportEntriesBelowSeverity(r.conf.MinReportEntrySeverity)
 		keepVeryVerboseSpecEvents := v.Is(types.VerbosityLevelVeryVerbose) ||
 			(v.Is(types.VerbosityLevelVerbose) && r.conf.ShowNodeEvents) ||
 			(report.Failed() && r.conf.ShowNodeEvents)
@@ -220,13 +506,19 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 	}
 
 	// should we have a separate section for always-visible reports?
-	showSeparateVisibilityAlwaysReportsSection := !timelineHasBeenStreaming && !showTimeline && report.ReportEntries.HasVisibility(types.ReportEntryVisibilityAlways)
+	showSeparateVisibilityAlwaysReportsSection := !timelineHasBeenStreaming && !showTimeline && report.ReportEntries.WithMinSeverity(r.conf.MinReportEntrySeverity).HasVisibility(types.ReportEntryVisibilityAlways)
 
 	// should we have a separate section for captured stdout/stderr
 	showSeparateStdSection := inParallel && (report.CapturedStdOutErr != "")
 
+	// should we show benchmark stats?
+	showBenchmarkStats := report.BenchmarkStats != nil && v.GT(types.VerbosityLevelSuccinct)
+
+	// should we show the stack captured for a slow spec?
+	showSlowSpecStack := report.SlowSpecStack != nil && v.GTE(types.VerbosityLevelVerbose)
+
 	// given all that - do we have any actual content to show? or are we a single denoter in a stream?
-	reportHasContent := v.Is(types.VerbosityLevelVeryVerbose) || showTimeline || showSeparateVisibilityAlwaysReportsSection || showSeparateStdSection || report.Failed() || (v.Is(types.VerbosityLevelVerbose) && !report.State.Is(types.SpecStateSkipped))
+	reportHasContent := v.Is(types.VerbosityLevelVeryVerbose) || showTimeline || showSeparateVisibilityAlwaysReportsSection || showSeparateStdSection || showInterleavedOutputSection || showLogFilePath || showBenchmarkStats || showSlowSpecStack || report.Failed() || (v.Is(types.VerbosityLevelVerbose) && !report.State.Is(types.SpecStateSkipped))
 
 	// should we show a runtime?
 	includeRuntime := !report.State.Is(types.SpecStateSkipped|types.SpecStatePending) || (report.State.Is(types.SpecStateSkipped) && report.Failure.Message != "")
@@ -245,6 +537,9 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		if report.NumAttempts > 1 && report.MaxFlakeAttempts > 1 {
 			header, reportHasContent = fmt.Sprintf("%s [FLAKEY TEST - TOOK %d ATTEMPTS TO PASS]", r.retryDenoter, report.NumAttempts), true
 		}
+		if report.DeviatesFromExpectedDuration() {
+			reportHasContent = true
+		}
 	case types.SpecStatePending:
 		header = "P"
 		if v.GT(types.VerbosityLevelSuccinct) {
@@ -260,6 +555,10 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		if report.MaxMustPassRepeatedly > 1 {
 			header = fmt.Sprintf("%s DURING REPETITION #%d", header, report.NumAttempts)
 		}
+		if report.MaxRepeat > 0 {
+			_, failed := report.RepeatCounts()
+			header = fmt.Sprintf("%s [%d OF %d ITERATIONS FAILED]", header, failed, len(report.RepeatStates))
+		}
 	}
 
 	// If we have no content to show, jsut emit the header and return
@@ -268,11 +567,29 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		return
 	}
 
+	if r.tagParallelProcess && report.ParallelProcess > 0 {
+		header = r.f("%s [Process #%d]", header, report.ParallelProcess)
+	}
+
 	if includeRuntime {
-		header = r.f("%s [%.3f seconds]", header, report.RunTime.Seconds())
+		if runTimeColor := r.runTimeColor(report.RunTime); runTimeColor != "" {
+			header = r.f("%s {{/}}%s[%.3f seconds]{{/}}%s", header, runTimeColor, report.RunTime.Seconds(), highlightColor)
+		} else {
+			header = r.f("%s [%.3f seconds]", header, report.RunTime.Seconds())
+		}
+	}
+
+	if includeRuntime && report.DeviatesFromExpectedDuration() {
+		factor, direction, _ := strings.Cut(report.DurationDeviation(), " ")
+		header = r.f("%s [%s %s THAN EXPECTED]", header, factor, strings.ToUpper(direction))
+	}
+
+	if v.Is(types.VerbosityLevelVeryVerbose) && report.PeakRSSDelta > 0 {
+		header = r.f("%s [peak RSS +%s]", header, humanReadableBytes(report.PeakRSSDelta))
 	}
 
 	// Emit header
+	r.openGitLabSection(report, !report.Failed())
 	if !timelineHasBeenStreaming {
 		r.emitDelimiter(0)
 	}
@@ -281,18 +598,53 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		r.emitBlock(r.codeLocationBlock(report, highlightColor, v.Is(types.VerbosityLevelVeryVerbose), false))
 	}
 
+	if showBenchmarkStats {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{bold}}Benchmark:{{/}} %s", report.BenchmarkStats))
+	}
+
+	if showSlowSpecStack {
+		r.emitBlock("\n")
+		r.emitSlowSpecStack(1, *report.SlowSpecStack)
+	}
+
+	if showLogFilePath {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{gray}}Captured output written to {{bold}}%s{{/}}", report.LogFilePath))
+	}
+
 	//Emit Stdout/Stderr Output
-	if showSeparateStdSection {
+	if showSeparateStdSection && (report.CapturedStdOut != "" || report.CapturedStdErr != "") {
+		if report.CapturedStdOut != "" {
+			r.emitBlock("\n")
+			r.emitBlock(r.fi(1, "{{gray}}Captured StdOut Output >>{{/}}"))
+			r.emitBlock(r.fi(1, "%s", report.CapturedStdOut))
+			r.emitBlock(r.fi(1, "{{gray}}<< Captured StdOut Output{{/}}"))
+		}
+		if report.CapturedStdErr != "" {
+			r.emitBlock("\n")
+			r.emitBlock(r.fi(1, "{{gray}}Captured StdErr Output >>{{/}}"))
+			r.emitBlock(r.fi(1, "%s", report.CapturedStdErr))
+			r.emitBlock(r.fi(1, "{{gray}}<< Captured StdErr Output{{/}}"))
+		}
+	} else if showSeparateStdSection {
 		r.emitBlock("\n")
 		r.emitBlock(r.fi(1, "{{gray}}Captured StdOut/StdErr Output >>{{/}}"))
 		r.emitBlock(r.fi(1, "%s", report.CapturedStdOutErr))
 		r.emitBlock(r.fi(1, "{{gray}}<< Captured StdOut/StdErr Output{{/}}"))
 	}
 
+	if showInterleavedOutputSection {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{gray}}Captured Output >>{{/}}"))
+		r.emitBlock(r.fi(1, "%s", interleavedOutput))
+		r.emitBlock(r.fi(1, "{{gray}}<< Captured Output{{/}}"))
+	}
+
 	if showSeparateVisibilityAlwaysReportsSection {
 		r.emitBlock("\n")
 		r.emitBlock(r.fi(1, "{{gray}}Report Entries >>{{/}}"))
-		for _, entry := range report.ReportEntries.WithVisibility(types.ReportEntryVisibilityAlways) {
+		for _, entry := range report.ReportEntries.WithMinSeverity(r.conf.MinReportEntrySeverity).WithVisibility(types.ReportEntryVisibilityAlways) {
 			r.emitReportEntry(1, entry)
 		}
 		r.emitBlock(r.fi(1, "{{gray}}<< Report Entries{{/}}"))
@@ -314,9 +666,85 @@ func (r *DefaultReporter) DidRun(report types.SpecReport) {
 		}
 	}
 
+	if showSilentSetupFailureHint {
+		r.emitBlock("\n")
+		r.emitBlock(r.fi(1, "{{gray}}Hint: the failing [%s] node produced no output - consider adding diagnostics (e.g. with GinkgoWriter) to make failures like this easier to debug{{/}}", report.Failure.FailureNodeType))
+	}
+
 	r.emitDelimiter(0)
 }
 
+// setupNodeTypes are the node types that set up and tear down an individual spec
+var setupNodeTypes = types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach | types.NodeTypeAfterEach | types.NodeTypeJustAfterEach | types.NodeTypeBeforeAll | types.NodeTypeAfterAll | types.NodeTypeCleanupAfterEach | types.NodeTypeCleanupAfterAll
+
+// isSilentSetupFailure returns true if the spec failed in a setup node and no GinkgoWriter or stdout/stderr output was captured while it ran
+func isSilentSetupFailure(report types.SpecReport) bool {
+	if !report.Failure.FailureNodeType.Is(setupNodeTypes) {
+		return false
+	}
+	return report.CapturedGinkgoWriterOutput == "" && report.CapturedStdOutErr == "" && report.CapturedStdOut == "" && report.CapturedStdErr == ""
+}
+
+func (r *DefaultReporter) emitSlowSpecStack(indent uint, stack types.SlowSpecStack) {
+	r.emit(r.fi(indent, "{{orange}}Spec was still running after {{bold}}%s{{/}}{{orange}}, in {{bold}}[%s]{{/}}", stack.Threshold, stack.CurrentNodeType))
+	if stack.CurrentNodeText != "" && !stack.CurrentNodeType.Is(types.NodeTypeIt) {
+		r.emit(r.f(" {{bold}}{{orange}}%s{{/}}", stack.CurrentNodeText))
+	}
+	r.emit("\n")
+	r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", r.cl(stack.CurrentNodeLocation)))
+	r.emit(r.fi(indent, "{{bold}}{{underline}}Spec Goroutine{{/}}\n"))
+	r.emitGoroutines(indent, stack.Goroutine)
+}
+
+func humanReadableBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// DidRunAttempt emits a summary of a failed attempt of a retried spec.  It only emits output when running with --show-attempts and -v or -vv.
+func (r *DefaultReporter) DidRunAttempt(report types.SpecReport) {
+	if !r.conf.ShowAttempts || r.conf.Verbosity().LT(types.VerbosityLevelVerbose) || report.RunningInParallel {
+		return
+	}
+	highlightColor := r.highlightColorForState(report.State)
+	runTimeColor := r.runTimeColor(report.RunTime)
+	if runTimeColor == "" {
+		runTimeColor = "{{gray}}"
+	}
+	r.emitBlock(r.fi(1, highlightColor+"[%s] Attempt #%d of %d{{/}} %s "+runTimeColor+"[%.3f seconds]{{/}}", r.humanReadableState(report.State), report.NumAttempts, report.MaxFlakeAttempts, r.truncate(report.FullText()), report.RunTime.Seconds()))
+	r.emitBlock(r.fi(2, highlightColor+"%s{{/}} {{gray}}%s{{/}}", report.Failure.Message, r.cl(report.Failure.Location)))
+}
+
+// runTimeColor returns the color to render runTime in when running with ColorDurationsByGradient - from green, for run times well under the SlowSpecThreshold, through yellow and orange, to red for run times at or over it.  It returns an empty string when the run time should not be colored.
+func (r *DefaultReporter) runTimeColor(runTime time.Duration) string {
+	if !r.conf.ColorDurationsByGradient || r.conf.NoColor {
+		return ""
+	}
+	threshold := r.conf.SlowSpecThreshold
+	if threshold <= 0 {
+		threshold = types.DefaultSlowSpecThreshold
+	}
+	ratio := float64(runTime) / float64(threshold)
+	switch {
+	case ratio < 0.5:
+		return "{{green}}"
+	case ratio < 0.75:
+		return "{{yellow}}"
+	case ratio < 1:
+		return "{{orange}}"
+	default:
+		return "{{red}}"
+	}
+}
+
 func (r *DefaultReporter) highlightColorForState(state types.SpecState) string {
 	switch state {
 	case types.SpecStatePassed:
@@ -397,7 +825,7 @@ func (r *DefaultReporter) emitShortFailure(indent uint, state types.SpecState, f
 	r.emitBlock(r.fi(indent, r.highlightColorForState(state)+"[%s]{{/}} in [%s] - %s {{gray}}@ %s{{/}}",
 		r.humanReadableState(state),
 		failure.FailureNodeType,
-		failure.Location,
+		r.cl(failure.Location),
 		failure.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT),
 	))
 }
@@ -405,7 +833,7 @@ func (r *DefaultReporter) emitShortFailure(indent uint, state types.SpecState, f
 func (r *DefaultReporter) emitFailure(indent uint, state types.SpecState, failure types.Failure, includeAdditionalFailure bool) {
 	highlightColor := r.highlightColorForState(state)
 	r.emitBlock(r.fi(indent, highlightColor+"[%s] %s{{/}}", r.humanReadableState(state), failure.Message))
-	r.emitBlock(r.fi(indent, highlightColor+"In {{bold}}[%s]{{/}}"+highlightColor+" at: {{bold}}%s{{/}} {{gray}}@ %s{{/}}\n", failure.FailureNodeType, failure.Location, failure.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT)))
+	r.emitBlock(r.fi(indent, highlightColor+"In {{bold}}[%s]{{/}}"+highlightColor+" at: {{bold}}%s{{/}} {{gray}}@ %s{{/}}\n", failure.FailureNodeType, r.cl(failure.Location), failure.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT)))
 	if failure.ForwardedPanic != "" {
 		r.emitBlock("\n")
 		r.emitBlock(r.fi(indent, highlightColor+"%s{{/}}", failure.ForwardedPanic))
@@ -452,7 +880,7 @@ func (r *DefaultReporter) emitProgressReport(indent uint, emitGinkgoWriterOutput
 			subjectIndent = 0
 		}
 		r.emit(r.fi(subjectIndent, "{{bold}}{{orange}}%s{{/}} (Spec Runtime: %s)\n", report.LeafNodeText, report.Time().Sub(report.SpecStartTime).Round(time.Millisecond)))
-		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", report.LeafNodeLocation))
+		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", r.cl(report.LeafNodeLocation)))
 		indent += 1
 	}
 	if report.CurrentNodeType != types.NodeTypeInvalid {
@@ -462,12 +890,12 @@ func (r *DefaultReporter) emitProgressReport(indent uint, emitGinkgoWriterOutput
 		}
 
 		r.emit(r.f(" (Node Runtime: %s)\n", report.Time().Sub(report.CurrentNodeStartTime).Round(time.Millisecond)))
-		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", report.CurrentNodeLocation))
+		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", r.cl(report.CurrentNodeLocation)))
 		indent += 1
 	}
 	if report.CurrentStepText != "" {
 		r.emit(r.fi(indent, "At {{bold}}{{orange}}[By Step] %s{{/}} (Step Runtime: %s)\n", report.CurrentStepText, report.Time().Sub(report.CurrentStepStartTime).Round(time.Millisecond)))
-		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", report.CurrentStepLocation))
+		r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", r.cl(report.CurrentStepLocation)))
 		indent += 1
 	}
 
@@ -524,17 +952,27 @@ func (r *DefaultReporter) emitProgressReport(indent uint, emitGinkgoWriterOutput
 }
 
 func (r *DefaultReporter) EmitReportEntry(entry types.ReportEntry) {
-	if r.conf.Verbosity().LT(types.VerbosityLevelVerbose) || entry.Visibility == types.ReportEntryVisibilityNever {
+	if r.conf.Verbosity().LT(types.VerbosityLevelVerbose) || entry.Visibility == types.ReportEntryVisibilityNever || entry.Severity < r.conf.MinReportEntrySeverity {
 		return
 	}
 	r.emitReportEntry(1, entry)
 }
 
 func (r *DefaultReporter) emitReportEntry(indent uint, entry types.ReportEntry) {
-	r.emitBlock(r.fi(indent, "{{bold}}"+entry.Name+"{{gray}} "+fmt.Sprintf("- %s @ %s{{/}}", entry.Location, entry.Time.Format(types.GINKGO_TIME_FORMAT))))
+	severityColor := ""
+	switch entry.Severity {
+	case types.ReportEntrySeverityWarning:
+		severityColor = "{{orange}}"
+	case types.ReportEntrySeverityError:
+		severityColor = "{{red}}"
+	}
+	r.emitBlock(r.fi(indent, severityColor+"{{bold}}"+entry.Name+"{{gray}} "+fmt.Sprintf("- %s @ %s{{/}}", r.cl(entry.Location), entry.Time.Format(types.GINKGO_TIME_FORMAT))))
 	if representation := entry.StringRepresentation(); representation != "" {
 		r.emitBlock(r.fi(indent+1, representation))
 	}
+	for _, child := range entry.Children {
+		r.emitReportEntry(indent+1, child)
+	}
 }
 
 func (r *DefaultReporter) EmitSpecEvent(event types.SpecEvent) {
@@ -547,7 +985,7 @@ func (r *DefaultReporter) EmitSpecEvent(event types.SpecEvent) {
 func (r *DefaultReporter) emitSpecEvent(indent uint, event types.SpecEvent, includeLocation bool) {
 	location := ""
 	if includeLocation {
-		location = fmt.Sprintf("- %s ", event.CodeLocation.String())
+		location = fmt.Sprintf("- %s ", r.cl(event.CodeLocation))
 	}
 	switch event.SpecEventType {
 	case types.SpecEventInvalid:
@@ -564,6 +1002,12 @@ func (r *DefaultReporter) emitSpecEvent(indent uint, event types.SpecEvent, incl
 		r.emitBlock(r.fi(indent, "\n{{bold}}Attempt #%d {{green}}Passed{{/}}{{bold}}.  Repeating %s{{/}} {{gray}}@ %s{{/}}\n\n", event.Attempt, r.retryDenoter, event.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT)))
 	case types.SpecEventSpecRetry:
 		r.emitBlock(r.fi(indent, "\n{{bold}}Attempt #%d {{red}}Failed{{/}}{{bold}}.  Retrying %s{{/}} {{gray}}@ %s{{/}}\n\n", event.Attempt, r.retryDenoter, event.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT)))
+	case types.SpecEventSpecIteration:
+		color := "{{red}}"
+		if event.Message == types.SpecStatePassed.String() {
+			color = "{{green}}"
+		}
+		r.emitBlock(r.fi(indent, "\n{{bold}}Iteration #%d %s%s{{/}}{{bold}}.  Repeating %s{{/}} {{gray}}@ %s{{/}}\n\n", event.Attempt, color, event.Message, r.retryDenoter, event.TimelineLocation.Time.Format(types.GINKGO_TIME_FORMAT)))
 	}
 }
 
@@ -577,11 +1021,11 @@ func (r *DefaultReporter) emitGoroutines(indent uint, goroutines ...types.Gorout
 		for _, fc := range g.Stack {
 			if fc.Highlight {
 				r.emit(r.fi(indent, color+"{{bold}}> %s{{/}}\n", fc.Function))
-				r.emit(r.fi(indent+2, color+"{{bold}}%s:%d{{/}}\n", fc.Filename, fc.Line))
+				r.emit(r.fi(indent+2, color+"{{bold}}%s{{/}}\n", r.cl(types.CodeLocation{FileName: fc.Filename, LineNumber: fc.Line})))
 				r.emitSource(indent+3, fc)
 			} else {
 				r.emit(r.fi(indent+1, "{{gray}}%s{{/}}\n", fc.Function))
-				r.emit(r.fi(indent+2, "{{gray}}%s:%d{{/}}\n", fc.Filename, fc.Line))
+				r.emit(r.fi(indent+2, "{{gray}}%s{{/}}\n", r.cl(types.CodeLocation{FileName: fc.Filename, LineNumber: fc.Line})))
 			}
 		}
 
@@ -664,18 +1108,31 @@ func (r *DefaultReporter) fi(indentation uint, format string, args ...interface{
 	return r.formatter.Fi(indentation, format, args...)
 }
 
+// truncate shortens spec and container texts according to ReporterConfig.MaxSpecTextLength
+func (r *DefaultReporter) truncate(text string) string {
+	return r.formatter.Truncate(text, r.conf.MaxSpecTextLength)
+}
+
 func (r *DefaultReporter) cycleJoin(elements []string, joiner string) string {
 	return r.formatter.CycleJoin(elements, joiner, []string{"{{/}}", "{{gray}}"})
 }
 
+func (r *DefaultReporter) cl(location types.CodeLocation) string {
+	return r.conf.FormatCodeLocation(location)
+}
+
 func (r *DefaultReporter) codeLocationBlock(report types.SpecReport, highlightColor string, veryVerbose bool, usePreciseFailureLocation bool) string {
 	texts, locations, labels := []string{}, []types.CodeLocation{}, [][]string{}
-	texts, locations, labels = append(texts, report.ContainerHierarchyTexts...), append(locations, report.ContainerHierarchyLocations...), append(labels, report.ContainerHierarchyLabels...)
+	for _, text := range report.ContainerHierarchyTexts {
+		texts = append(texts, r.truncate(text))
+	}
+	locations, labels = append(locations, report.ContainerHierarchyLocations...), append(labels, report.ContainerHierarchyLabels...)
 
+	leafNodeText := r.truncate(report.LeafNodeText)
 	if report.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) {
-		texts = append(texts, r.f("[%s] %s", report.LeafNodeType, report.LeafNodeText))
+		texts = append(texts, r.f("[%s] %s", report.LeafNodeType, leafNodeText))
 	} else {
-		texts = append(texts, r.f(report.LeafNodeText))
+		texts = append(texts, r.f(leafNodeText))
 	}
 	labels = append(labels, report.LeafNodeLabels)
 	locations = append(locations, report.LeafNodeLocation)
@@ -699,7 +1156,7 @@ func (r *DefaultReporter) codeLocationBlock(report types.SpecReport, highlightCo
 		highlightIndex = i
 	case types.FailureNodeIsLeafNode:
 		i := len(texts) - 1
-		texts[i] = fmt.Sprintf("[%s] %s", report.LeafNodeType, report.LeafNodeText)
+		texts[i] = fmt.Sprintf("[%s] %s", report.LeafNodeType, leafNodeText)
 		locations[i] = failureLocation
 		highlightIndex = i
 	default:
@@ -719,7 +1176,7 @@ func (r *DefaultReporter) codeLocationBlock(report types.SpecReport, highlightCo
 				out += r.f(" {{coral}}[%s]{{/}}", strings.Join(labels[i], ", "))
 			}
 			out += "\n"
-			out += r.fi(uint(i), "{{gray}}%s{{/}}\n", locations[i])
+			out += r.fi(uint(i), "{{gray}}%s{{/}}\n", r.cl(locations[i]))
 		}
 	} else {
 		for i := range texts {
@@ -743,14 +1200,14 @@ func (r *DefaultReporter) codeLocationBlock(report types.SpecReport, highlightCo
 		}
 		out += "\n"
 		if usePreciseFailureLocation {
-			out += r.f("{{gray}}%s{{/}}", failureLocation)
+			out += r.f("{{gray}}%s{{/}}", r.cl(failureLocation))
 		} else {
 			leafLocation := locations[len(locations)-1]
 			if (report.Failure.FailureNodeLocation != types.CodeLocation{}) && (report.Failure.FailureNodeLocation != leafLocation) {
-				out += r.fi(1, highlightColor+"[%s]{{/}} {{gray}}%s{{/}}\n", report.Failure.FailureNodeType, report.Failure.FailureNodeLocation)
-				out += r.fi(1, "{{gray}}[%s] %s{{/}}", report.LeafNodeType, leafLocation)
+				out += r.fi(1, highlightColor+"[%s]{{/}} {{gray}}%s{{/}}\n", report.Failure.FailureNodeType, r.cl(report.Failure.FailureNodeLocation))
+				out += r.fi(1, "{{gray}}[%s] %s{{/}}", report.LeafNodeType, r.cl(leafLocation))
 			} else {
-				out += r.f("{{gray}}%s{{/}}", leafLocation)
+				out += r.f("{{gray}}%s{{/}}", r.cl(leafLocation))
 			}
 		}
 
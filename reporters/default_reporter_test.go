@@ -1,7 +1,10 @@
 package reporters_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"strings"
@@ -102,7 +105,13 @@ func AF(state types.SpecState, options ...interface{}) types.AdditionalFailure {
 }
 
 type STD string
+type STDOUT string
+type STDERR string
 type GW string
+type PeakRSSDelta int64
+type IsInformational bool
+type IsVerbose bool
+type IsFocused bool
 
 // convenience helper to quickly make SpecReports
 func S(options ...interface{}) types.SpecReport {
@@ -143,10 +152,32 @@ func S(options ...interface{}) types.SpecReport {
 			report.MaxFlakeAttempts = int(x)
 		case MustPassRepeatedly:
 			report.MaxMustPassRepeatedly = int(x)
+		case Repeat:
+			report.MaxRepeat = int(x)
+		case []types.SpecState:
+			report.RepeatStates = x
+		case ExpectedDuration:
+			report.ExpectedDuration = time.Duration(x)
 		case STD:
 			report.CapturedStdOutErr = string(x)
+		case STDOUT:
+			report.CapturedStdOut = string(x)
+		case STDERR:
+			report.CapturedStdErr = string(x)
 		case GW:
 			report.CapturedGinkgoWriterOutput = string(x)
+		case PeakRSSDelta:
+			report.PeakRSSDelta = int64(x)
+		case IsInformational:
+			report.IsInformational = bool(x)
+		case IsVerbose:
+			report.IsVerbose = bool(x)
+		case IsFocused:
+			report.IsFocused = bool(x)
+		case types.BenchmarkStats:
+			report.BenchmarkStats = &x
+		case types.SlowSpecStack:
+			report.SlowSpecStack = &x
 		case types.Failure:
 			report.Failure = x
 		case types.AdditionalFailure:
@@ -176,6 +207,7 @@ const (
 	VeryVerbose
 	FullTrace
 	ShowNodeEvents
+	SuppressSuiteHeader
 
 	Parallel //used in the WillRun => DidRun specs to capture behavior when running in parallel
 )
@@ -201,6 +233,9 @@ func (cf ConfigFlag) String() string {
 	if cf.Has(ShowNodeEvents) {
 		out = append(out, "show-node-events")
 	}
+	if cf.Has(SuppressSuiteHeader) {
+		out = append(out, "suppress-suite-header")
+	}
 	if cf.Has(Parallel) {
 		out = append(out, "parallel")
 	}
@@ -226,6 +261,8 @@ func C(flags ...ConfigFlag) types.ReporterConfig {
 		VeryVerbose:    f.Has(VeryVerbose),
 		FullTrace:      f.Has(FullTrace),
 		ShowNodeEvents: f.Has(ShowNodeEvents),
+
+		SuppressSuiteHeader: f.Has(SuppressSuiteHeader),
 	}
 }
 
@@ -422,6 +459,46 @@ var _ = Describe("DefaultReporter", func() {
 			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
 			"",
 		),
+		Entry("When the suite defines no specs",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 0, TotalSpecs: 0},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}}",
+			"",
+			"{{orange}}{{bold}}No specs defined{{/}}",
+			"",
+		),
+		Entry("With container and setup node counts",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20, TotalContainers: 4, TotalSetupNodes: 6},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}}",
+			"",
+			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
+			"",
+		),
+		Entry("With container and setup node counts when verbose",
+			C(Verbose),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20, TotalContainers: 4, TotalSetupNodes: 6},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}}",
+			"",
+			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
+			"{{gray}}Specs are organized in {{bold}}4{{/}}{{gray}} containers and use {{bold}}6{{/}}{{gray}} setup nodes{{/}}",
+			"",
+		),
 		Entry("With Labels",
 			C(),
 			types.Report{
@@ -463,6 +540,19 @@ var _ = Describe("DefaultReporter", func() {
 			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
 			"",
 		),
+		Entry("When configured to randomize specs within containers",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1, RandomizeWithinContainers: true},
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}} - will randomize specs within containers",
+			"",
+			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
+			"",
+		),
 		Entry("when configured to run in parallel",
 			C(),
 			types.Report{
@@ -477,6 +567,33 @@ var _ = Describe("DefaultReporter", func() {
 			"Running in parallel across {{bold}}3{{/}} processes",
 			"",
 		),
+		Entry("when containers are nested deeply",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1}, MaxNestingDepth: 7,
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}}",
+			"",
+			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
+			"{{orange}}Containers in this suite are nested {{bold}}7{{/}}{{orange}} deep - consider flattening them or setting --max-nesting-depth{{/}}",
+			"",
+		),
+		Entry("when containers are nested deeply but the suite sets its own limit",
+			C(),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1, MaxNestingDepth: 8}, MaxNestingDepth: 7,
+			},
+			"Running Suite: My Suite - /path/to/suite",
+			"========================================",
+			"Random Seed: {{bold}}17{{/}}",
+			"",
+			"Will run {{bold}}15{{/}} of {{bold}}20{{/}} specs",
+			"",
+		),
 		Entry("when succinct and in series",
 			C(Succinct),
 			types.Report{
@@ -501,6 +618,27 @@ var _ = Describe("DefaultReporter", func() {
 			},
 			"[17] {{bold}}My Suite{{/}} {{coral}}[dog, fish]{{/}} - 15/20 specs - 3 procs ",
 		),
+		Entry("when the suite header is suppressed and in series",
+			C(SuppressSuiteHeader),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+			},
+		),
+		Entry("when the suite header is suppressed and in parallel",
+			C(SuppressSuiteHeader),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", SuiteLabels: Label("dog, fish"), PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 3},
+			},
+		),
+		Entry("when the suite header is suppressed and succinct",
+			C(SuppressSuiteHeader|Succinct),
+			types.Report{
+				SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+				SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 3},
+			},
+		),
 	)
 
 	DescribeTable("WillRun",
@@ -604,6 +742,79 @@ var _ = Describe("DefaultReporter", func() {
 				DELIMITER,
 				""),
 		),
+		Entry("a passing test with a captured peak RSS delta",
+			S("A", cl0, PeakRSSDelta(3*1024*1024+512*1024)),
+			Case(Succinct, Normal,
+				"{{green}}"+DENOTER+"{{/}}"),
+			Case(Verbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				DELIMITER,
+				""),
+			Case(VeryVerbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds] [peak RSS +3.5 MiB]{{/}}", DENOTER),
+				DELIMITER,
+				""),
+		),
+		Entry("a passing benchmark",
+			S("A", cl0, types.BenchmarkStats{Iterations: 10, Mean: 2 * time.Millisecond, StdDev: time.Millisecond, P95: 4 * time.Millisecond, Min: time.Millisecond, Max: 5 * time.Millisecond}),
+			Case(Succinct,
+				"{{green}}"+DENOTER+"{{/}}"),
+			Case(Normal,
+				DELIMITER,
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				"{{green}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				"",
+				"  {{bold}}Benchmark:{{/}} 10 iterations: mean 2ms ± 1ms, p95 4ms, min 1ms, max 5ms",
+				DELIMITER,
+				""),
+			Case(Verbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				"",
+				"  {{bold}}Benchmark:{{/}} 10 iterations: mean 2ms ± 1ms, p95 4ms, min 1ms, max 5ms",
+				DELIMITER,
+				""),
+		),
+		Entry("a passing test whose stack was captured because it was slow",
+			S("A", cl0, types.SlowSpecStack{Threshold: 500 * time.Millisecond, CurrentNodeType: types.NodeTypeBeforeEach, CurrentNodeText: "setup", CurrentNodeLocation: cl1, Goroutine: G(true, "sleeping", Fn("F1()", "fileA", 15), Fn("F2()", "fileB", 11, true))}),
+			Case(Succinct, Normal,
+				"{{green}}"+DENOTER+"{{/}}"),
+			Case(Verbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				"",
+				"  {{orange}}Spec was still running after {{bold}}500ms{{/}}{{orange}}, in {{bold}}[BeforeEach]{{/}} {{bold}}{{orange}}setup{{/}}",
+				"    {{gray}}"+cl1.String()+"{{/}}",
+				"  {{bold}}{{underline}}Spec Goroutine{{/}}",
+				"  {{orange}}goroutine 17 [sleeping]{{/}}",
+				"    {{gray}}F1(){{/}}",
+				"      {{gray}}fileA:15{{/}}",
+				"  {{orange}}{{bold}}> F2(){{/}}",
+				"      {{orange}}{{bold}}fileB:11{{/}}",
+				DELIMITER,
+				""),
+		),
+		Entry("a passing test whose peak RSS delta could not be captured",
+			S("A", cl0, PeakRSSDelta(-1)),
+			Case(VeryVerbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				DELIMITER,
+				""),
+		),
 		Entry("a passing suite-level node",
 			S(types.NodeTypeReportAfterSuite, "C", cl0),
 			Case(Succinct, Normal, Succinct|Parallel, Normal|Parallel),
@@ -771,6 +982,26 @@ var _ = Describe("DefaultReporter", func() {
 				DELIMITER,
 				""),
 		),
+		Entry("a passing test with stdout and stderr captured separately",
+			S(types.NodeTypeIt, "A", cl0, STD("hello there\nuh oh\n"), STDOUT("hello there\n"), STDERR("uh oh\n")),
+			Case(Succinct, Normal,
+				spr("{{green}}%s{{/}}", DENOTER)),
+			Case(Succinct|Parallel, Normal|Parallel, Verbose|Parallel, VeryVerbose|Parallel,
+				DELIMITER,
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				"{{green}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				"",
+				"  {{gray}}Captured StdOut Output >>{{/}}",
+				"  hello there",
+				"  {{gray}}<< Captured StdOut Output{{/}}",
+				"",
+				"  {{gray}}Captured StdErr Output >>{{/}}",
+				"  uh oh",
+				"  {{gray}}<< Captured StdErr Output{{/}}",
+				DELIMITER,
+				""),
+		),
 		Entry("a passing test with a full timeline that is only visible in verbose/very-verbose mode",
 			S(types.NodeTypeIt, "A", cl0, GW("some GinkgoWriter\noutput is interspersed\nhere and there\n"),
 				SE(types.SpecEventNodeStart, types.NodeTypeIt, "A", cl0),
@@ -1312,6 +1543,60 @@ var _ = Describe("DefaultReporter", func() {
 				DELIMITER,
 				""),
 		),
+		Entry("a passing test that took about as long as expected",
+			S("A", cl0, ExpectedDuration(800*time.Millisecond)),
+			Case(Succinct, Normal, Succinct|Parallel, Normal|Parallel,
+				"{{green}}"+DENOTER+"{{/}}"),
+			Case(Verbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				DELIMITER,
+				""),
+		),
+		Entry("a passing test that was slower than expected",
+			S("A", cl0, 2500*time.Millisecond, ExpectedDuration(time.Second)),
+			Case(Succinct, Normal, Succinct|Parallel, Normal|Parallel,
+				DELIMITER,
+				spr("{{green}}%s [2.500 seconds] [2.5x SLOWER THAN EXPECTED]{{/}}", DENOTER),
+				"{{green}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				DELIMITER,
+				""),
+			Case(Verbose,
+				DELIMITER,
+				"{{/}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				spr("{{green}}%s [2.500 seconds] [2.5x SLOWER THAN EXPECTED]{{/}}", DENOTER),
+				DELIMITER,
+				""),
+		),
+		Entry("a passing test that was faster than expected",
+			S("A", cl0, ExpectedDuration(4*time.Second)),
+			Case(Succinct, Normal, Succinct|Parallel, Normal|Parallel,
+				DELIMITER,
+				spr("{{green}}%s [1.000 seconds] [4x FASTER THAN EXPECTED]{{/}}", DENOTER),
+				"{{green}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				DELIMITER,
+				""),
+		),
+		Entry("a failed test that was repeated",
+			S(types.NodeTypeIt, "A", cl0, 3, types.SpecStateFailed, Repeat(3), []types.SpecState{types.SpecStatePassed, types.SpecStateFailed, types.SpecStatePassed},
+				F("failure", cl1, types.FailureNodeIsLeafNode, FailureNodeLocation(cl0), types.NodeTypeIt),
+			),
+			Case(Succinct, Succinct|Parallel, Normal, Normal|Parallel, Verbose|Parallel,
+				DELIMITER,
+				spr("{{red}}%s [FAILED] [1 OF 3 ITERATIONS FAILED] [1.000 seconds]{{/}}", DENOTER),
+				"{{red}}{{bold}}[It] A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				"",
+				"  {{red}}[FAILED] failure{{/}}",
+				spr("  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}cl1.go:37{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+				DELIMITER,
+				""),
+		),
 		Entry("a failed test with nothing but a failure + the associated additional-failure in the timeline",
 			S(types.NodeTypeIt, CTS("A", "B"), CLS(cl0, cl1), "C", cl2, types.SpecStateTimedout,
 				F("failure\nmessage", cl3, types.FailureNodeIsLeafNode, FailureNodeLocation(cl2), types.NodeTypeIt, TL(0), AF(types.SpecStatePanicked, cl4, types.FailureNodeIsLeafNode, FailureNodeLocation(cl2), types.NodeTypeIt, TL(0))),
@@ -1837,6 +2122,70 @@ var _ = Describe("DefaultReporter", func() {
 			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}3 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
 			"",
 		),
+		Entry("the suite's outcome was determined by the SuiteSuccessPredicate",
+			C(),
+			types.Report{
+				SuiteSucceeded:                    false,
+				SuiteOutcomeDeterminedByPredicate: true,
+				PreRunStats:                       types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 2},
+				RunTime:                           time.Minute,
+				SpecReports:                       types.SpecReports{S(types.SpecStatePassed), S(types.SpecStatePassed)},
+			},
+			"",
+			"{{red}}{{bold}}Ran 2 of 2 Specs in 60.000 seconds{{/}}",
+			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"{{yellow}}The suite's outcome was determined by the configured SuiteSuccessPredicate{{/}}",
+			"",
+		),
+		Entry("when configured to be succinct and the SuiteSuccessPredicate passed the suite",
+			C(Succinct),
+			types.Report{
+				SuiteSucceeded:                    true,
+				SuiteOutcomeDeterminedByPredicate: true,
+				PreRunStats:                       types.PreRunStats{TotalSpecs: 1, SpecsThatWillRun: 1},
+				RunTime:                           time.Minute,
+				SpecReports:                       types.SpecReports{S(types.SpecStatePassed)},
+			},
+			"",
+			"{{green}}{{bold}}Ran 1 of 1 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"{{yellow}}The suite's outcome was determined by the configured SuiteSuccessPredicate{{/}}",
+			"",
+		),
+		Entry("the suite ran in parallel and the reporter is verbose",
+			C(Verbose),
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 2},
+				RunTime:        time.Minute,
+				SpecReports:    types.SpecReports{S(types.SpecStatePassed), S(types.SpecStatePassed)},
+				ParallelEfficiency: &types.ParallelEfficiency{
+					TotalSpecTime:   90 * time.Second,
+					WallTime:        time.Minute,
+					NumProcesses:    2,
+					ProcessBusyTime: map[int]time.Duration{1: time.Minute, 2: 30 * time.Second},
+				},
+			},
+			"",
+			"{{green}}{{bold}}Ran 2 of 2 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"{{gray}}Parallel Efficiency: 75.0% - 1m30s of spec time across 2 processes in 1m0s (idle: #1 0s, #2 30s){{/}}",
+			"",
+		),
+		Entry("the suite ran in parallel and the reporter is not verbose",
+			C(),
+			types.Report{
+				SuiteSucceeded:     true,
+				PreRunStats:        types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 2},
+				RunTime:            time.Minute,
+				SpecReports:        types.SpecReports{S(types.SpecStatePassed), S(types.SpecStatePassed)},
+				ParallelEfficiency: &types.ParallelEfficiency{TotalSpecTime: 90 * time.Second, WallTime: time.Minute, NumProcesses: 2},
+			},
+			"",
+			"{{green}}{{bold}}Ran 2 of 2 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
 		Entry("the suite passes and has flaky specs",
 			C(),
 			types.Report{
@@ -1858,6 +2207,33 @@ var _ = Describe("DefaultReporter", func() {
 			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}7 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{light-yellow}}{{bold}}2 Flaked{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}3 Skipped{{/}}",
 			"",
 		),
+		Entry("the suite passes and has informational specs",
+			C(),
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 5, SpecsThatWillRun: 5},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.SpecStatePassed), S(types.SpecStatePassed),
+					S(CTS("Describe A"), "The Informational Test", CLS(cl0), cl1, IsInformational(true),
+						types.SpecStatePanicked,
+						F("FAILURE MESSAGE", types.FailureNodeIsLeafNode, FailureNodeLocation(cl1), types.NodeTypeIt, cl2),
+					),
+					S("Another Informational Test", cl3, IsInformational(true), types.SpecStatePassed),
+					S(types.SpecStateSkipped, IsInformational(true)),
+				},
+			},
+			"",
+			"{{cyan}}{{bold}}Informational Specs (these never fail the suite):{{/}}",
+			"  {{magenta}}[PANICKED!]{{/}} {{/}}Describe A {{magenta}}{{bold}}[It] The Informational Test{{/}}",
+			"  {{gray}}cl2.go:80{{/}}",
+			"  {{green}}[PASSED]{{/}} {{green}}{{bold}}Another Informational Test{{/}}",
+			"  {{gray}}cl3.go:103{{/}}",
+			"",
+			"{{green}}{{bold}}Ran 4 of 5 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{cyan}}{{bold}}2 Informational{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}1 Skipped{{/}}",
+			"",
+		),
 		Entry("the suite fails with one failed test",
 			C(),
 			types.Report{
@@ -2014,36 +2390,164 @@ var _ = Describe("DefaultReporter", func() {
 			"{{green}}{{bold}}3 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
 			"",
 		),
-	)
-
-	DescribeTable("EmitProgressReport",
-		func(conf types.ReporterConfig, report types.ProgressReport, expected ...any) {
-			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
-			reporter.EmitProgressReport(report)
-			Expect(string(buf.Contents())).Should(MatchLines(expected...))
-		},
-		//just headers to start
-		Entry("With a suite node",
-			C(),
-			PR("A Message", types.NodeTypeBeforeSuite),
-			INDENTED_DELIMITER,
-			"  A Message",
-			"    In {{bold}}{{orange}}[BeforeSuite]{{/}} (Node Runtime: 3s)",
-			"      {{gray}}"+cl1.String()+"{{/}}",
-			INDENTED_DELIMITER,
-			""),
-		Entry("With a top-level spec",
-			C(),
-			PR("A Message", types.NodeTypeIt, CurrentNodeText("A Top-Level It"), LeafNodeText("A Top-Level It")),
-			INDENTED_DELIMITER,
-			"  A Message",
-			"    {{bold}}{{orange}}A Top-Level It{{/}} (Spec Runtime: 5s)",
-			"      {{gray}}"+cl0.String()+"{{/}}",
-			"      In {{bold}}{{orange}}[It]{{/}} (Node Runtime: 3s)",
-			"        {{gray}}"+cl1.String()+"{{/}}",
-			INDENTED_DELIMITER,
-			""),
-		Entry("With a spec in containers",
+		Entry("when configured to emit a spec count summary",
+			types.ReporterConfig{NoColor: true, SpecCountSummary: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 2},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeBeforeSuite),
+					S(CTS("Cart"), Label("fast"), types.SpecStatePassed, IsFocused(true)),
+					S(CTS("Cart", "empty"), CLabels(Label("slow"), Label()), types.SpecStatePending),
+					S(CTS("Checkout"), Label("fast", "slow"), types.SpecStateSkipped),
+					S(types.SpecStatePassed),
+				},
+			},
+			"",
+			"{{bold}}Spec Count Summary{{/}}",
+			"  {{bold}}                 Total Will Run Pending Skipped Focused{{/}}",
+			"  Total                4        2       1       1       1",
+			"",
+			"  {{bold}}By Container     Total Will Run Pending Skipped Focused{{/}}",
+			"  (no container)       1        1       0       0       0",
+			"  Cart                 2        1       1       0       1",
+			"  Checkout             1        0       0       1       0",
+			"",
+			"  {{bold}}By Label         Total Will Run Pending Skipped Focused{{/}}",
+			"  fast                 2        1       0       1       1",
+			"  slow                 2        0       1       1       0",
+			"",
+			"{{green}}{{bold}}Ran 2 of 4 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}1 Pending{{/}} | {{cyan}}{{bold}}1 Skipped{{/}}",
+			"",
+		),
+		Entry("when configured to report flakes and the suite has flaky specs",
+			types.ReporterConfig{NoColor: true, ReportFlakes: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 2},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.SpecStatePassed), S(types.SpecStatePassed),
+				},
+				FlakyReports: []types.FlakyReport{
+					{
+						ContainerHierarchyTexts: []string{"Cart"}, LeafNodeText: "A", LeafNodeLocation: cl0, NumAttempts: 2,
+						AttemptFailures: []types.AdditionalFailure{
+							{State: types.SpecStateFailed, Attempt: 1, Failure: types.Failure{Message: "Failure recorded during attempt 1:\nfirst failure", Location: cl1}},
+						},
+					},
+				},
+			},
+			"",
+			"{{light-yellow}}{{bold}}Summarizing 1 Flaky Spec:{{/}}",
+			"  {{light-yellow}}[FLAKEY - TOOK 2 ATTEMPTS TO PASS]{{/}} Cart A {{gray}}"+cl0.String()+"{{/}}",
+			"    {{red}}Attempt 1 [FAILED]{{/}} {{gray}}"+cl1.String()+"{{/}}",
+			"      Failure recorded during attempt 1:",
+			"      first failure",
+			"",
+			"{{green}}{{bold}}Ran 2 of 2 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}2 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
+		Entry("when not configured to report flakes",
+			types.ReporterConfig{NoColor: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 1, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports:    types.SpecReports{S(types.SpecStatePassed)},
+				FlakyReports:   []types.FlakyReport{{LeafNodeText: "A", LeafNodeLocation: cl0, NumAttempts: 2}},
+			},
+			"",
+			"{{green}}{{bold}}Ran 1 of 1 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}0 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
+		Entry("when configured to list pending specs and the suite has pending specs",
+			types.ReporterConfig{NoColor: true, ListPendingSpecs: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeBeforeSuite),
+					S(types.SpecStatePassed),
+					S(CTS("Cart"), "A", cl0, types.SpecStatePending),
+					S(CTS("Cart", "Checkout"), "B", cl1, types.SpecStatePending),
+					S("C", cl2, types.SpecStateSkipped),
+				},
+			},
+			"",
+			"{{yellow}}{{bold}}Summarizing 2 Pending Specs:{{/}}",
+			"  {{yellow}}[PENDING]{{/}} Cart A {{gray}}"+cl0.String()+"{{/}}",
+			"  {{yellow}}[PENDING]{{/}} Cart Checkout B {{gray}}"+cl1.String()+"{{/}}",
+			"",
+			"{{green}}{{bold}}Ran 1 of 4 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}2 Pending{{/}} | {{cyan}}{{bold}}1 Skipped{{/}}",
+			"",
+		),
+		Entry("when not configured to list pending specs",
+			types.ReporterConfig{NoColor: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports:    types.SpecReports{S(types.SpecStatePassed), S("A", cl0, types.SpecStatePending)},
+			},
+			"",
+			"{{green}}{{bold}}Ran 1 of 2 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}1 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
+		Entry("when configured to emit a spec count summary as JSON",
+			types.ReporterConfig{NoColor: true, SpecCountSummary: true, SpecCountSummaryJSON: true},
+			types.Report{
+				SuiteSucceeded: true,
+				PreRunStats:    types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 1},
+				RunTime:        time.Minute,
+				SpecReports: types.SpecReports{
+					S(CTS("Cart"), Label("fast"), types.SpecStatePassed, IsFocused(true)),
+					S(CTS("Cart"), types.SpecStatePending),
+				},
+			},
+			`{"Total":{"Name":"","Total":2,"WillRun":1,"Pending":1,"Skipped":0,"Focused":1},"ByContainer":[{"Name":"Cart","Total":2,"WillRun":1,"Pending":1,"Skipped":0,"Focused":1}],"ByLabel":[{"Name":"fast","Total":1,"WillRun":1,"Pending":0,"Skipped":0,"Focused":1}]}`,
+			"",
+			"{{green}}{{bold}}Ran 1 of 2 Specs in 60.000 seconds{{/}}",
+			"{{green}}{{bold}}SUCCESS!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}0 Failed{{/}} | {{yellow}}{{bold}}1 Pending{{/}} | {{cyan}}{{bold}}0 Skipped{{/}}",
+			"",
+		),
+	)
+
+	DescribeTable("EmitProgressReport",
+		func(conf types.ReporterConfig, report types.ProgressReport, expected ...any) {
+			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+			reporter.EmitProgressReport(report)
+			Expect(string(buf.Contents())).Should(MatchLines(expected...))
+		},
+		//just headers to start
+		Entry("With a suite node",
+			C(),
+			PR("A Message", types.NodeTypeBeforeSuite),
+			INDENTED_DELIMITER,
+			"  A Message",
+			"    In {{bold}}{{orange}}[BeforeSuite]{{/}} (Node Runtime: 3s)",
+			"      {{gray}}"+cl1.String()+"{{/}}",
+			INDENTED_DELIMITER,
+			""),
+		Entry("With a top-level spec",
+			C(),
+			PR("A Message", types.NodeTypeIt, CurrentNodeText("A Top-Level It"), LeafNodeText("A Top-Level It")),
+			INDENTED_DELIMITER,
+			"  A Message",
+			"    {{bold}}{{orange}}A Top-Level It{{/}} (Spec Runtime: 5s)",
+			"      {{gray}}"+cl0.String()+"{{/}}",
+			"      In {{bold}}{{orange}}[It]{{/}} (Node Runtime: 3s)",
+			"        {{gray}}"+cl1.String()+"{{/}}",
+			INDENTED_DELIMITER,
+			""),
+		Entry("With a spec in containers",
 			C(),
 			PR(types.NodeTypeIt, CurrentNodeText("My Spec"), LeafNodeText("My Spec"), []string{"Container A", "Container B", "Container C"}),
 			INDENTED_DELIMITER,
@@ -2650,6 +3154,19 @@ var _ = Describe("DefaultReporter", func() {
 			"    {{green}}my report http://example.com/?q=%d%3%%{{/}}",
 			"",
 		),
+		//nested reports
+		Entry("emits nested reports indented beneath their parent",
+			C(Verbose),
+			RE("request", cl0, "GET /", RE("headers", cl1, "Accept: */*", RE("accept", cl2)), RE("response", cl3, 200)),
+			spr("  {{bold}}request{{gray}} - cl0.go:12 @ %s{{/}}", FORMATTED_TIME),
+			"    GET /",
+			spr("    {{bold}}headers{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+			"      Accept: */*",
+			spr("      {{bold}}accept{{gray}} - cl2.go:80 @ %s{{/}}", FORMATTED_TIME),
+			spr("    {{bold}}response{{gray}} - cl3.go:103 @ %s{{/}}", FORMATTED_TIME),
+			"      200",
+			"",
+		),
 	)
 
 	DescribeTable("EmitSpecEvent",
@@ -2714,6 +3231,22 @@ var _ = Describe("DefaultReporter", func() {
 			"",
 			"",
 		),
+		Entry("emits passed spec iterations",
+			C(Verbose),
+			SE(types.SpecEventSpecIteration, 2, "passed"),
+			"",
+			spr("  {{bold}}Iteration #2 {{green}}passed{{/}}{{bold}}.  Repeating ↺{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			"",
+			"",
+		),
+		Entry("emits failed spec iterations",
+			C(Verbose),
+			SE(types.SpecEventSpecIteration, 4, "failed"),
+			"",
+			spr("  {{bold}}Iteration #4 {{red}}failed{{/}}{{bold}}.  Repeating ↺{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			"",
+			"",
+		),
 		Entry("emits spec retries",
 			C(Verbose),
 			SE(types.SpecEventSpecRetry, 7),
@@ -2765,3 +3298,878 @@ var _ = Describe("DefaultReporter", func() {
 		),
 	)
 })
+
+var _ = Describe("DefaultReporter with a CodeLocationFormatter", func() {
+	var buf *gbytes.Buffer
+	var report types.SpecReport
+
+	newReporter := func(flags ConfigFlag) *reporters.DefaultReporter {
+		conf := C(flags)
+		conf.CodeLocationFormatter = func(cl types.CodeLocation) string {
+			return strings.TrimPrefix(cl.String(), "/root/repo/")
+		}
+		return reporters.NewDefaultReporterUnderTest(conf, buf)
+	}
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		containerLocation := types.CodeLocation{FileName: "/root/repo/container_test.go", LineNumber: 7}
+		leafLocation := types.CodeLocation{FileName: "/root/repo/leaf_test.go", LineNumber: 12}
+		failureLocation := types.CodeLocation{FileName: "/root/repo/failure_test.go", LineNumber: 17}
+		report = S(CTS("Container"), CLS(containerLocation), "A", leafLocation, types.SpecStateFailed,
+			F("boom", failureLocation, types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(leafLocation)),
+		)
+	})
+
+	It("formats the failure location in the per-spec output", func() {
+		newReporter(Normal).DidRun(report)
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("{{gray}}leaf_test.go:12{{/}}"))
+		Ω(output).Should(ContainSubstring("at: {{bold}}failure_test.go:17{{/}}"))
+		Ω(output).ShouldNot(ContainSubstring("/root/repo/"))
+	})
+
+	It("formats container locations in the very verbose per-spec output", func() {
+		newReporter(VeryVerbose).DidRun(report)
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("{{gray}}container_test.go:7{{/}}"))
+		Ω(output).Should(ContainSubstring("{{gray}}leaf_test.go:12{{/}}"))
+		Ω(output).ShouldNot(ContainSubstring("/root/repo/"))
+	})
+
+	It("formats the failure location in the summary", func() {
+		newReporter(Normal).SuiteDidEnd(types.Report{
+			SuiteSucceeded: false,
+			PreRunStats:    types.PreRunStats{TotalSpecs: 1, SpecsThatWillRun: 1},
+			RunTime:        time.Minute,
+			SpecReports:    types.SpecReports{report},
+		})
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("Summarizing 1 Failure"))
+		Ω(output).Should(ContainSubstring("{{gray}}failure_test.go:17{{/}}"))
+		Ω(output).ShouldNot(ContainSubstring("/root/repo/"))
+	})
+
+	It("formats progress report frames", func() {
+		newReporter(Normal).EmitProgressReport(types.ProgressReport{
+			LeafNodeText:     "A",
+			LeafNodeLocation: types.CodeLocation{FileName: "/root/repo/leaf_test.go", LineNumber: 12},
+			Goroutines: []types.Goroutine{{
+				ID: 1, State: "running", IsSpecGoroutine: true,
+				Stack: []types.FunctionCall{{Function: "pkg.F", Filename: "/root/repo/pkg/f.go", Line: 3}},
+			}},
+		})
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("pkg/f.go:3"))
+		Ω(output).ShouldNot(ContainSubstring("/root/repo/"))
+	})
+})
+
+var _ = Describe("DefaultReporter in failures-only mode", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var reporter *reporters.DefaultReporter
+	var passing, pending, skipped, failing types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf := C(Normal)
+		conf.FailuresOnly = true
+		reporter = reporters.NewDefaultReporterUnderTest(conf, buf)
+
+		passing = S(CTS("Container"), "A", cl0, types.SpecStatePassed, GW("some output\n"))
+		pending = S(CTS("Container"), "B", cl0, types.SpecStatePending)
+		skipped = S(CTS("Container"), "C", cl0, types.SpecStateSkipped)
+		failing = S(CTS("Container"), CLS(cl0), "D", cl1, types.SpecStateFailed,
+			F("boom", cl2, types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl1)),
+		)
+	})
+
+	It("emits nothing when the suite begins", func() {
+		reporter.SuiteWillBegin(types.Report{SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 4, TotalSpecs: 4}})
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("emits nothing for passing, pending, and skipped specs", func() {
+		for _, report := range []types.SpecReport{passing, pending, skipped} {
+			reporter.WillRun(report)
+			reporter.DidRun(report)
+		}
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("emits full detail for failed specs", func() {
+		reporter.DidRun(failing)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			"{{red}}"+DENOTER+" [FAILED] [1.000 seconds]{{/}}",
+			"{{/}}Container {{red}}{{bold}}[It] D{{/}}",
+			"{{gray}}"+cl1.String()+"{{/}}",
+			"",
+			"  {{red}}[FAILED] boom{{/}}",
+			"  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}"+cl2.String()+"{{/}} {{gray}}@ "+FORMATTED_TIME+"{{/}}",
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("still summarizes failures and the suite when the suite ends", func() {
+		reporter.SuiteDidEnd(types.Report{
+			SuiteSucceeded: false,
+			PreRunStats:    types.PreRunStats{TotalSpecs: 4, SpecsThatWillRun: 3},
+			RunTime:        time.Minute,
+			SpecReports:    types.SpecReports{passing, pending, skipped, failing},
+		})
+		Ω(string(buf.Contents())).Should(MatchLines(
+			"",
+			"{{red}}{{bold}}Summarizing 1 Failure:{{/}}",
+			"  {{red}}[FAIL]{{/}} {{/}}Container {{red}}{{bold}}[It] D{{/}}",
+			"  {{gray}}"+cl2.String()+"{{/}}",
+			"",
+			"{{red}}{{bold}}Ran 2 of 4 Specs in 60.000 seconds{{/}}",
+			"{{red}}{{bold}}FAIL!{{/}} -- {{green}}{{bold}}1 Passed{{/}} | {{red}}{{bold}}1 Failed{{/}} | {{yellow}}{{bold}}1 Pending{{/}} | {{cyan}}{{bold}}1 Skipped{{/}}",
+			"",
+		))
+	})
+})
+
+var _ = Describe("DefaultReporter report entry severity", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var report types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		report = S(types.NodeTypeIt, "A", cl0,
+			RE("info entry", cl1),
+			RE("warning entry", cl1, types.ReportEntrySeverityWarning),
+			RE("error entry", cl1, types.ReportEntrySeverityError),
+		)
+	})
+
+	It("colors report entries by severity", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+			"{{green}}{{bold}}A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Report Entries >>{{/}}",
+			spr("  {{bold}}info entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+			spr("  {{orange}}{{bold}}warning entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+			spr("  {{red}}{{bold}}error entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+			"  {{gray}}<< Report Entries{{/}}",
+			DELIMITER,
+			"",
+		))
+	})
+
+	Context("when MinReportEntrySeverity is set", func() {
+		var conf types.ReporterConfig
+		BeforeEach(func() {
+			conf = C(Normal)
+			conf.MinReportEntrySeverity = types.ReportEntrySeverityWarning
+		})
+
+		It("suppresses report entries below the minimum severity", func() {
+			reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(report)
+			Ω(string(buf.Contents())).Should(MatchLines(
+				DELIMITER,
+				spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+				"{{green}}{{bold}}A{{/}}",
+				"{{gray}}cl0.go:12{{/}}",
+				"",
+				"  {{gray}}Report Entries >>{{/}}",
+				spr("  {{orange}}{{bold}}warning entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+				spr("  {{red}}{{bold}}error entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+				"  {{gray}}<< Report Entries{{/}}",
+				DELIMITER,
+				"",
+			))
+		})
+
+		It("omits the report entries section entirely when every entry is suppressed", func() {
+			report = S(types.NodeTypeIt, "A", cl0, RE("info entry", cl1))
+			reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(report)
+			Ω(string(buf.Contents())).Should(Equal("{{green}}" + DENOTER + "{{/}}"))
+		})
+
+		It("does not stream suppressed report entries", func() {
+			conf.Verbose = true
+			reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+			reporter.EmitReportEntry(RE("info entry", cl1))
+			Ω(buf.Contents()).Should(BeEmpty())
+			reporter.EmitReportEntry(RE("error entry", cl1, types.ReportEntrySeverityError))
+			Ω(string(buf.Contents())).Should(MatchLines(
+				spr("  {{red}}{{bold}}error entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+				"",
+			))
+		})
+	})
+})
+
+var _ = Describe("DefaultReporter with InterleaveOutput", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	var report types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.InterleaveOutput = true
+
+		t := time.Now()
+		report = S(types.NodeTypeIt, "A", cl0, STD("stdout 1\nstdout 2\n"), GW("gw 1\ngw 2"))
+		report.RunningInParallel = true
+		report.CapturedOutputSegments = []types.CapturedOutputSegment{
+			{Source: types.CapturedOutputSourceStdOutErr, Start: 0, End: 9, Time: t},
+			{Source: types.CapturedOutputSourceGinkgoWriter, Start: 0, End: 5, Time: t.Add(time.Millisecond)},
+			{Source: types.CapturedOutputSourceStdOutErr, Start: 9, End: 18, Time: t.Add(2 * time.Millisecond)},
+			{Source: types.CapturedOutputSourceGinkgoWriter, Start: 5, End: 9, Time: t.Add(3 * time.Millisecond)},
+		}
+	})
+
+	It("emits stdout/stderr and GinkgoWriter output in a single chronologically-ordered block", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+			"{{green}}{{bold}}A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Captured Output >>{{/}}",
+			"  stdout 1",
+			"  gw 1",
+			"  stdout 2",
+			"  gw 2",
+			"  {{gray}}<< Captured Output{{/}}",
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("leaves only events in the timeline", func() {
+		report.State = types.SpecStateFailed
+		report.Failure = F("boom", cl1, types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl0), TL("gw 1\n"))
+		report.ReportEntries = types.ReportEntries{RE("my entry", cl1, TL("gw 1\n"))}
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{red}}%s [FAILED] [1.000 seconds]{{/}}", DENOTER),
+			"{{red}}{{bold}}[It] A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Captured Output >>{{/}}",
+			"  stdout 1",
+			"  gw 1",
+			"  stdout 2",
+			"  gw 2",
+			"  {{gray}}<< Captured Output{{/}}",
+			"",
+			"  {{gray}}Timeline >>{{/}}",
+			spr("  {{red}}[FAILED]{{/}} in [It] - cl1.go:37 {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			spr("  {{bold}}my entry{{gray}} - cl1.go:37 @ %s{{/}}", FORMATTED_TIME),
+			"  {{gray}}<< Timeline{{/}}",
+			"",
+			"  {{red}}[FAILED] boom{{/}}",
+			spr("  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}cl1.go:37{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			DELIMITER,
+			"",
+		))
+	})
+})
+
+var _ = Describe("DefaultReporter with a per-spec log file", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var report types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		report = S(types.NodeTypeIt, "A", cl0, STD("stdout 1\nstdout 2\n"), GW("gw 1\ngw 2"))
+		report.RunningInParallel = true
+		report.LogFilePath = "/path/to/logs/abc.log"
+	})
+
+	It("points to the log file instead of inlining the captured output", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+			"{{green}}{{bold}}A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Captured output written to {{bold}}/path/to/logs/abc.log{{/}}",
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("leaves only events in the timeline of failed specs", func() {
+		report.State = types.SpecStateFailed
+		report.Failure = F("boom", cl1, types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl0), TL("gw 1\n"))
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{red}}%s [FAILED] [1.000 seconds]{{/}}", DENOTER),
+			"{{red}}{{bold}}[It] A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Captured output written to {{bold}}/path/to/logs/abc.log{{/}}",
+			"",
+			"  {{red}}[FAILED] boom{{/}}",
+			spr("  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}cl1.go:37{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("does not mention the log file when the output would not have been shown", func() {
+		report.RunningInParallel = false
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines("{{green}}" + DENOTER + "{{/}}"))
+	})
+})
+
+var _ = Describe("DefaultReporter with MaxSpecTextLength", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var reporter *reporters.DefaultReporter
+	var report types.SpecReport
+	longText := "returns the book when the title is " + strings.Repeat("very ", 40) + "long"
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf := C(Normal)
+		conf.MaxSpecTextLength = 20
+		reporter = reporters.NewDefaultReporterUnderTest(conf, buf)
+		report = S(CTS("a container with a {{bold}}long{{/}} description"), CLS(cl0), longText, cl1, types.SpecStateFailed,
+			F("boom", cl2, types.FailureNodeIsLeafNode, types.NodeTypeIt, FailureNodeLocation(cl1)),
+		)
+	})
+
+	It("truncates the container and spec texts in the per-spec header without cutting color tokens", func() {
+		reporter.DidRun(report)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{red}}%s [FAILED] [1.000 seconds]{{/}}", DENOTER),
+			"{{/}}a container with a {{bold}}l...{{/}} {{red}}{{bold}}[It] returns the book whe...{{/}}",
+			"{{gray}}cl1.go:37{{/}}",
+			"",
+			"  {{red}}[FAILED] boom{{/}}",
+			spr("  {{red}}In {{bold}}[It]{{/}}{{red}} at: {{bold}}cl2.go:80{{/}} {{gray}}@ %s{{/}}", FORMATTED_TIME),
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("truncates the texts in the failure summary", func() {
+		reporter.SuiteDidEnd(types.Report{
+			SuiteSucceeded: false,
+			PreRunStats:    types.PreRunStats{TotalSpecs: 1, SpecsThatWillRun: 1},
+			RunTime:        time.Minute,
+			SpecReports:    types.SpecReports{report},
+		})
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("  {{red}}[FAIL]{{/}} {{/}}a container with a {{bold}}l...{{/}} {{red}}{{bold}}[It] returns the book whe...{{/}}"))
+		Ω(output).ShouldNot(ContainSubstring(longText))
+	})
+
+	It("leaves the full text in the report and the JSON report", func() {
+		reporter.DidRun(report)
+		Ω(report.LeafNodeText).Should(Equal(longText))
+
+		path := filepath.Join(GinkgoT().TempDir(), "report.json")
+		Ω(reporters.GenerateJSONReport(types.Report{SpecReports: types.SpecReports{report}}, path)).Should(Succeed())
+		data, err := os.ReadFile(path)
+		Ω(err).ShouldNot(HaveOccurred())
+		var decoded []types.Report
+		Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+		Ω(decoded[0].SpecReports[0].LeafNodeText).Should(Equal(longText))
+		Ω(decoded[0].SpecReports[0].ContainerHierarchyTexts).Should(Equal([]string{"a container with a {{bold}}long{{/}} description"}))
+	})
+})
+
+var _ = Describe("DefaultReporter with EmitSpecStart", func() {
+	var DENOTER, START_DENOTER = "•", "→"
+	if runtime.GOOS == "windows" {
+		DENOTER, START_DENOTER = "+", "->"
+	}
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.EmitSpecStart = true
+	})
+
+	It("emits a start marker before each spec, ahead of its completion dot", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		for _, report := range []types.SpecReport{S(CTS("Container"), "A", cl0), S(CTS("Container"), "B", cl1)} {
+			reporter.WillRun(report)
+			reporter.DidRun(report)
+		}
+		Ω(string(buf.Contents())).Should(MatchLines(
+			spr("{{gray}}%s Container A{{/}}", START_DENOTER),
+			spr("{{green}}%s{{/}}", DENOTER),
+			spr("{{gray}}%s Container B{{/}}", START_DENOTER),
+			spr("{{green}}%s{{/}}", DENOTER),
+		))
+	})
+
+	It("does not emit a start marker for pending or skipped specs", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		reporter.WillRun(S("A", cl0, types.SpecStatePending))
+		reporter.WillRun(S("B", cl0, types.SpecStateSkipped))
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("does not emit a start marker when running in parallel", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		report := S("A", cl0)
+		report.RunningInParallel = true
+		reporter.WillRun(report)
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("emits the usual header instead when running verbosely", func() {
+		conf.Verbose = true
+		reporters.NewDefaultReporterUnderTest(conf, buf).WillRun(S(CTS("Container"), "A", cl0))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring(START_DENOTER))
+		Ω(string(buf.Contents())).Should(ContainSubstring("{{/}}Container {{/}}{{bold}}A{{/}}"))
+	})
+
+	It("does nothing unless enabled", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).WillRun(S("A", cl0))
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+})
+
+var _ = Describe("DefaultReporter with ShowAttempts", func() {
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	var attempt types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Verbose)
+		conf.ShowAttempts = true
+		attempt = S(CTS("Container"), "A", cl0, 2, FlakeAttempts(3), types.SpecStateFailed, F("boom", cl1))
+	})
+
+	It("emits a summary of each failed attempt", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRunAttempt(attempt)
+		Ω(string(buf.Contents())).Should(MatchLines(
+			"  {{red}}[FAILED] Attempt #2 of 3{{/}} Container A {{gray}}[1.000 seconds]{{/}}",
+			"    {{red}}boom{{/}} {{gray}}cl1.go:37{{/}}",
+			"",
+		))
+	})
+
+	It("emits nothing when not running verbosely", func() {
+		conf = C(Normal)
+		conf.ShowAttempts = true
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRunAttempt(attempt)
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("emits nothing when running in parallel", func() {
+		attempt.RunningInParallel = true
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRunAttempt(attempt)
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+
+	It("does nothing unless enabled", func() {
+		reporters.NewDefaultReporterUnderTest(C(Verbose), buf).DidRunAttempt(attempt)
+		Ω(buf.Contents()).Should(BeEmpty())
+	})
+})
+
+var _ = Describe("DefaultReporter with EmitEnvironmentOnFailure", func() {
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	var report types.Report
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.EmitEnvironmentOnFailure = true
+		report = types.Report{
+			SuiteSucceeded: false,
+			SuiteConfig:    types.SuiteConfig{RandomSeed: 17, ParallelTotal: 3, LabelFilter: "integration", FocusStrings: []string{"dogs", "cats"}},
+			SpecReports:    types.SpecReports{S("A", cl0, types.SpecStateFailed, F("boom", cl0))},
+			Environment: &types.SuiteEnvironment{
+				GoVersion:            "go1.99",
+				GOOS:                 "plan9",
+				GOARCH:               "mips",
+				GOMAXPROCS:           4,
+				NumCPU:               8,
+				AvailableMemory:      3 << 29,
+				EnvironmentVariables: map[string]string{"GOFLAGS": "-count=1", "CI": "true"},
+			},
+		}
+	})
+
+	It("emits the environment and the resolved configuration after the failure summary when the suite fails", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).SuiteDidEnd(report)
+		output := string(buf.Contents())
+		Ω(strings.Index(output, "Suite Environment")).Should(BeNumerically(">", strings.Index(output, "Summarizing 1 Failure")))
+		Ω(output).Should(ContainSubstring(strings.Join([]string{
+			"{{bold}}Suite Environment{{/}}",
+			"  Go: go1.99 plan9/mips",
+			"  GOMAXPROCS: 4 | CPUs: 8 | Available Memory: 1.5 GiB",
+			"  Parallel Processes: 3",
+			"  Random Seed: 17",
+			"  Label Filter: integration",
+			"  Focus: dogs, cats",
+			"  Environment Variables:",
+			"    CI=true",
+			"    GOFLAGS=-count=1",
+		}, "\n")))
+	})
+
+	It("does not emit the environment when the suite succeeds", func() {
+		report.SuiteSucceeded = true
+		report.SpecReports = types.SpecReports{S("A", cl0, types.SpecStatePassed)}
+		reporters.NewDefaultReporterUnderTest(conf, buf).SuiteDidEnd(report)
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Suite Environment"))
+	})
+
+	It("does not emit the environment unless enabled", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).SuiteDidEnd(report)
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Suite Environment"))
+	})
+})
+
+var _ = Describe("DefaultReporter with ForceColor", func() {
+	var buf *gbytes.Buffer
+	var report types.Report
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		report = types.Report{
+			SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+			SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+		}
+	})
+
+	It("emits ANSI color codes to a writer that is not a terminal", func() {
+		reporters.NewDefaultReporter(types.ReporterConfig{ForceColor: true}, buf).SuiteWillBegin(report)
+		Ω(string(buf.Contents())).Should(ContainSubstring("Random Seed: \x1b[1m17\x1b[0m"))
+	})
+
+	It("lets NoColor take precedence", func() {
+		reporters.NewDefaultReporter(types.ReporterConfig{ForceColor: true, NoColor: true}, buf).SuiteWillBegin(report)
+		Ω(string(buf.Contents())).Should(ContainSubstring("Random Seed: 17"))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("\x1b["))
+	})
+})
+
+var _ = Describe("DefaultReporter with the as-completed parallel output mode", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var report types.Report
+	var spec types.SpecReport
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		report = types.Report{
+			SuiteDescription: "My Suite", SuitePath: "/path/to/suite", PreRunStats: types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20},
+			SuiteConfig: types.SuiteConfig{RandomSeed: 17, ParallelTotal: 3, ParallelOutputMode: types.ParallelOutputModeAsCompleted},
+		}
+		spec = S(CTS("Container"), "A", cl0, types.SpecStateFailed, F("boom", cl1))
+		spec.RunningInParallel = true
+		spec.ParallelProcess = 2
+	})
+
+	It("tags each block with the process that ran the spec", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(C(Normal), buf)
+		reporter.SuiteWillBegin(report)
+		Ω(buf.Clear()).Should(Succeed())
+		reporter.DidRun(spec)
+		Ω(string(buf.Contents())).Should(ContainSubstring(spr("{{red}}%s [FAILED] [Process #2] [1.000 seconds]{{/}}", DENOTER)))
+	})
+
+	It("does not tag the denoters emitted for specs with no content", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(C(Normal), buf)
+		reporter.SuiteWillBegin(report)
+		Ω(buf.Clear()).Should(Succeed())
+		passed := S("B", cl0)
+		passed.RunningInParallel, passed.ParallelProcess = true, 2
+		reporter.DidRun(passed)
+		Ω(string(buf.Contents())).Should(Equal("{{green}}" + DENOTER + "{{/}}"))
+	})
+
+	It("does not tag blocks in the ordered parallel output mode", func() {
+		report.SuiteConfig.ParallelOutputMode = types.ParallelOutputModeOrdered
+		reporter := reporters.NewDefaultReporterUnderTest(C(Normal), buf)
+		reporter.SuiteWillBegin(report)
+		reporter.DidRun(spec)
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Process #2"))
+	})
+})
+
+var _ = Describe("DefaultReporter with GitLabSections", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	var start, end time.Time
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.GitLabSections = true
+		start = time.Unix(1700000000, 0)
+		end = start.Add(3 * time.Second)
+	})
+
+	spec := func(options ...interface{}) types.SpecReport {
+		report := S(append([]interface{}{CTS("Container"), "does (the) thing", cl0}, options...)...)
+		report.ID = "abc123"
+		report.StartTime, report.EndTime = start, end
+		return report
+	}
+
+	It("brackets a failed spec's output with an expanded section named after the spec", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		report := spec(types.SpecStateFailed, F("boom", cl1))
+		reporter.WillRun(report)
+		reporter.DidRun(report)
+		Ω(string(buf.Contents())).Should(HavePrefix("\x1b[0Ksection_start:1700000000:ginkgo_Container_does_the_thing_abc123\r\x1b[0KContainer does (the) thing\n" + DELIMITER + "\n"))
+		Ω(string(buf.Contents())).Should(ContainSubstring("[FAILED] boom"))
+		Ω(string(buf.Contents())).Should(HaveSuffix(DELIMITER + "\n\x1b[0Ksection_end:1700000003:ginkgo_Container_does_the_thing_abc123\r\x1b[0K\n"))
+	})
+
+	It("collapses the sections of specs that did not fail", func() {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		report := spec(RE("my-entry", cl1, types.ReportEntryVisibilityAlways))
+		reporter.DidRun(report)
+		Ω(string(buf.Contents())).Should(HavePrefix("\x1b[0Ksection_start:1700000000:ginkgo_Container_does_the_thing_abc123[collapsed=true]\r\x1b[0KContainer does (the) thing\n"))
+		Ω(string(buf.Contents())).Should(ContainSubstring("my-entry"))
+		Ω(string(buf.Contents())).Should(HaveSuffix("\x1b[0Ksection_end:1700000003:ginkgo_Container_does_the_thing_abc123\r\x1b[0K\n"))
+	})
+
+	It("opens the section before the streamed output when running verbosely in series", func() {
+		conf = C(Verbose)
+		conf.GitLabSections = true
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		report := spec()
+		reporter.WillRun(report)
+		Ω(string(buf.Contents())).Should(HavePrefix("\x1b[0Ksection_start:1700000000:ginkgo_Container_does_the_thing_abc123\r\x1b[0KContainer does (the) thing\n" + DELIMITER))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("section_end"))
+		reporter.EmitReportEntry(types.ReportEntry{Name: "streamed", Visibility: types.ReportEntryVisibilityAlways})
+		reporter.DidRun(report)
+		Ω(string(buf.Contents())).Should(HaveSuffix(DELIMITER + "\n\x1b[0Ksection_end:1700000003:ginkgo_Container_does_the_thing_abc123\r\x1b[0K\n"))
+		Ω(strings.Count(string(buf.Contents()), "section_start")).Should(Equal(1))
+	})
+
+	It("does not emit sections for specs that only emit a denoter", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(spec())
+		Ω(string(buf.Contents())).Should(Equal("{{green}}" + DENOTER + "{{/}}"))
+	})
+
+	It("names sections for suite-level nodes after the node type", func() {
+		report := types.SpecReport{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStateFailed, Failure: F("boom", cl1), StartTime: start, EndTime: end}
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(report)
+		Ω(string(buf.Contents())).Should(HavePrefix("\x1b[0Ksection_start:1700000000:ginkgo_BeforeSuite\r\x1b[0K[BeforeSuite]\n"))
+	})
+
+	It("does nothing unless enabled", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(spec(types.SpecStateFailed, F("boom", cl1)))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("section_"))
+	})
+})
+
+var _ = Describe("DefaultReporter with VerboseOutput specs", func() {
+	var DENOTER = "•"
+	if runtime.GOOS == "windows" {
+		DENOTER = "+"
+	}
+	var buf *gbytes.Buffer
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+	})
+
+	run := func(conf types.ReporterConfig, report types.SpecReport) {
+		reporter := reporters.NewDefaultReporterUnderTest(conf, buf)
+		reporter.WillRun(report)
+		reporter.DidRun(report)
+	}
+
+	It("renders a passing VerboseOutput spec, and its captured output, as though -v had been set", func() {
+		run(C(Succinct), S("A", cl0, GW("some GinkgoWriter output\n"), IsVerbose(true)))
+		Ω(string(buf.Contents())).Should(MatchLines(
+			DELIMITER,
+			spr("{{green}}%s [1.000 seconds]{{/}}", DENOTER),
+			"{{green}}{{bold}}A{{/}}",
+			"{{gray}}cl0.go:12{{/}}",
+			"",
+			"  {{gray}}Timeline >>{{/}}",
+			"  some GinkgoWriter output",
+			"  {{gray}}<< Timeline{{/}}",
+			DELIMITER,
+			"",
+		))
+	})
+
+	It("continues to render other passing specs at the configured verbosity", func() {
+		run(C(Succinct), S("A", cl0, GW("some GinkgoWriter output\n"), IsVerbose(true)))
+		run(C(Succinct), S("B", cl1, GW("some other GinkgoWriter output\n")))
+		Ω(string(buf.Contents())).Should(ContainSubstring("some GinkgoWriter output"))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("some other GinkgoWriter output"))
+		Ω(string(buf.Contents())).Should(HaveSuffix(DELIMITER + "\n" + spr("{{green}}%s{{/}}", DENOTER)))
+	})
+
+	It("does not repeat the timeline of a VerboseOutput spec that has already been streamed", func() {
+		run(C(Verbose), S("A", cl0, GW("some GinkgoWriter output\n"), IsVerbose(true)))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("some GinkgoWriter output"))
+	})
+
+	It("does not emit passing VerboseOutput specs with --failures-only", func() {
+		conf := C(Normal)
+		conf.FailuresOnly = true
+		run(conf, S("A", cl0, GW("some GinkgoWriter output\n"), IsVerbose(true)))
+		Ω(string(buf.Contents())).Should(BeEmpty())
+	})
+})
+
+var _ = Describe("DefaultReporter with HintOnSilentSetupFailure", func() {
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	hint := "  {{gray}}Hint: the failing [BeforeEach] node produced no output - consider adding diagnostics (e.g. with GinkgoWriter) to make failures like this easier to debug{{/}}"
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.HintOnSilentSetupFailure = true
+	})
+
+	setupFailure := func(options ...interface{}) types.SpecReport {
+		return S(append([]interface{}{"A", cl0, types.SpecStateFailed, F("boom", cl1, types.FailureNodeInContainer, FailureNodeLocation(cl2), types.NodeTypeBeforeEach)}, options...)...)
+	}
+
+	It("hints that diagnostics should be added when a setup node fails without producing any output", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(setupFailure())
+		Ω(string(buf.Contents())).Should(HaveSuffix("\n" + hint + "\n" + DELIMITER + "\n"))
+	})
+
+	It("does not hint when the spec produced output", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(setupFailure(GW("some GinkgoWriter output\n")))
+		Ω(string(buf.Contents())).Should(ContainSubstring("some GinkgoWriter output"))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Hint:"))
+
+		buf = gbytes.NewBuffer()
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(setupFailure(STD("some stdout\n")))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Hint:"))
+	})
+
+	It("does not hint when the spec failed in its subject node", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(S("A", cl0, types.SpecStateFailed, F("boom", cl1, types.FailureNodeIsLeafNode, FailureNodeLocation(cl0), types.NodeTypeIt)))
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Hint:"))
+	})
+
+	It("does not hint unless HintOnSilentSetupFailure is set", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).DidRun(setupFailure())
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("Hint:"))
+	})
+})
+
+var _ = Describe("DefaultReporter with PrintReproducibleFlags", func() {
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+	var report types.Report
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Normal)
+		conf.PrintReproducibleFlags = true
+		suiteConfig := types.NewDefaultSuiteConfig()
+		suiteConfig.RandomSeed, suiteConfig.ParallelTotal = 17, 3
+		suiteConfig.LabelFilter = "integration && !slow"
+		suiteConfig.FocusStrings = []string{"it's a dog"}
+		report = types.Report{
+			SuiteSucceeded: true,
+			SuiteConfig:    suiteConfig,
+			SpecReports:    types.SpecReports{S("A", cl0, types.SpecStatePassed)},
+		}
+	})
+
+	It("emits the flags that reproduce the run, quoted for the shell, before the suite summary", func() {
+		reporters.NewDefaultReporterUnderTest(conf, buf).SuiteDidEnd(report)
+		output := string(buf.Contents())
+		Ω(output).Should(ContainSubstring("{{bold}}To reproduce this run:{{/}}\n  ginkgo --seed=17 --procs=3 '--label-filter=integration && !slow' '--focus=it'\\''s a dog' --no-color --print-reproducible-flags\n"))
+		Ω(strings.Index(output, "To reproduce this run")).Should(BeNumerically("<", strings.Index(output, "Ran 1 of")))
+	})
+
+	It("does not emit the flags unless enabled", func() {
+		reporters.NewDefaultReporterUnderTest(C(Normal), buf).SuiteDidEnd(report)
+		Ω(string(buf.Contents())).ShouldNot(ContainSubstring("To reproduce this run"))
+	})
+})
+
+var _ = Describe("DefaultReporter with ColorDurationsByGradient", func() {
+	var buf *gbytes.Buffer
+	var conf types.ReporterConfig
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		conf = C(Verbose)
+		conf.NoColor = false
+		conf.ColorDurationsByGradient = true
+		conf.SlowSpecThreshold = 2 * time.Second
+	})
+
+	DescribeTable("colors run times relative to the SlowSpecThreshold",
+		func(runTime time.Duration, expected string) {
+			reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(S("A", cl0, runTime))
+			Ω(string(buf.Contents())).Should(ContainSubstring(" {{/}}%s{{/}}{{green}}", expected))
+		},
+		Entry("fast", 500*time.Millisecond, "{{green}}[0.500 seconds]"),
+		Entry("medium", 1200*time.Millisecond, "{{yellow}}[1.200 seconds]"),
+		Entry("almost slow", 1800*time.Millisecond, "{{orange}}[1.800 seconds]"),
+		Entry("slow", 3*time.Second, "{{red}}[3.000 seconds]"),
+	)
+
+	It("defaults the SlowSpecThreshold", func() {
+		conf.SlowSpecThreshold = 0
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(S("A", cl0, 3*time.Second))
+		Ω(string(buf.Contents())).Should(ContainSubstring("{{yellow}}[3.000 seconds]{{/}}"))
+	})
+
+	It("does nothing when NoColor is set", func() {
+		conf.NoColor = true
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(S("A", cl0, 3*time.Second))
+		Ω(string(buf.Contents())).Should(ContainSubstring(" [3.000 seconds]{{/}}"))
+	})
+
+	It("does nothing unless enabled", func() {
+		conf.ColorDurationsByGradient = false
+		reporters.NewDefaultReporterUnderTest(conf, buf).DidRun(S("A", cl0, 3*time.Second))
+		Ω(string(buf.Contents())).Should(ContainSubstring(" [3.000 seconds]{{/}}"))
+	})
+})
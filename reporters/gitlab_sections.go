@@ -0,0 +1,83 @@
+package reporters
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+GitLab CI collapses the parts of a job log that are delimited by section markers (see https://docs.gitlab.com/ee/ci/jobs/#custom-collapsible-sections).
+When ReporterConfig.GitLabSections is set the DefaultReporter wraps the output it emits for each spec in a section whose header is the spec's text.
+
+Section names may only contain letters, digits, '_', '.', and '-' so the name is derived from the spec's text and ID.
+*/
+
+var gitLabSectionNameInvalidCharacters = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+const gitLabSectionNameMaxTextLength = 64
+
+func gitLabSectionHeader(report types.SpecReport) string {
+	if report.LeafNodeType.Is(types.NodeTypesForSuiteLevelNodes) {
+		return strings.TrimSpace(fmt.Sprintf("[%s] %s", report.LeafNodeType, report.LeafNodeText))
+	}
+	return report.FullText()
+}
+
+func gitLabSectionName(report types.SpecReport) string {
+	name := strings.Trim(gitLabSectionNameInvalidCharacters.ReplaceAllString(gitLabSectionHeader(report), "_"), "_")
+	if len(name) > gitLabSectionNameMaxTextLength {
+		name = name[:gitLabSectionNameMaxTextLength]
+	}
+	if report.ID != "" {
+		name += "_" + report.ID
+	}
+	return "ginkgo_" + name
+}
+
+// gitLabSectionTimestamp returns the time to record in a section marker as a unix timestamp - GitLab uses the start and end timestamps to display each section's duration
+func gitLabSectionTimestamp(t time.Time, fallback time.Time) int64 {
+	if t.IsZero() {
+		t = fallback
+	}
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// openGitLabSection starts a section for the spec unless GitLabSections is off or a section is already open.  Collapsed sections start out collapsed in GitLab's log viewer.
+func (r *DefaultReporter) openGitLabSection(report types.SpecReport, collapsed bool) {
+	if !r.conf.GitLabSections || r.gitLabSection != "" {
+		return
+	}
+	r.gitLabSection = gitLabSectionName(report)
+	options := ""
+	if collapsed {
+		options = "[collapsed=true]"
+	}
+	r.emitGitLabSectionMarker(fmt.Sprintf("\x1b[0Ksection_start:%d:%s%s\r\x1b[0K%s", gitLabSectionTimestamp(report.StartTime, time.Time{}), r.gitLabSection, options, gitLabSectionHeader(report)))
+}
+
+// closeGitLabSection ends the open section, if any
+func (r *DefaultReporter) closeGitLabSection(report types.SpecReport) {
+	if r.gitLabSection == "" {
+		return
+	}
+	r.emitGitLabSectionMarker(fmt.Sprintf("\x1b[0Ksection_end:%d:%s\r\x1b[0K", gitLabSectionTimestamp(report.EndTime, report.StartTime), r.gitLabSection))
+	r.gitLabSection = ""
+}
+
+// emitGitLabSectionMarker emits marker on a line of its own.  Markers are invisible in GitLab's log viewer so, unlike emitBlock, emitting one does not reset the delimiter deduplication.
+func (r *DefaultReporter) emitGitLabSectionMarker(marker string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.lastCharWasNewline {
+		r.writer.Write([]byte("\n"))
+	}
+	r.writer.Write([]byte(marker + "\n"))
+	r.lastCharWasNewline = true
+}
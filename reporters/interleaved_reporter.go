@@ -0,0 +1,145 @@
+package reporters
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// DefaultEventSentinel is the prefix an InterleavedReporter uses to mark its JSON event lines when no other sentinel is provided
+const DefaultEventSentinel = "@@GINKGO@@"
+
+/*
+InterleavedEvent is the payload of each JSON event line emitted by an InterleavedReporter.  Event names the Reporter callback that produced the event
+(e.g. "SuiteWillBegin", "WillRun", "DidRun", "SuiteDidEnd") and exactly one of the remaining fields is populated for that callback.
+*/
+type InterleavedEvent struct {
+	Event string
+
+	Report         *types.Report         `json:",omitempty"`
+	SpecReport     *types.SpecReport     `json:",omitempty"`
+	SpecReports    []types.SpecReport    `json:",omitempty"`
+	State          types.SpecState       `json:",omitempty"`
+	Failure        *types.Failure        `json:",omitempty"`
+	ProgressReport *types.ProgressReport `json:",omitempty"`
+	ReportEntry    *types.ReportEntry    `json:",omitempty"`
+	SpecEvent      *types.SpecEvent      `json:",omitempty"`
+}
+
+/*
+InterleavedReporter renders Ginkgo's default human-readable output and, on the same writer, emits a single line of JSON for each Reporter callback.
+Each JSON line is prefixed with a sentinel (followed by a space) and always begins on a new line so a single log can be read by humans and parsed by tools
+that grep for the sentinel (see ParseInterleavedStream).
+
+An InterleavedReporter attached to a suite via ReporterSet.Reporters runs in each parallel process and so only sees the specs
+that run on that process.
+*/
+type InterleavedReporter struct {
+	*DefaultReporter
+	sentinel string
+}
+
+// NewInterleavedReporter returns an InterleavedReporter that emits human-readable output (configured by conf) and sentinel-prefixed JSON events to out.  If sentinel is empty DefaultEventSentinel is used.
+func NewInterleavedReporter(out io.Writer, sentinel string, conf types.ReporterConfig) *InterleavedReporter {
+	if sentinel == "" {
+		sentinel = DefaultEventSentinel
+	}
+	return &InterleavedReporter{
+		DefaultReporter: NewDefaultReporter(conf, out),
+		sentinel:        sentinel,
+	}
+}
+
+func (r *InterleavedReporter) SuiteWillBegin(report types.Report) {
+	r.DefaultReporter.SuiteWillBegin(report)
+	r.emitEvent(InterleavedEvent{Event: "SuiteWillBegin", Report: &report})
+}
+
+func (r *InterleavedReporter) SpecsWillRun(specs []types.SpecReport) {
+	r.emitEvent(InterleavedEvent{Event: "SpecsWillRun", SpecReports: specs})
+}
+
+func (r *InterleavedReporter) WillRun(report types.SpecReport) {
+	r.DefaultReporter.WillRun(report)
+	r.emitEvent(InterleavedEvent{Event: "WillRun", SpecReport: &report})
+}
+
+func (r *InterleavedReporter) DidRun(report types.SpecReport) {
+	r.DefaultReporter.DidRun(report)
+	if !r.conf.KeepANSIInCaptured {
+		report = stripANSIFromSpecReport(report)
+	}
+	r.emitEvent(InterleavedEvent{Event: "DidRun", SpecReport: &report})
+}
+
+func (r *InterleavedReporter) DidRunAttempt(report types.SpecReport) {
+	r.DefaultReporter.DidRunAttempt(report)
+	r.emitEvent(InterleavedEvent{Event: "DidRunAttempt", SpecReport: &report})
+}
+
+func (r *InterleavedReporter) SuiteDidEnd(report types.Report) {
+	r.DefaultReporter.SuiteDidEnd(report)
+	if !r.conf.KeepANSIInCaptured {
+		report = StripANSIFromCapturedOutput(report)
+	}
+	r.emitEvent(InterleavedEvent{Event: "SuiteDidEnd", Report: &report})
+}
+
+func (r *InterleavedReporter) EmitFailure(state types.SpecState, failure types.Failure) {
+	r.DefaultReporter.EmitFailure(state, failure)
+	r.emitEvent(InterleavedEvent{Event: "Failure", State: state, Failure: &failure})
+}
+
+func (r *InterleavedReporter) EmitProgressReport(progressReport types.ProgressReport) {
+	r.DefaultReporter.EmitProgressReport(progressReport)
+	r.emitEvent(InterleavedEvent{Event: "ProgressReport", ProgressReport: &progressReport})
+}
+
+func (r *InterleavedReporter) EmitReportEntry(entry types.ReportEntry) {
+	r.DefaultReporter.EmitReportEntry(entry)
+	r.emitEvent(InterleavedEvent{Event: "ReportEntry", ReportEntry: &entry})
+}
+
+func (r *InterleavedReporter) EmitSpecEvent(event types.SpecEvent) {
+	r.DefaultReporter.EmitSpecEvent(event)
+	r.emitEvent(InterleavedEvent{Event: "SpecEvent", SpecEvent: &event})
+}
+
+// emitEvent emits the event as a single line of compact JSON.  emitBlock shares the DefaultReporter's lock and ensures the line starts on a new line.
+func (r *InterleavedReporter) emitEvent(event InterleavedEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		r.emitBlock(r.f("{{red}}Failed to encode %s event:\n%s{{/}}", event.Event, err.Error()))
+		return
+	}
+	r.emitBlock(r.sentinel + " " + string(data))
+}
+
+/*
+ParseInterleavedStream separates the output of an InterleavedReporter that used sentinel (DefaultEventSentinel if empty).  It returns the human-readable lines, in order,
+and the decoded JSON events, in order.  An error is returned if reading fails or if a sentinel-prefixed line does not contain a valid event.
+*/
+func ParseInterleavedStream(in io.Reader, sentinel string) ([]string, []InterleavedEvent, error) {
+	if sentinel == "" {
+		sentinel = DefaultEventSentinel
+	}
+	lines, events := []string{}, []InterleavedEvent{}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, sentinel+" ") {
+			lines = append(lines, line)
+			continue
+		}
+		event := InterleavedEvent{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, sentinel+" ")), &event); err != nil {
+			return nil, nil, err
+		}
+		events = append(events, event)
+	}
+	return lines, events, scanner.Err()
+}
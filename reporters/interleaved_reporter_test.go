@@ -0,0 +1,85 @@
+package reporters_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("InterleavedReporter", func() {
+	var buf *gbytes.Buffer
+	var report types.Report
+
+	BeforeEach(func() {
+		buf = gbytes.NewBuffer()
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuiteSucceeded:   false,
+			PreRunStats:      types.PreRunStats{TotalSpecs: 2, SpecsThatWillRun: 2},
+			RunTime:          time.Minute,
+			SpecReports: types.SpecReports{
+				S("A", types.SpecStatePassed),
+				S("B", types.SpecStateFailed, F("boom", types.FailureNodeIsLeafNode, types.NodeTypeIt, cl0)),
+			},
+		}
+	})
+
+	run := func(reporter reporters.Reporter) {
+		reporter.SuiteWillBegin(report)
+		reporter.(reporters.SpecOrderReporter).SpecsWillRun(report.SpecReports)
+		for _, spec := range report.SpecReports {
+			reporter.WillRun(spec)
+			reporter.DidRun(spec)
+		}
+		reporter.EmitReportEntry(types.ReportEntry{Name: "my-entry"})
+		reporter.SuiteDidEnd(report)
+	}
+
+	It("emits human-readable output and sentinel-prefixed JSON events to the same writer", func() {
+		run(reporters.NewInterleavedReporter(buf, "", types.ReporterConfig{NoColor: true}))
+
+		Ω(buf).Should(gbytes.Say("Running Suite: My Suite"))
+		Ω(buf).Should(gbytes.Say(`\n@@GINKGO@@ {"Event":"SuiteWillBegin","Report":{"SuitePath":"","SuiteDescription":"My Suite"`))
+		Ω(buf).Should(gbytes.Say(`\n@@GINKGO@@ {"Event":"DidRun","SpecReport":{.*"LeafNodeText":"B"`))
+		Ω(buf).Should(gbytes.Say(`\[FAIL\] \[It\] B`))
+		Ω(buf).Should(gbytes.Say(`FAIL! -- 1 Passed \| 1 Failed \| 0 Pending \| 0 Skipped`))
+		Ω(buf).Should(gbytes.Say(`\n@@GINKGO@@ {"Event":"SuiteDidEnd"`))
+	})
+
+	It("produces output that can be separated into human-readable lines and events", func() {
+		run(reporters.NewInterleavedReporter(buf, "##EVENT##", types.ReporterConfig{NoColor: true, Succinct: true}))
+
+		lines, events, err := reporters.ParseInterleavedStream(strings.NewReader(string(buf.Contents())), "##EVENT##")
+		Ω(err).ShouldNot(HaveOccurred())
+
+		names := []string{}
+		for _, event := range events {
+			names = append(names, event.Event)
+		}
+		Ω(names).Should(Equal([]string{"SuiteWillBegin", "SpecsWillRun", "WillRun", "DidRun", "WillRun", "DidRun", "ReportEntry", "SuiteDidEnd"}))
+		Ω(events[1].SpecReports).Should(HaveLen(2))
+		Ω(events[3].SpecReport.LeafNodeText).Should(Equal("A"))
+		Ω(events[5].SpecReport.State).Should(Equal(types.SpecStateFailed))
+		Ω(events[5].SpecReport.Failure.Message).Should(Equal("boom"))
+		Ω(events[6].ReportEntry.Name).Should(Equal("my-entry"))
+		Ω(events[7].Report.SpecReports).Should(HaveLen(2))
+
+		human := strings.Join(lines, "\n")
+		Ω(human).Should(ContainSubstring("My Suite"))
+		Ω(human).Should(ContainSubstring("[FAIL] [It] B"))
+		Ω(human).Should(ContainSubstring("FAIL! -- 1 Passed | 1 Failed | 0 Pending | 0 Skipped"))
+		Ω(human).ShouldNot(ContainSubstring("##EVENT##"))
+		Ω(human).ShouldNot(ContainSubstring(`"Event"`))
+	})
+
+	It("returns an error when a sentinel-prefixed line is not a valid event", func() {
+		_, _, err := reporters.ParseInterleavedStream(strings.NewReader("hello\n@@GINKGO@@ {not json\n"), "")
+		Ω(err).Should(HaveOccurred())
+	})
+})
@@ -9,25 +9,54 @@ import (
 	"github.com/onsi/ginkgo/v2/types"
 )
 
-// GenerateJSONReport produces a JSON-formatted report at the passed in destination
+// JSONReportConfig configures the JSON report generated by GenerateJSONReportWithConfig
+type JSONReportConfig struct {
+	// Enable Compact to emit compact, single-line, JSON - useful when storing large reports as artifacts.  By default the generated JSON is indented.
+	Compact bool
+
+	// IncludeLabelFilter, if set, limits the SpecReports in the report to specs whose labels (including the suite's labels) satisfy the label filter query.  The report's PreRunStats continue to describe the full run.
+	IncludeLabelFilter string
+}
+
+// GenerateJSONReport produces a pretty-printed JSON-formatted report at the passed in destination
 func GenerateJSONReport(report types.Report, destination string) error {
-	if err := os.MkdirAll(path.Dir(destination), 0770); err != nil {
+	return GenerateJSONReportWithConfig(report, destination, JSONReportConfig{})
+}
+
+// GenerateJSONReportWithConfig produces a JSON-formatted report at the passed in destination, formatted according to config
+// If destination is "-" the report is written to stdout instead
+func GenerateJSONReportWithConfig(report types.Report, destination string, config JSONReportConfig) error {
+	included, err := includedSpecReports(report, config.IncludeLabelFilter)
+	if err != nil {
 		return err
 	}
-	f, err := os.Create(destination)
+	if config.IncludeLabelFilter != "" {
+		specReports := types.SpecReports{}
+		for i, spec := range report.SpecReports {
+			if included[i] {
+				specReports = append(specReports, spec)
+			}
+		}
+		report.SpecReports = specReports
+	}
+	var data []byte
+	if config.Compact {
+		data, err = json.Marshal([]types.Report{report})
+	} else {
+		data, err = json.MarshalIndent([]types.Report{report}, "", "  ")
+		data = append(data, '\n')
+	}
 	if err != nil {
 		return err
 	}
-	defer f.Close()
-	enc := json.NewEncoder(f)
-	enc.SetIndent("", "  ")
-	err = enc.Encode([]types.Report{
-		report,
-	})
-	if err != nil {
+	if destination == types.STDOUT_REPORT_DESTINATION {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(destination), 0770); err != nil {
 		return err
 	}
-	return nil
+	return os.WriteFile(destination, data, 0666)
 }
 
 // MergeJSONReports produces a single JSON-formatted report at the passed in destination by merging the JSON-formatted reports provided in sources
@@ -1,6 +1,7 @@
 package reporters_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -52,6 +53,49 @@ var _ = Describe("JSONReport", func() {
 		}
 	})
 
+	Describe("when the report includes nested report entries", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			report.SpecReports = types.SpecReports{
+				S(types.NodeTypeIt, "A", cl0,
+					RE("request", cl0, "GET /", RE("headers", cl1, "Accept: */*", RE("accept", cl2)), RE("response", cl3, 200)),
+				),
+			}
+			filePath = fmt.Sprintf("report-nested-%d.json", GinkgoParallelProcess())
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.Remove, filePath)
+		})
+
+		It("encodes the nested entries as children of their parent", func() {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+
+			entry := decoded[0].SpecReports[0].ReportEntries[0]
+			Ω(entry.Name).Should(Equal("request"))
+			Ω(entry.StringRepresentation()).Should(Equal("GET /"))
+			Ω(entry.Children).Should(HaveLen(2))
+			Ω(entry.Children[0].Name).Should(Equal("headers"))
+			Ω(entry.Children[0].Location).Should(Equal(cl1))
+			Ω(entry.Children[0].StringRepresentation()).Should(Equal("Accept: */*"))
+			Ω(entry.Children[0].Children).Should(HaveLen(1))
+			Ω(entry.Children[0].Children[0].Name).Should(Equal("accept"))
+			Ω(entry.Children[0].Children[0].Children).Should(BeEmpty())
+			Ω(entry.Children[1].Name).Should(Equal("response"))
+			Ω(entry.Children[1].GetRawValue()).Should(Equal(float64(200)))
+		})
+
+		It("omits Children for entries that have none", func() {
+			report.SpecReports = types.SpecReports{S(types.NodeTypeIt, "A", cl0, RE("flat", cl0))}
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).ShouldNot(ContainSubstring("Children"))
+		})
+	})
+
 	Describe("when configured to write the report inside a folder", func() {
 		var folderPath string
 		var filePath string
@@ -72,4 +116,204 @@ var _ = Describe("JSONReport", func() {
 			Ω(err).Should(Succeed(), "Report file should be created")
 		})
 	})
+
+	Describe("when the report includes suite labels", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			report.SuiteLabels = []string{"books", "integration"}
+			filePath = fmt.Sprintf("report-suite-labels-%d.json", GinkgoParallelProcess())
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.Remove, filePath)
+		})
+
+		It("serializes the suite labels", func() {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SuiteLabels).Should(Equal([]string{"books", "integration"}))
+		})
+	})
+
+	Describe("when specs declare an expected duration", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			report.SpecReports = types.SpecReports{
+				S(types.NodeTypeIt, "A", cl0, 2500*time.Millisecond, ExpectedDuration(time.Second)),
+				S(types.NodeTypeIt, "B", cl1),
+			}
+			filePath = fmt.Sprintf("report-expected-duration-%d.json", GinkgoParallelProcess())
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.Remove, filePath)
+		})
+
+		It("serializes the expected duration alongside the actual run time", func() {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SpecReports[0].ExpectedDuration).Should(Equal(time.Second))
+			Ω(decoded[0].SpecReports[0].RunTime).Should(Equal(2500 * time.Millisecond))
+			Ω(decoded[0].SpecReports[1].ExpectedDuration).Should(BeZero())
+		})
+	})
+
+	Describe("when a spec timed out", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			failure := F("A node timeout occurred", cl1, types.FailureNodeInContainer, FailureNodeLocation(cl1), types.NodeTypeBeforeEach)
+			failure.TimeoutDetails = &types.TimeoutDetails{Timeout: "node", Budget: time.Second, Elapsed: 1200 * time.Millisecond, NodeType: types.NodeTypeBeforeEach, NodeText: "A"}
+			report.SpecReports = types.SpecReports{
+				S(types.NodeTypeIt, "A", cl0, types.SpecStateTimedout, failure),
+				S(types.NodeTypeIt, "B", cl0),
+			}
+			filePath = fmt.Sprintf("report-timeout-%d.json", GinkgoParallelProcess())
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.Remove, filePath)
+		})
+
+		It("includes the timeout budget, the elapsed time, and the node that was running", func() {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var raw []map[string]interface{}
+			Ω(json.Unmarshal(data, &raw)).Should(Succeed())
+			specReports := raw[0]["SpecReports"].([]interface{})
+			details := specReports[0].(map[string]interface{})["Failure"].(map[string]interface{})["TimeoutDetails"]
+			Ω(details).Should(Equal(map[string]interface{}{
+				"Timeout":  "node",
+				"Budget":   float64(time.Second),
+				"Elapsed":  float64(1200 * time.Millisecond),
+				"NodeType": "BeforeEach",
+				"NodeText": "A",
+			}))
+			Ω(specReports[1].(map[string]interface{})).ShouldNot(HaveKey("Failure"))
+
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SpecReports[0].Failure.TimeoutDetails).Should(Equal(report.SpecReports[0].Failure.TimeoutDetails))
+		})
+	})
+
+	Describe("when the report includes passing suite setup nodes", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			report.SpecReports = types.SpecReports{
+				S(types.NodeTypeSynchronizedBeforeSuite, cl0, types.SpecStatePassed, 2*time.Second, GW("starting the database\n"), STD("db ready\n")),
+				S(types.NodeTypeBeforeSuite, cl1, types.SpecStatePassed, time.Second, GW("seeding the library\n")),
+				S(types.NodeTypeIt, "A", cl2),
+				S(types.NodeTypeAfterSuite, cl3, types.SpecStatePassed, 500*time.Millisecond, GW("tearing down\n")),
+			}
+			filePath = fmt.Sprintf("report-setup-nodes-%d.json", GinkgoParallelProcess())
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			DeferCleanup(os.Remove, filePath)
+		})
+
+		It("includes them, along with their state, timing, and captured output, regardless of verbosity", func() {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SpecReports).Should(HaveLen(4))
+
+			synchronizedBeforeSuite := decoded[0].SpecReports[0]
+			Ω(synchronizedBeforeSuite.LeafNodeType).Should(Equal(types.NodeTypeSynchronizedBeforeSuite))
+			Ω(synchronizedBeforeSuite.State).Should(Equal(types.SpecStatePassed))
+			Ω(synchronizedBeforeSuite.RunTime).Should(Equal(2 * time.Second))
+			Ω(synchronizedBeforeSuite.CapturedGinkgoWriterOutput).Should(Equal("starting the database\n"))
+			Ω(synchronizedBeforeSuite.CapturedStdOutErr).Should(Equal("db ready\n"))
+
+			beforeSuite := decoded[0].SpecReports[1]
+			Ω(beforeSuite.LeafNodeType).Should(Equal(types.NodeTypeBeforeSuite))
+			Ω(beforeSuite.State).Should(Equal(types.SpecStatePassed))
+			Ω(beforeSuite.RunTime).Should(Equal(time.Second))
+			Ω(beforeSuite.CapturedGinkgoWriterOutput).Should(Equal("seeding the library\n"))
+
+			afterSuite := decoded[0].SpecReports[3]
+			Ω(afterSuite.LeafNodeType).Should(Equal(types.NodeTypeAfterSuite))
+			Ω(afterSuite.State).Should(Equal(types.SpecStatePassed))
+			Ω(afterSuite.CapturedGinkgoWriterOutput).Should(Equal("tearing down\n"))
+		})
+	})
+
+	Describe("configuring the JSON formatting", func() {
+		var filePath string
+
+		BeforeEach(func() {
+			filePath = filepath.Join(GinkgoT().TempDir(), "report.json")
+		})
+
+		readReport := func() (string, []types.Report) {
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			return string(data), decoded
+		}
+
+		It("emits compact, single-line, JSON when Compact is true", func() {
+			Ω(reporters.GenerateJSONReportWithConfig(report, filePath, reporters.JSONReportConfig{Compact: true})).Should(Succeed())
+			data, decoded := readReport()
+			Ω(data).ShouldNot(ContainSubstring("\n"))
+			Ω(decoded[0].SuiteDescription).Should(Equal("My Suite"))
+			Ω(decoded[0].SpecReports).Should(HaveLen(len(report.SpecReports)))
+		})
+
+		It("emits indented JSON by default", func() {
+			Ω(reporters.GenerateJSONReportWithConfig(report, filePath, reporters.JSONReportConfig{})).Should(Succeed())
+			data, decoded := readReport()
+			Ω(data).Should(HavePrefix("[\n  {\n    \"SuitePath\": \"/path/to/suite\","))
+			Ω(decoded[0].SuiteDescription).Should(Equal("My Suite"))
+		})
+
+		It("emits indented JSON by default when using GenerateJSONReport", func() {
+			Ω(reporters.GenerateJSONReport(report, filePath)).Should(Succeed())
+			data, _ := readReport()
+			Ω(data).Should(HavePrefix("[\n  {\n"))
+			Ω(data).Should(HaveSuffix("\n  }\n]\n"))
+		})
+	})
+
+	Describe("when configured with an IncludeLabelFilter", func() {
+		It("only includes specs that match the filter, but preserves the full run's PreRunStats", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), "report.json")
+			Ω(reporters.GenerateJSONReportWithConfig(report, filePath, reporters.JSONReportConfig{IncludeLabelFilter: "dolphin"})).Should(Succeed())
+			data, err := os.ReadFile(filePath)
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SpecReports).Should(HaveLen(1))
+			Ω(decoded[0].SpecReports[0].LeafNodeText).Should(Equal("C"))
+			Ω(decoded[0].PreRunStats).Should(Equal(types.PreRunStats{SpecsThatWillRun: 15, TotalSpecs: 20}))
+		})
+
+		It("returns an error when the filter is invalid", func() {
+			filePath := filepath.Join(GinkgoT().TempDir(), "report.json")
+			Ω(reporters.GenerateJSONReportWithConfig(report, filePath, reporters.JSONReportConfig{IncludeLabelFilter: "dolphin &&"})).ShouldNot(Succeed())
+			Ω(filePath).ShouldNot(BeAnExistingFile())
+		})
+	})
+
+	Describe("when the destination is -", func() {
+		It("writes the report to stdout", func() {
+			stdout, err := os.Create(filepath.Join(GinkgoT().TempDir(), "stdout"))
+			Ω(err).ShouldNot(HaveOccurred())
+			originalStdout := os.Stdout
+			os.Stdout = stdout
+			err = reporters.GenerateJSONReport(report, "-")
+			os.Stdout = originalStdout
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(stdout.Close()).Should(Succeed())
+
+			data, err := os.ReadFile(stdout.Name())
+			Ω(err).ShouldNot(HaveOccurred())
+			var decoded []types.Report
+			Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+			Ω(decoded[0].SuiteDescription).Should(Equal("My Suite"))
+			Ω("-").ShouldNot(BeAnExistingFile())
+		})
+	})
 })
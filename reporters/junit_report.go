@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/onsi/ginkgo/v2/config"
@@ -40,6 +41,47 @@ type JunitReportConfig struct {
 
 	// Enable OmitSuiteSetupNodes to prevent the creation of testcase entries for setup nodes
 	OmitSuiteSetupNodes bool
+
+	// CodeLocationFormatter, if set, is used to render code locations in failure descriptions and timelines.  See types.ReporterConfig.CodeLocationFormatter
+	CodeLocationFormatter func(types.CodeLocation) string
+
+	// SpecStateOutcomes overrides the JUnit element used to report specs in the given failure states.  For example, map types.SpecStateInterrupted to JUnitOutcomeSkipped to keep interrupted specs from appearing as test failures.
+	// Only failure states (see types.SpecStateFailureStates) can be overridden.  States that are not in the map use the default outcome.
+	SpecStateOutcomes map[types.SpecState]JUnitOutcome
+
+	// IncludeLabelFilter, if set, limits the testcase entries in the report to specs whose labels (including the suite's labels) satisfy the label filter query.  This is independent of --label-filter and applies only to the generated report.
+	IncludeLabelFilter string
+
+	// Enable CountAllSpecs to have the suite-level tests, failures, errors, skipped, and disabled counts reflect every spec in the run, even those excluded by IncludeLabelFilter.  By default the counts reflect only the included specs.
+	CountAllSpecs bool
+
+	// SuiteNameOverride, if set, is used in place of the suite description as the name of the testsuite and the classname of its testcases
+	SuiteNameOverride string
+
+	// Properties, if set, are emitted as top-level properties of the report (i.e. in a <properties> element directly within <testsuites>).  They are sorted by name.
+	Properties map[string]string
+}
+
+// JUnitOutcome is the JUnit element used to report a spec
+type JUnitOutcome uint
+
+const (
+	// JUnitOutcomeDefault uses Ginkgo's default outcome for the spec's state: failed, timedout, and aborted specs are reported as <failure>; interrupted and panicked specs are reported as <error>
+	JUnitOutcomeDefault JUnitOutcome = iota
+	// JUnitOutcomeFailure reports the spec as a <failure>
+	JUnitOutcomeFailure
+	// JUnitOutcomeError reports the spec as an <error>
+	JUnitOutcomeError
+	// JUnitOutcomeSkipped reports the spec as <skipped>
+	JUnitOutcomeSkipped
+)
+
+var defaultJUnitOutcomes = map[types.SpecState]JUnitOutcome{
+	types.SpecStateFailed:      JUnitOutcomeFailure,
+	types.SpecStateTimedout:    JUnitOutcomeFailure,
+	types.SpecStateAborted:     JUnitOutcomeFailure,
+	types.SpecStateInterrupted: JUnitOutcomeError,
+	types.SpecStatePanicked:    JUnitOutcomeError,
 }
 
 type JUnitTestSuites struct {
@@ -55,12 +97,15 @@ type JUnitTestSuites struct {
 	// Time is the time in seconds to execute all test suites
 	Time float64 `xml:"time,attr"`
 
+	//Properties captures any global properties configured via JunitReportConfig.Properties
+	Properties *JUnitProperties `xml:"properties,omitempty"`
+
 	//The set of all test suites
 	TestSuites []JUnitTestSuite `xml:"testsuite"`
 }
 
 type JUnitTestSuite struct {
-	// Name maps onto the description of the test suite - maps onto Report.SuiteDescription
+	// Name maps onto the description of the test suite - maps onto Report.SuiteDescription unless JunitReportConfig.SuiteNameOverride is set
 	Name string `xml:"name,attr"`
 	// Package maps onto the absolute path to the test suite - maps onto Report.SuitePath
 	Package string `xml:"package,attr"`
@@ -107,12 +152,14 @@ type JUnitProperty struct {
 type JUnitTestCase struct {
 	// Name maps onto the full text of the spec - equivalent to "[SpecReport.LeafNodeType] SpecReport.FullText()"
 	Name string `xml:"name,attr"`
-	// Classname maps onto the name of the test suite - equivalent to Report.SuiteDescription
+	// Classname maps onto the name of the test suite - equivalent to Report.SuiteDescription unless JunitReportConfig.SuiteNameOverride is set
 	Classname string `xml:"classname,attr"`
 	// Status maps onto the string representation of SpecReport.State
 	Status string `xml:"status,attr"`
 	// Time is the time in seconds to execute the spec - maps onto SpecReport.RunTime
 	Time float64 `xml:"time,attr"`
+	// Properties maps onto any ReportEntries targeted at ReportEntryTargetJUnitProperty - each is emitted as a property named after the entry with the entry's string representation as its value
+	Properties *JUnitProperties `xml:"properties,omitempty"`
 	//Skipped is populated with a message if the test was skipped or pending
 	Skipped *JUnitSkipped `xml:"skipped,omitempty"`
 	//Error is populated if the test panicked or was interrupted
@@ -153,8 +200,12 @@ func GenerateJUnitReport(report types.Report, dst string) error {
 }
 
 func GenerateJUnitReportWithConfig(report types.Report, dst string, config JunitReportConfig) error {
+	suiteName := report.SuiteDescription
+	if config.SuiteNameOverride != "" {
+		suiteName = config.SuiteNameOverride
+	}
 	suite := JUnitTestSuite{
-		Name:      report.SuiteDescription,
+		Name:      suiteName,
 		Package:   report.SuitePath,
 		Time:      report.RunTime.Seconds(),
 		Timestamp: report.StartTime.Format("2006-01-02T15:04:05"),
@@ -180,10 +231,17 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 			},
 		},
 	}
-	for _, spec := range report.SpecReports {
+	included, err := includedSpecReports(report, config.IncludeLabelFilter)
+	if err != nil {
+		return err
+	}
+	for i, spec := range report.SpecReports {
 		if config.OmitSuiteSetupNodes && spec.LeafNodeType != types.NodeTypeIt {
 			continue
 		}
+		if !included[i] && !config.CountAllSpecs {
+			continue
+		}
 		name := fmt.Sprintf("[%s]", spec.LeafNodeType)
 		if config.OmitLeafNodeType {
 			name = ""
@@ -199,12 +257,18 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 
 		test := JUnitTestCase{
 			Name:      name,
-			Classname: report.SuiteDescription,
+			Classname: suiteName,
 			Status:    spec.State.String(),
 			Time:      spec.RunTime.Seconds(),
 		}
+		if entries := spec.ReportEntries.WithTarget(types.ReportEntryTargetJUnitProperty); len(entries) > 0 {
+			test.Properties = &JUnitProperties{}
+			for _, entry := range entries {
+				test.Properties.Properties = append(test.Properties.Properties, JUnitProperty{entry.Name, entry.StringRepresentation()})
+			}
+		}
 		if !spec.State.Is(config.OmitTimelinesForSpecState) {
-			test.SystemErr = systemErrForUnstructuredReporters(spec)
+			test.SystemErr = systemErrForUnstructuredReporters(spec, config.CodeLocationFormatter)
 		}
 		if !config.OmitCapturedStdOutErr {
 			test.SystemOut = systemOutForUnstructuredReporters(spec)
@@ -222,59 +286,44 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 		case types.SpecStatePending:
 			test.Skipped = &JUnitSkipped{Message: "pending"}
 			suite.Disabled += 1
-		case types.SpecStateFailed:
-			test.Failure = &JUnitFailure{
-				Message:     spec.Failure.Message,
-				Type:        "failed",
-				Description: failureDescriptionForUnstructuredReporters(spec),
-			}
-			if config.OmitFailureMessageAttr {
-				test.Failure.Message = ""
-			}
-			suite.Failures += 1
-		case types.SpecStateTimedout:
-			test.Failure = &JUnitFailure{
-				Message:     spec.Failure.Message,
-				Type:        "timedout",
-				Description: failureDescriptionForUnstructuredReporters(spec),
+		case types.SpecStateFailed, types.SpecStateTimedout, types.SpecStateInterrupted, types.SpecStateAborted, types.SpecStatePanicked:
+			message := spec.Failure.Message
+			if spec.State == types.SpecStatePanicked {
+				message = spec.Failure.ForwardedPanic
 			}
 			if config.OmitFailureMessageAttr {
-				test.Failure.Message = ""
+				message = ""
 			}
-			suite.Failures += 1
-		case types.SpecStateInterrupted:
-			test.Error = &JUnitError{
-				Message:     spec.Failure.Message,
-				Type:        "interrupted",
-				Description: failureDescriptionForUnstructuredReporters(spec),
+			description := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
+			outcome, overridden := config.SpecStateOutcomes[spec.State]
+			if !overridden || outcome == JUnitOutcomeDefault {
+				outcome = defaultJUnitOutcomes[spec.State]
 			}
-			if config.OmitFailureMessageAttr {
-				test.Error.Message = ""
-			}
-			suite.Errors += 1
-		case types.SpecStateAborted:
-			test.Failure = &JUnitFailure{
-				Message:     spec.Failure.Message,
-				Type:        "aborted",
-				Description: failureDescriptionForUnstructuredReporters(spec),
-			}
-			if config.OmitFailureMessageAttr {
-				test.Failure.Message = ""
-			}
-			suite.Errors += 1
-		case types.SpecStatePanicked:
-			test.Error = &JUnitError{
-				Message:     spec.Failure.ForwardedPanic,
-				Type:        "panicked",
-				Description: failureDescriptionForUnstructuredReporters(spec),
+			switch outcome {
+			case JUnitOutcomeFailure:
+				test.Failure = &JUnitFailure{Message: message, Type: spec.State.String(), Description: description}
+			case JUnitOutcomeError:
+				test.Error = &JUnitError{Message: message, Type: spec.State.String(), Description: description}
+			case JUnitOutcomeSkipped:
+				test.Skipped = &JUnitSkipped{Message: spec.State.String()}
+				if message != "" {
+					test.Skipped.Message += " - " + message
+				}
 			}
-			if config.OmitFailureMessageAttr {
-				test.Error.Message = ""
+			switch {
+			case outcome == JUnitOutcomeSkipped:
+				suite.Skipped += 1
+			case outcome == JUnitOutcomeError, !overridden && spec.State == types.SpecStateAborted:
+				// aborted specs are emitted as <failure> but, for historical reasons, are counted as errors by default
+				suite.Errors += 1
+			default:
+				suite.Failures += 1
 			}
-			suite.Errors += 1
 		}
 
-		suite.TestCases = append(suite.TestCases, test)
+		if included[i] {
+			suite.TestCases = append(suite.TestCases, test)
+		}
 	}
 
 	junitReport := JUnitTestSuites{
@@ -285,6 +334,17 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 		Time:       suite.Time,
 		TestSuites: []JUnitTestSuite{suite},
 	}
+	if len(config.Properties) > 0 {
+		names := []string{}
+		for name := range config.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		junitReport.Properties = &JUnitProperties{}
+		for _, name := range names {
+			junitReport.Properties.Properties = append(junitReport.Properties.Properties, JUnitProperty{name, config.Properties[name]})
+		}
+	}
 
 	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
 		return err
@@ -301,9 +361,29 @@ func GenerateJUnitReportWithConfig(report types.Report, dst string, config Junit
 	return f.Close()
 }
 
+// includedSpecReports returns, for each of report's SpecReports, whether the spec's labels (including the suite's labels) satisfy the labelFilter query.  Every spec is included when labelFilter is empty.
+func includedSpecReports(report types.Report, labelFilter string) ([]bool, error) {
+	included := make([]bool, len(report.SpecReports))
+	if labelFilter == "" {
+		for i := range included {
+			included[i] = true
+		}
+		return included, nil
+	}
+	filter, err := types.ParseLabelFilter(labelFilter)
+	if err != nil {
+		return nil, err
+	}
+	for i, spec := range report.SpecReports {
+		included[i] = filter(append(append([]string{}, report.SuiteLabels...), spec.Labels()...))
+	}
+	return included, nil
+}
+
 func MergeAndCleanupJUnitReports(sources []string, dst string) ([]string, error) {
 	messages := []string{}
 	mergedReport := JUnitTestSuites{}
+	mergedProperties := map[string]bool{}
 	for _, source := range sources {
 		report := JUnitTestSuites{}
 		f, err := os.Open(source)
@@ -324,6 +404,18 @@ func MergeAndCleanupJUnitReports(sources []string, dst string) ([]string, error)
 		mergedReport.Failures += report.Failures
 		mergedReport.Time += report.Time
 		mergedReport.TestSuites = append(mergedReport.TestSuites, report.TestSuites...)
+		if report.Properties != nil {
+			if mergedReport.Properties == nil {
+				mergedReport.Properties = &JUnitProperties{}
+			}
+			// global properties are typically the same across suites so only the first value for each name is kept
+			for _, property := range report.Properties.Properties {
+				if !mergedProperties[property.Name] {
+					mergedProperties[property.Name] = true
+					mergedReport.Properties.Properties = append(mergedReport.Properties.Properties, property)
+				}
+			}
+		}
 	}
 
 	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
@@ -341,17 +433,19 @@ func MergeAndCleanupJUnitReports(sources []string, dst string) ([]string, error)
 	return messages, f.Close()
 }
 
-func failureDescriptionForUnstructuredReporters(spec types.SpecReport) string {
+func failureDescriptionForUnstructuredReporters(spec types.SpecReport, codeLocationFormatter func(types.CodeLocation) string) string {
 	out := &strings.Builder{}
-	NewDefaultReporter(types.ReporterConfig{NoColor: true, VeryVerbose: true}, out).emitFailure(0, spec.State, spec.Failure, true)
+	NewDefaultReporter(types.ReporterConfig{NoColor: true, VeryVerbose: true, CodeLocationFormatter: codeLocationFormatter}, out).emitFailure(0, spec.State, spec.Failure, true)
 	if len(spec.AdditionalFailures) > 0 {
 		out.WriteString("\nThere were additional failures detected after the initial failure. These are visible in the timeline\n")
 	}
 	return out.String()
 }
 
-func systemErrForUnstructuredReporters(spec types.SpecReport) string {
-	return RenderTimeline(spec, true)
+func systemErrForUnstructuredReporters(spec types.SpecReport, codeLocationFormatter func(types.CodeLocation) string) string {
+	out := &strings.Builder{}
+	NewDefaultReporter(types.ReporterConfig{NoColor: true, VeryVerbose: true, CodeLocationFormatter: codeLocationFormatter}, out).emitTimeline(0, spec, spec.Timeline())
+	return out.String()
 }
 
 func RenderTimeline(spec types.SpecReport, noColor bool) string {
@@ -374,6 +374,251 @@ var _ = Describe("JunitReport", func() {
 		})
 	})
 
+	Describe("when configured with a CodeLocationFormatter", func() {
+		var generated reporters.JUnitTestSuites
+
+		BeforeEach(func() {
+			fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(report, fname, reporters.JunitReportConfig{
+				CodeLocationFormatter: func(cl types.CodeLocation) string { return "formatted:" + cl.String() },
+			})).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+
+			generated = reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+		})
+
+		It("uses the formatter when rendering failure descriptions and timelines", func() {
+			failingSpec := generated.TestSuites[0].TestCases[0]
+			Ω(failingSpec.Failure.Description).Should(ContainSubstring("at: formatted:cl3.go:103"))
+			Ω(failingSpec.SystemErr).Should(ContainSubstring("formatted:cl0.go:12"))
+		})
+	})
+
+	Describe("when configured with SpecStateOutcomes", func() {
+		generate := func(config reporters.JunitReportConfig) reporters.JUnitTestSuites {
+			interruptedReport := types.Report{
+				SuiteDescription: "My Suite",
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeIt, "interrupted", cl0, types.SpecStateInterrupted, F("interrupted by user", cl0)),
+					S(types.NodeTypeIt, "failed", cl1, types.SpecStateFailed, F("boom", cl1)),
+				},
+			}
+			fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(interruptedReport, fname, config)).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+
+			generated := reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+			return generated
+		}
+
+		It("emits interrupted specs as errors by default", func() {
+			generated := generate(reporters.JunitReportConfig{})
+			interrupted := generated.TestSuites[0].TestCases[0]
+			Ω(interrupted.Error.Type).Should(Equal("interrupted"))
+			Ω(interrupted.Error.Message).Should(Equal("interrupted by user"))
+			Ω(interrupted.Failure).Should(BeNil())
+			Ω(generated.Errors).Should(Equal(1))
+			Ω(generated.Failures).Should(Equal(1))
+		})
+
+		It("can emit interrupted specs as failures", func() {
+			generated := generate(reporters.JunitReportConfig{SpecStateOutcomes: map[types.SpecState]reporters.JUnitOutcome{
+				types.SpecStateInterrupted: reporters.JUnitOutcomeFailure,
+			}})
+			interrupted := generated.TestSuites[0].TestCases[0]
+			Ω(interrupted.Failure.Type).Should(Equal("interrupted"))
+			Ω(interrupted.Failure.Message).Should(Equal("interrupted by user"))
+			Ω(interrupted.Error).Should(BeNil())
+			Ω(generated.Errors).Should(Equal(0))
+			Ω(generated.Failures).Should(Equal(2))
+		})
+
+		It("can emit interrupted specs as skipped", func() {
+			generated := generate(reporters.JunitReportConfig{SpecStateOutcomes: map[types.SpecState]reporters.JUnitOutcome{
+				types.SpecStateInterrupted: reporters.JUnitOutcomeSkipped,
+			}})
+			interrupted := generated.TestSuites[0].TestCases[0]
+			Ω(interrupted.Skipped.Message).Should(Equal("interrupted - interrupted by user"))
+			Ω(interrupted.Error).Should(BeNil())
+			Ω(interrupted.Failure).Should(BeNil())
+			Ω(generated.Errors).Should(Equal(0))
+			Ω(generated.Failures).Should(Equal(1))
+			Ω(generated.Disabled).Should(Equal(1))
+			Ω(generated.TestSuites[0].Skipped).Should(Equal(1))
+		})
+
+		It("leaves states that are not overridden alone", func() {
+			generated := generate(reporters.JunitReportConfig{SpecStateOutcomes: map[types.SpecState]reporters.JUnitOutcome{
+				types.SpecStateInterrupted: reporters.JUnitOutcomeSkipped,
+				types.SpecStatePassed:      reporters.JUnitOutcomeFailure,
+			}})
+			failed := generated.TestSuites[0].TestCases[1]
+			Ω(failed.Failure.Type).Should(Equal("failed"))
+		})
+	})
+
+	Describe("when configured with an IncludeLabelFilter", func() {
+		var complianceReport types.Report
+		generate := func(config reporters.JunitReportConfig) reporters.JUnitTestSuites {
+			fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(complianceReport, fname, config)).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+
+			generated := reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+			return generated
+		}
+
+		BeforeEach(func() {
+			complianceReport = types.Report{
+				SuiteDescription: "My Suite",
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeIt, "audits logins", cl0, Label("compliance"), types.SpecStatePassed),
+					S(types.NodeTypeIt, "renders the page", cl1, types.SpecStateFailed, F("boom", cl1)),
+					S(types.NodeTypeIt, "retains records", cl2, CLabels(Label("compliance", "slow")), types.SpecStateFailed, F("missing records", cl2)),
+					S(types.NodeTypeIt, "exports data", cl3, Label("compliance"), types.SpecStatePending),
+					S(types.NodeTypeBeforeSuite, cl4, types.SpecStatePassed),
+				},
+			}
+		})
+
+		It("only includes specs that match the filter and counts only those specs", func() {
+			generated := generate(reporters.JunitReportConfig{IncludeLabelFilter: "compliance"})
+			names := []string{}
+			for _, testCase := range generated.TestSuites[0].TestCases {
+				names = append(names, testCase.Name)
+			}
+			Ω(names).Should(Equal([]string{"[It] audits logins [compliance]", "[It] retains records [compliance, slow]", "[It] exports data [compliance]"}))
+			Ω(generated.Tests).Should(Equal(3))
+			Ω(generated.Failures).Should(Equal(1))
+			Ω(generated.Disabled).Should(Equal(1))
+			Ω(generated.TestSuites[0].Tests).Should(Equal(3))
+		})
+
+		It("can count every spec in the run", func() {
+			generated := generate(reporters.JunitReportConfig{IncludeLabelFilter: "compliance", CountAllSpecs: true})
+			Ω(generated.TestSuites[0].TestCases).Should(HaveLen(3))
+			Ω(generated.Tests).Should(Equal(5))
+			Ω(generated.Failures).Should(Equal(2))
+			Ω(generated.Disabled).Should(Equal(1))
+		})
+
+		It("matches against the suite's labels", func() {
+			complianceReport.SuiteLabels = []string{"compliance"}
+			generated := generate(reporters.JunitReportConfig{IncludeLabelFilter: "compliance && !slow"})
+			Ω(generated.TestSuites[0].TestCases).Should(HaveLen(4))
+		})
+
+		It("returns an error when the filter is invalid", func() {
+			Ω(reporters.GenerateJUnitReportWithConfig(complianceReport, "./unused-report", reporters.JunitReportConfig{IncludeLabelFilter: "compliance &&"})).ShouldNot(Succeed())
+			Ω("./unused-report").ShouldNot(BeAnExistingFile())
+		})
+	})
+
+	Describe("when configured with a SuiteNameOverride and Properties", func() {
+		var fname string
+		BeforeEach(func() {
+			fname = fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(report, fname, reporters.JunitReportConfig{
+				SuiteNameOverride: "stable-suite-name",
+				Properties:        map[string]string{"build": "1234", "branch": "main"},
+			})).Should(Succeed())
+			DeferCleanup(os.RemoveAll, fname)
+		})
+
+		It("uses the overridden name for the testsuite and the classname of its testcases", func() {
+			generated := reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+			Ω(generated.TestSuites[0].Name).Should(Equal("stable-suite-name"))
+			for _, testCase := range generated.TestSuites[0].TestCases {
+				Ω(testCase.Classname).Should(Equal("stable-suite-name"))
+			}
+		})
+
+		It("emits the properties, sorted by name, directly within testsuites", func() {
+			content, err := os.ReadFile(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(MatchRegexp(`<testsuites [^>]*>\s*<properties>\s*<property name="branch" value="main"></property>\s*<property name="build" value="1234"></property>\s*</properties>\s*<testsuite name="stable-suite-name"`))
+		})
+
+		It("preserves the properties when merging reports", func() {
+			otherFname := fmt.Sprintf("./other-report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReportWithConfig(report, otherFname, reporters.JunitReportConfig{
+				Properties: map[string]string{"build": "5678", "commit": "abc"},
+			})).Should(Succeed())
+			mergedFname := fmt.Sprintf("./merged-report-%d", GinkgoParallelProcess())
+			DeferCleanup(os.Remove, mergedFname)
+
+			_, err := reporters.MergeAndCleanupJUnitReports([]string{fname, otherFname}, mergedFname)
+			Ω(err).ShouldNot(HaveOccurred())
+			merged := reporters.JUnitTestSuites{}
+			f, err := os.Open(mergedFname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&merged)).Should(Succeed())
+			Ω(merged.Properties.Properties).Should(Equal([]reporters.JUnitProperty{
+				{Name: "branch", Value: "main"},
+				{Name: "build", Value: "1234"},
+				{Name: "commit", Value: "abc"},
+			}))
+		})
+	})
+
+	Describe("when report entries are targeted at JUnit properties", func() {
+		var fname string
+		BeforeEach(func() {
+			tl := TL("some GinkgoWriter\noutput is interspersed\nhere and there\n")
+			report.SpecReports[1].ReportEntries = append(report.SpecReports[1].ReportEntries,
+				RE("latency", cl1, 12.5, types.ReportEntryTargetJUnitProperty, tl),
+				RE("not a property", cl1, 17, tl),
+				RE("endpoint", cl1, "/api/books", types.ReportEntryTargetJUnitProperty, types.ReportEntryVisibilityNever, tl),
+			)
+			fname = fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateJUnitReport(report, fname)).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+		})
+
+		It("emits the targeted entries as properties of the spec's testcase", func() {
+			generated := reporters.JUnitTestSuites{}
+			f, err := os.Open(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(xml.NewDecoder(f).Decode(&generated)).Should(Succeed())
+
+			testCases := generated.TestSuites[0].TestCases
+			Ω(testCases[1].Properties.Properties).Should(Equal([]reporters.JUnitProperty{
+				{Name: "latency", Value: "12.5"},
+				{Name: "endpoint", Value: "/api/books"},
+			}))
+			for i, testCase := range testCases {
+				if i != 1 {
+					Ω(testCase.Properties).Should(BeNil())
+				}
+			}
+
+			content, err := os.ReadFile(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(MatchRegexp(`<testcase name="\[It\] A" [^>]*>\s*<properties>\s*<property name="latency" value="12.5"></property>\s*<property name="endpoint" value="/api/books"></property>\s*</properties>`))
+		})
+	})
+
+	It("does not emit top-level properties by default", func() {
+		fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+		Ω(reporters.GenerateJUnitReport(report, fname)).Should(Succeed())
+		DeferCleanup(os.Remove, fname)
+		content, err := os.ReadFile(fname)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(content)).Should(MatchRegexp(`<testsuites [^>]*>\s*<testsuite `))
+	})
+
 	Describe("when configured to write the report inside a folder", func() {
 		var folderPath string
 		var filePath string
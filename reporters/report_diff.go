@@ -0,0 +1,92 @@
+package reporters
+
+import (
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// ReportDiffConfig configures DiffReports
+type ReportDiffConfig struct {
+	// TimingThreshold is the minimum absolute change in a spec's RunTime for the spec to appear in ReportDiff.TimingChanged
+	TimingThreshold time.Duration
+	// TimingThresholdFraction is the minimum change in a spec's RunTime, as a fraction of its old RunTime, for the spec to appear in ReportDiff.TimingChanged (e.g. 0.5 for a 50% change)
+	TimingThresholdFraction float64
+}
+
+// timingChanged returns true if the change in RunTime exceeds every configured threshold.  Timing changes are never reported if no threshold is configured.
+func (config ReportDiffConfig) timingChanged(oldRunTime time.Duration, newRunTime time.Duration) bool {
+	if config.TimingThreshold <= 0 && config.TimingThresholdFraction <= 0 {
+		return false
+	}
+	change := newRunTime - oldRunTime
+	if change < 0 {
+		change = -change
+	}
+	if config.TimingThreshold > 0 && change < config.TimingThreshold {
+		return false
+	}
+	if config.TimingThresholdFraction > 0 && (oldRunTime == 0 || float64(change)/float64(oldRunTime) < config.TimingThresholdFraction) {
+		return false
+	}
+	return change > 0
+}
+
+/*
+DiffReports compares the It specs in old and new and sorts the specs that changed into the categories captured by types.ReportDiff.
+
+Specs are matched across the two reports by their full text.  Only specs that ran in both reports (i.e. passed or failed) are considered for TimingChanged.
+*/
+func DiffReports(old types.Report, new types.Report, config ReportDiffConfig) types.ReportDiff {
+	diff := types.ReportDiff{SuiteDescription: new.SuiteDescription}
+	if diff.SuiteDescription == "" {
+		diff.SuiteDescription = old.SuiteDescription
+	}
+
+	oldSpecs := map[string]types.SpecReport{}
+	for _, report := range old.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+		oldSpecs[report.FullText()] = report
+	}
+	newSpecs := map[string]bool{}
+
+	for _, newReport := range new.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+		fullText := newReport.FullText()
+		newSpecs[fullText] = true
+		specDiff := types.SpecDiff{
+			FullText:         fullText,
+			LeafNodeLocation: newReport.LeafNodeLocation,
+			NewState:         newReport.State,
+			NewRunTime:       newReport.RunTime,
+		}
+		oldReport, ok := oldSpecs[fullText]
+		if !ok {
+			diff.Added = append(diff.Added, specDiff)
+			continue
+		}
+		specDiff.OldState, specDiff.OldRunTime = oldReport.State, oldReport.RunTime
+
+		if newReport.Failed() && !oldReport.Failed() {
+			diff.NewlyFailing = append(diff.NewlyFailing, specDiff)
+		} else if newReport.State.Is(types.SpecStatePassed) && oldReport.Failed() {
+			diff.NewlyPassing = append(diff.NewlyPassing, specDiff)
+		}
+
+		ran := types.SpecStatePassed | types.SpecStateFailureStates
+		if newReport.State.Is(ran) && oldReport.State.Is(ran) && config.timingChanged(oldReport.RunTime, newReport.RunTime) {
+			diff.TimingChanged = append(diff.TimingChanged, specDiff)
+		}
+	}
+
+	for _, oldReport := range old.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+		if !newSpecs[oldReport.FullText()] {
+			diff.Removed = append(diff.Removed, types.SpecDiff{
+				FullText:         oldReport.FullText(),
+				LeafNodeLocation: oldReport.LeafNodeLocation,
+				OldState:         oldReport.State,
+				OldRunTime:       oldReport.RunTime,
+			})
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,105 @@
+package reporters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("DiffReports", func() {
+	var old, new types.Report
+
+	fullTexts := func(specDiffs []types.SpecDiff) []string {
+		texts := []string{}
+		for _, specDiff := range specDiffs {
+			texts = append(texts, specDiff.FullText)
+		}
+		return texts
+	}
+
+	BeforeEach(func() {
+		old = types.Report{
+			SuiteDescription: "My Suite",
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeBeforeSuite, types.SpecStatePassed),
+				S([]string{"cart"}, "stays green", cl0, types.SpecStatePassed, time.Second),
+				S([]string{"cart"}, "breaks", cl0, types.SpecStatePassed, time.Second),
+				S([]string{"cart"}, "gets fixed", cl0, types.SpecStateFailed, time.Second),
+				S([]string{"cart"}, "stays red", cl0, types.SpecStateFailed, time.Second),
+				S([]string{"cart"}, "gets slower", cl0, types.SpecStatePassed, time.Second),
+				S([]string{"cart"}, "gets faster", cl0, types.SpecStatePassed, 4*time.Second),
+				S([]string{"cart"}, "stops being skipped", cl0, types.SpecStateSkipped, time.Duration(0)),
+				S([]string{"cart"}, "is deleted", cl1, types.SpecStatePassed, time.Second),
+			},
+		}
+		new = types.Report{
+			SuiteDescription: "My Suite",
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeBeforeSuite, types.SpecStateFailed),
+				S([]string{"cart"}, "stays green", cl0, types.SpecStatePassed, 1100*time.Millisecond),
+				S([]string{"cart"}, "breaks", cl0, types.SpecStatePanicked, time.Second),
+				S([]string{"cart"}, "gets fixed", cl0, types.SpecStatePassed, time.Second),
+				S([]string{"cart"}, "stays red", cl0, types.SpecStateFailed, time.Second),
+				S([]string{"cart"}, "gets slower", cl0, types.SpecStatePassed, 3*time.Second),
+				S([]string{"cart"}, "gets faster", cl0, types.SpecStatePassed, time.Second),
+				S([]string{"cart"}, "stops being skipped", cl0, types.SpecStatePassed, 5*time.Second),
+				S([]string{"cart"}, "is new", cl2, types.SpecStateFailed, time.Second),
+			},
+		}
+	})
+
+	It("categorizes the specs that changed, matching them by full text", func() {
+		diff := reporters.DiffReports(old, new, reporters.ReportDiffConfig{TimingThreshold: time.Second})
+		Ω(diff.SuiteDescription).Should(Equal("My Suite"))
+		Ω(diff.HasChanges()).Should(BeTrue())
+		Ω(fullTexts(diff.NewlyFailing)).Should(Equal([]string{"cart breaks"}))
+		Ω(fullTexts(diff.NewlyPassing)).Should(Equal([]string{"cart gets fixed"}))
+		Ω(fullTexts(diff.Added)).Should(Equal([]string{"cart is new"}))
+		Ω(fullTexts(diff.Removed)).Should(Equal([]string{"cart is deleted"}))
+		Ω(fullTexts(diff.TimingChanged)).Should(Equal([]string{"cart gets slower", "cart gets faster"}))
+	})
+
+	It("records the old and new states and run times", func() {
+		diff := reporters.DiffReports(old, new, reporters.ReportDiffConfig{TimingThreshold: time.Second})
+		Ω(diff.NewlyFailing[0]).Should(Equal(types.SpecDiff{
+			FullText:         "cart breaks",
+			LeafNodeLocation: cl0,
+			OldState:         types.SpecStatePassed,
+			NewState:         types.SpecStatePanicked,
+			OldRunTime:       time.Second,
+			NewRunTime:       time.Second,
+		}))
+		Ω(diff.Added[0].OldState).Should(Equal(types.SpecStateInvalid))
+		Ω(diff.Added[0].LeafNodeLocation).Should(Equal(cl2))
+		Ω(diff.Removed[0].NewState).Should(Equal(types.SpecStateInvalid))
+		Ω(diff.Removed[0].LeafNodeLocation).Should(Equal(cl1))
+		Ω(diff.TimingChanged[0].RunTimeChange()).Should(Equal(2 * time.Second))
+		Ω(diff.TimingChanged[1].RunTimeChange()).Should(Equal(-3 * time.Second))
+	})
+
+	Describe("timing thresholds", func() {
+		It("does not report timing changes when no threshold is set", func() {
+			diff := reporters.DiffReports(old, new, reporters.ReportDiffConfig{})
+			Ω(diff.TimingChanged).Should(BeEmpty())
+		})
+
+		It("supports a threshold relative to the old run time", func() {
+			diff := reporters.DiffReports(old, new, reporters.ReportDiffConfig{TimingThresholdFraction: 0.1})
+			Ω(fullTexts(diff.TimingChanged)).Should(Equal([]string{"cart stays green", "cart gets slower", "cart gets faster"}))
+		})
+
+		It("requires changes to exceed both thresholds when both are set", func() {
+			diff := reporters.DiffReports(old, new, reporters.ReportDiffConfig{TimingThreshold: 2 * time.Second, TimingThresholdFraction: 1.0})
+			Ω(fullTexts(diff.TimingChanged)).Should(Equal([]string{"cart gets slower"}))
+		})
+	})
+
+	It("reports no changes when the reports match", func() {
+		diff := reporters.DiffReports(old, old, reporters.ReportDiffConfig{TimingThreshold: time.Millisecond})
+		Ω(diff.HasChanges()).Should(BeFalse())
+	})
+})
@@ -17,6 +17,42 @@ type Reporter interface {
 	EmitSpecEvent(event types.SpecEvent)
 }
 
+/*
+AttemptReporter is an optional interface that Reporters can implement to be notified of each failed attempt of a spec that Ginkgo retries (i.e. a spec
+decorated with FlakeAttempts or run with --flake-attempts).
+
+DidRunAttempt is called after each failed attempt that is followed by another attempt and before the retry begins.  The SpecReport describes the
+state of the spec at the end of that attempt: NumAttempts is the attempt that just ran and State and Failure describe how it failed.  The final attempt
+is reported via DidRun, as usual.
+*/
+type AttemptReporter interface {
+	DidRunAttempt(report types.SpecReport)
+}
+
+/*
+SpecOrderReporter is an optional interface that Reporters can implement to be notified of the order in which Ginkgo will run the suite's specs - for example, to build a model of the
+suite's progress before any spec runs.
+
+SpecsWillRun is called after SuiteWillBegin (and after the BeforeSuite, if any) and before the first spec runs.  specs contains a SpecReport for each spec that will run - i.e. excluding pending
+specs and specs that have been filtered out - in the order Ginkgo will run them, after randomization and any SuiteConfig.FinalOrderHook have been applied.  Specs that Ginkgo only decides to skip
+while the suite runs (e.g. because a preceding spec in an Ordered container failed) are included.
+
+When running in parallel each process receives the complete list but only runs some of the specs in it.  SpecsWillRun is not called if the suite fails before its specs are ordered (e.g. because the BeforeSuite failed).
+*/
+type SpecOrderReporter interface {
+	SpecsWillRun(specs []types.SpecReport)
+}
+
+/*
+AggregatedReportReporter is an optional interface that Reporters attached to a suite via ReporterSet.Reporters can implement to receive the suite's report aggregated across all parallel processes.
+
+SuiteDidEndAcrossProcesses is called once, on parallel process #1, with the same report that is passed to ReportAfterSuite nodes.  It is also called when running in series.
+Reporters that write a single output describing the whole suite should write it from SuiteDidEndAcrossProcesses when running in parallel as SuiteDidEnd only receives the specs that ran on the reporter's process.
+*/
+type AggregatedReportReporter interface {
+	SuiteDidEndAcrossProcesses(report types.Report)
+}
+
 type NoopReporter struct{}
 
 func (n NoopReporter) SuiteWillBegin(report types.Report)                       {}
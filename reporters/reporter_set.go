@@ -0,0 +1,177 @@
+package reporters
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+ReporterSet declaratively describes a set of reporters.  You can pass a ReporterSet to RunSpecs and Ginkgo will
+generate the requested machine-readable reports at the end of the suite and forward spec events to any custom Reporters.
+
+	RunSpecs(t, "My Suite", reporters.ReporterSet{
+		JUnitReport: "report.xml",
+		JUnitReportConfig: reporters.JunitReportConfig{OmitLeafNodeType: true},
+		JSONReport: "report.json",
+		Reporters: []reporters.Reporter{myCustomReporter},
+	})
+
+Reports are generated by an autogenerated ReportAfterSuite node and so are aggregated across all parallel processes.  Custom Reporters, however,
+are attached to each process and only receive events for the specs that run on that process.
+*/
+type ReporterSet struct {
+	// JSONReport, JUnitReport, and TeamcityReport behave like the --json-report, --junit-report, and --teamcity-report flags.
+	// If set, Ginkgo will generate a report of the corresponding format at the specified location.
+	JSONReport     string
+	JUnitReport    string
+	TeamcityReport string
+
+	// JUnitReportConfig is used to configure the JUnit report generated at JUnitReport
+	JUnitReportConfig JunitReportConfig
+
+	// TeamcityReportConfig is used to configure the Teamcity report generated at TeamcityReport
+	TeamcityReportConfig TeamcityReportConfig
+
+	// Reporters is a list of custom Reporters that will receive spec events alongside Ginkgo's default reporter
+	Reporters []Reporter
+}
+
+// WillGenerateReport returns true if the ReporterSet will generate any machine-readable reports
+func (rs ReporterSet) WillGenerateReport() bool {
+	return rs.JSONReport != "" || rs.JUnitReport != "" || rs.TeamcityReport != ""
+}
+
+// AggregatedReportReporters returns the Reporters in the ReporterSet that implement AggregatedReportReporter
+func (rs ReporterSet) AggregatedReportReporters() []AggregatedReportReporter {
+	out := []AggregatedReportReporter{}
+	for _, reporter := range rs.Reporters {
+		if aggregatedReportReporter, ok := reporter.(AggregatedReportReporter); ok {
+			out = append(out, aggregatedReportReporter)
+		}
+	}
+	return out
+}
+
+// GenerateReports generates each of the machine-readable reports requested by the ReporterSet and returns any errors encountered along the way
+func (rs ReporterSet) GenerateReports(report types.Report) []error {
+	errors := []error{}
+	if rs.JSONReport != "" {
+		if err := GenerateJSONReport(report, rs.JSONReport); err != nil {
+			errors = append(errors, fmt.Errorf("Failed to generate JSON report:\n%w", err))
+		}
+	}
+	if rs.JUnitReport != "" {
+		if err := GenerateJUnitReportWithConfig(report, rs.JUnitReport, rs.JUnitReportConfig); err != nil {
+			errors = append(errors, fmt.Errorf("Failed to generate JUnit report:\n%w", err))
+		}
+	}
+	if rs.TeamcityReport != "" {
+		if err := GenerateTeamcityReportWithConfig(report, rs.TeamcityReport, rs.TeamcityReportConfig); err != nil {
+			errors = append(errors, fmt.Errorf("Failed to generate Teamcity report:\n%w", err))
+		}
+	}
+	return errors
+}
+
+/*
+VetReporterSets validates the passed-in ReporterSets.  It returns an error for every output path that is used by more than one report
+- either within a single ReporterSet, across multiple ReporterSets, or between a ReporterSet and the reports configured in reporterConfig.
+*/
+func VetReporterSets(reporterConfig types.ReporterConfig, sets ...ReporterSet) []error {
+	errors := []error{}
+	seen := map[string]bool{}
+	track := func(path string) {
+		if path == "" {
+			return
+		}
+		path = filepath.Clean(path)
+		if seen[path] {
+			errors = append(errors, types.GinkgoErrors.DuplicateReportOutputPath(path))
+		}
+		seen[path] = true
+	}
+	track(reporterConfig.JSONReport)
+	track(reporterConfig.JUnitReport)
+	track(reporterConfig.TeamcityReport)
+	for _, set := range sets {
+		track(set.JSONReport)
+		track(set.JUnitReport)
+		track(set.TeamcityReport)
+	}
+	return errors
+}
+
+// CompositeReporter forwards every event it receives to each of its Reporters, in order
+type CompositeReporter []Reporter
+
+func NewCompositeReporter(reporters ...Reporter) CompositeReporter {
+	return CompositeReporter(reporters)
+}
+
+func (c CompositeReporter) SuiteWillBegin(report types.Report) {
+	for _, reporter := range c {
+		reporter.SuiteWillBegin(report)
+	}
+}
+
+func (c CompositeReporter) WillRun(report types.SpecReport) {
+	for _, reporter := range c {
+		reporter.WillRun(report)
+	}
+}
+
+func (c CompositeReporter) DidRun(report types.SpecReport) {
+	for _, reporter := range c {
+		reporter.DidRun(report)
+	}
+}
+
+// DidRunAttempt forwards the attempt to each Reporter that implements AttemptReporter
+func (c CompositeReporter) DidRunAttempt(report types.SpecReport) {
+	for _, reporter := range c {
+		if attemptReporter, ok := reporter.(AttemptReporter); ok {
+			attemptReporter.DidRunAttempt(report)
+		}
+	}
+}
+
+// SpecsWillRun forwards the spec order to each Reporter that implements SpecOrderReporter
+func (c CompositeReporter) SpecsWillRun(specs []types.SpecReport) {
+	for _, reporter := range c {
+		if orderReporter, ok := reporter.(SpecOrderReporter); ok {
+			orderReporter.SpecsWillRun(specs)
+		}
+	}
+}
+
+func (c CompositeReporter) SuiteDidEnd(report types.Report) {
+	for _, reporter := range c {
+		reporter.SuiteDidEnd(report)
+	}
+}
+
+func (c CompositeReporter) EmitFailure(state types.SpecState, failure types.Failure) {
+	for _, reporter := range c {
+		reporter.EmitFailure(state, failure)
+	}
+}
+
+func (c CompositeReporter) EmitProgressReport(progressReport types.ProgressReport) {
+	for _, reporter := range c {
+		reporter.EmitProgressReport(progressReport)
+	}
+}
+
+func (c CompositeReporter) EmitReportEntry(entry types.ReportEntry) {
+	for _, reporter := range c {
+		reporter.EmitReportEntry(entry)
+	}
+}
+
+func (c CompositeReporter) EmitSpecEvent(event types.SpecEvent) {
+	for _, reporter := range c {
+		reporter.EmitSpecEvent(event)
+	}
+}
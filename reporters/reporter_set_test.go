@@ -0,0 +1,70 @@
+package reporters_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/internal/test_helpers"
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("ReporterSet", func() {
+	Describe("VetReporterSets", func() {
+		It("succeeds when every report has its own output path", func() {
+			errors := reporters.VetReporterSets(
+				types.ReporterConfig{JSONReport: "cli.json"},
+				reporters.ReporterSet{JSONReport: "out.json", JUnitReport: "out.xml"},
+				reporters.ReporterSet{TeamcityReport: "out.teamcity"},
+			)
+			Ω(errors).Should(BeEmpty())
+		})
+
+		It("catches duplicate output paths within and across sets and the reporter config", func() {
+			errors := reporters.VetReporterSets(
+				types.ReporterConfig{JUnitReport: "out.xml"},
+				reporters.ReporterSet{JSONReport: "out.json", JUnitReport: "./out.xml"},
+				reporters.ReporterSet{TeamcityReport: "out.json"},
+			)
+			Ω(errors).Should(ConsistOf(
+				types.GinkgoErrors.DuplicateReportOutputPath("out.xml"),
+				types.GinkgoErrors.DuplicateReportOutputPath("out.json"),
+			))
+		})
+	})
+
+	Describe("CompositeReporter", func() {
+		It("forwards events to each reporter", func() {
+			a, b := test_helpers.NewFakeReporter(), test_helpers.NewFakeReporter()
+			composite := reporters.NewCompositeReporter(a, b)
+
+			composite.SuiteWillBegin(types.Report{SuiteDescription: "suite"})
+			composite.WillRun(S("A"))
+			composite.DidRun(S("A"))
+			composite.SuiteDidEnd(types.Report{SuiteDescription: "suite"})
+
+			for _, reporter := range []*test_helpers.FakeReporter{a, b} {
+				Ω(reporter.Begin.SuiteDescription).Should(Equal("suite"))
+				Ω(reporter.Will.Names()).Should(Equal([]string{"A"}))
+				Ω(reporter.Did.Names()).Should(Equal([]string{"A"}))
+				Ω(reporter.End.SuiteDescription).Should(Equal("suite"))
+			}
+		})
+
+		It("forwards attempts to each reporter that implements AttemptReporter", func() {
+			a := test_helpers.NewFakeReporter()
+			composite := reporters.NewCompositeReporter(a, reporters.NoopReporter{})
+
+			composite.DidRunAttempt(S("A"))
+			Ω(a.Attempts.Names()).Should(Equal([]string{"A"}))
+		})
+
+		It("forwards the spec order to each reporter that implements SpecOrderReporter", func() {
+			a := test_helpers.NewFakeReporter()
+			composite := reporters.NewCompositeReporter(a, reporters.NoopReporter{})
+
+			composite.SpecsWillRun([]types.SpecReport{S("B"), S("A")})
+			Ω(a.Planned.Names()).Should(Equal([]string{"B", "A"}))
+		})
+	})
+})
@@ -0,0 +1,201 @@
+/*
+Slack Reporter for Ginkgo
+
+Posts a summary of the suite to a Slack incoming webhook using Slack's Block Kit message format
+https://api.slack.com/messaging/webhooks
+*/
+
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// DEFAULT_MAX_FAILED_SPECS is the number of failed specs a SlackReporter lists by default
+const DEFAULT_MAX_FAILED_SPECS = 10
+
+// DEFAULT_TIMEOUT bounds how long a SlackReporter waits for the webhook to respond by default
+const DEFAULT_TIMEOUT = 10 * time.Second
+
+// Option configures a SlackReporter
+type Option func(*SlackReporter)
+
+// MaxFailedSpecs sets the maximum number of failed specs listed in the posted message.  Any additional failures are summarized as "...and N more".
+func MaxFailedSpecs(n int) Option {
+	return func(r *SlackReporter) {
+		r.maxFailedSpecs = n
+	}
+}
+
+// PostOnSuccess configures the SlackReporter to post a summary when the suite passes, too.  By default a summary is only posted when the suite fails.
+func PostOnSuccess() Option {
+	return func(r *SlackReporter) {
+		r.postOnSuccess = true
+	}
+}
+
+// HTTPClient sets the client used to post to the webhook.  The default client times out after DEFAULT_TIMEOUT.
+func HTTPClient(client *http.Client) Option {
+	return func(r *SlackReporter) {
+		r.client = client
+	}
+}
+
+/*
+SlackReporter is a Reporter that posts a summary of the suite to a Slack incoming webhook when the suite ends.  The summary includes the suite's
+spec counts and lists the text and location of up to MaxFailedSpecs failed specs.
+
+Posting is best-effort: a SlackReporter never fails the suite or changes its exit code.  Any error encountered while posting is available via Err().
+
+Since a SlackReporter is a Reporter it only sees the specs that are reported to it.  When attached to a suite via ReporterSet.Reporters it runs in
+each parallel process and so only sees the specs that run on that process.  To post a single summary for the whole suite call SuiteDidEnd from a ReportAfterSuite node instead:
+
+	var _ = ReportAfterSuite("slack summary", func(report Report) {
+		slack.NewSlackReporter(os.Getenv("SLACK_WEBHOOK_URL")).SuiteDidEnd(report)
+	})
+*/
+type SlackReporter struct {
+	reporters.NoopReporter
+	webhookURL     string
+	maxFailedSpecs int
+	postOnSuccess  bool
+	client         *http.Client
+	err            error
+}
+
+// NewSlackReporter returns a SlackReporter that posts to webhookURL
+func NewSlackReporter(webhookURL string, opts ...Option) *SlackReporter {
+	r := &SlackReporter{
+		webhookURL:     webhookURL,
+		maxFailedSpecs: DEFAULT_MAX_FAILED_SPECS,
+		client:         &http.Client{Timeout: DEFAULT_TIMEOUT},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *SlackReporter) SuiteDidEnd(report types.Report) {
+	if report.SuiteSucceeded && !r.postOnSuccess {
+		return
+	}
+	r.err = r.PostSummary(report)
+}
+
+// Err returns the error, if any, encountered the last time the SlackReporter posted to the webhook
+func (r *SlackReporter) Err() error {
+	return r.err
+}
+
+// PostSummary posts a summary of report to the webhook regardless of whether or not the suite succeeded
+func (r *SlackReporter) PostSummary(report types.Report) error {
+	payload, err := json.Marshal(r.Message(report))
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Post(r.webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to post Slack summary: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to post Slack summary: webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Message is the Slack message posted by a SlackReporter
+type Message struct {
+	// Text is the fallback text Slack displays in notifications
+	Text   string  `json:"text"`
+	Blocks []Block `json:"blocks"`
+}
+
+// Block is a Slack Block Kit layout block.  Header and section blocks use Text, context blocks use Elements.
+type Block struct {
+	Type     string  `json:"type"`
+	Text     *Text   `json:"text,omitempty"`
+	Elements []*Text `json:"elements,omitempty"`
+}
+
+// Text is a Slack Block Kit text object
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Message returns the Slack message a SlackReporter posts for report
+func (r *SlackReporter) Message(report types.Report) Message {
+	status := "passed"
+	if !report.SuiteSucceeded {
+		status = "failed"
+	}
+	title := fmt.Sprintf("%s %s", report.SuiteDescription, status)
+
+	specs := report.SpecReports.WithLeafNodeType(types.NodeTypeIt)
+	counts := fmt.Sprintf("*Passed:* %d | *Failed:* %d | *Pending:* %d | *Skipped:* %d | *Run Time:* %s",
+		specs.CountWithState(types.SpecStatePassed),
+		specs.CountWithState(types.SpecStateFailureStates),
+		specs.CountWithState(types.SpecStatePending),
+		specs.CountWithState(types.SpecStateSkipped),
+		report.RunTime.Round(time.Millisecond),
+	)
+
+	message := Message{
+		Text: title,
+		Blocks: []Block{
+			{Type: "header", Text: &Text{Type: "plain_text", Text: title}},
+			{Type: "section", Text: &Text{Type: "mrkdwn", Text: counts}},
+		},
+	}
+
+	if len(report.SpecialSuiteFailureReasons) > 0 {
+		reasons := &strings.Builder{}
+		for _, reason := range report.SpecialSuiteFailureReasons {
+			fmt.Fprintf(reasons, "\n• %s", escape(reason))
+		}
+		message.Blocks = append(message.Blocks, Block{Type: "section", Text: &Text{Type: "mrkdwn", Text: "*Suite failure reasons:*" + reasons.String()}})
+	}
+
+	failures := report.SpecReports.WithState(types.SpecStateFailureStates)
+	if len(failures) == 0 {
+		return message
+	}
+	listed := failures
+	if r.maxFailedSpecs >= 0 && len(listed) > r.maxFailedSpecs {
+		listed = listed[:r.maxFailedSpecs]
+	}
+	if len(listed) > 0 {
+		list := &strings.Builder{}
+		list.WriteString("*Failed specs:*")
+		for _, spec := range listed {
+			text := spec.FullText()
+			if text == "" {
+				text = fmt.Sprintf("[%s]", spec.LeafNodeType)
+			}
+			fmt.Fprintf(list, "\n• %s - `%s`", escape(text), escape(spec.LeafNodeLocation.String()))
+		}
+		message.Blocks = append(message.Blocks, Block{Type: "section", Text: &Text{Type: "mrkdwn", Text: list.String()}})
+	}
+	if remaining := len(failures) - len(listed); remaining > 0 {
+		message.Blocks = append(message.Blocks, Block{Type: "context", Elements: []*Text{{Type: "mrkdwn", Text: fmt.Sprintf("...and %d more", remaining)}}})
+	}
+	return message
+}
+
+// escape escapes the characters Slack treats as control characters in mrkdwn text
+func escape(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, ">", "&gt;")
+	return s
+}
@@ -0,0 +1,135 @@
+package slack_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters/slack"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SlackReporter", func() {
+	var server *httptest.Server
+	var status int
+	var posts []slack.Message
+	var contentTypes []string
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		posts, contentTypes = []slack.Message{}, []string{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, err := io.ReadAll(req.Body)
+			Ω(err).ShouldNot(HaveOccurred())
+			var message slack.Message
+			Ω(json.Unmarshal(body, &message)).Should(Succeed())
+			posts = append(posts, message)
+			contentTypes = append(contentTypes, req.Header.Get("Content-Type"))
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	spec := func(texts []string, state types.SpecState, line int) types.SpecReport {
+		return types.SpecReport{
+			ContainerHierarchyTexts: texts[:len(texts)-1],
+			LeafNodeText:            texts[len(texts)-1],
+			LeafNodeType:            types.NodeTypeIt,
+			LeafNodeLocation:        types.CodeLocation{FileName: "/path/to/suite_test.go", LineNumber: line},
+			State:                   state,
+		}
+	}
+
+	failingReport := types.Report{
+		SuiteDescription: "My Suite",
+		SuiteSucceeded:   false,
+		RunTime:          1500 * time.Millisecond,
+		SpecReports: types.SpecReports{
+			spec([]string{"Cart", "adds items"}, types.SpecStatePassed, 10),
+			spec([]string{"Cart", "removes <items>"}, types.SpecStateFailed, 20),
+			spec([]string{"Checkout", "charges the card"}, types.SpecStatePanicked, 30),
+			spec([]string{"Checkout", "is pending"}, types.SpecStatePending, 40),
+			spec([]string{"Checkout", "is skipped"}, types.SpecStateSkipped, 50),
+			{LeafNodeType: types.NodeTypeAfterSuite, LeafNodeLocation: types.CodeLocation{FileName: "/path/to/suite_test.go", LineNumber: 60}, State: types.SpecStateFailed},
+		},
+	}
+
+	Context("when the suite fails", func() {
+		It("posts a Slack message summarizing the counts and listing the failed specs", func() {
+			reporter := slack.NewSlackReporter(server.URL)
+			reporter.SuiteDidEnd(failingReport)
+			Ω(reporter.Err()).ShouldNot(HaveOccurred())
+
+			Ω(contentTypes).Should(Equal([]string{"application/json"}))
+			Ω(posts).Should(Equal([]slack.Message{{
+				Text: "My Suite failed",
+				Blocks: []slack.Block{
+					{Type: "header", Text: &slack.Text{Type: "plain_text", Text: "My Suite failed"}},
+					{Type: "section", Text: &slack.Text{Type: "mrkdwn", Text: "*Passed:* 1 | *Failed:* 2 | *Pending:* 1 | *Skipped:* 1 | *Run Time:* 1.5s"}},
+					{Type: "section", Text: &slack.Text{Type: "mrkdwn", Text: "*Failed specs:*" +
+						"\n• Cart removes &lt;items&gt; - `/path/to/suite_test.go:20`" +
+						"\n• Checkout charges the card - `/path/to/suite_test.go:30`" +
+						"\n• [AfterSuite] - `/path/to/suite_test.go:60`"}},
+				},
+			}}))
+		})
+
+		It("lists at most MaxFailedSpecs failed specs", func() {
+			reporter := slack.NewSlackReporter(server.URL, slack.MaxFailedSpecs(1))
+			reporter.SuiteDidEnd(failingReport)
+			Ω(posts).Should(HaveLen(1))
+			blocks := posts[0].Blocks
+			Ω(blocks).Should(HaveLen(4))
+			Ω(blocks[2].Text.Text).Should(Equal("*Failed specs:*\n• Cart removes &lt;items&gt; - `/path/to/suite_test.go:20`"))
+			Ω(blocks[3]).Should(Equal(slack.Block{Type: "context", Elements: []*slack.Text{{Type: "mrkdwn", Text: "...and 2 more"}}}))
+		})
+
+		It("includes any special suite failure reasons", func() {
+			report := failingReport
+			report.SpecReports = nil
+			report.SpecialSuiteFailureReasons = []string{"Interrupted by User"}
+			slack.NewSlackReporter(server.URL).SuiteDidEnd(report)
+			Ω(posts).Should(HaveLen(1))
+			Ω(posts[0].Blocks).Should(HaveLen(3))
+			Ω(posts[0].Blocks[2].Text.Text).Should(Equal("*Suite failure reasons:*\n• Interrupted by User"))
+		})
+
+		It("records, but does not panic on, webhook errors", func() {
+			status = http.StatusInternalServerError
+			reporter := slack.NewSlackReporter(server.URL)
+			Ω(func() { reporter.SuiteDidEnd(failingReport) }).ShouldNot(Panic())
+			Ω(reporter.Err()).Should(MatchError(ContainSubstring("500 Internal Server Error")))
+
+			reporter = slack.NewSlackReporter("http://127.0.0.1:0/nope", slack.HTTPClient(&http.Client{Timeout: time.Second}))
+			Ω(func() { reporter.SuiteDidEnd(failingReport) }).ShouldNot(Panic())
+			Ω(reporter.Err()).Should(MatchError(ContainSubstring("failed to post Slack summary")))
+		})
+	})
+
+	Context("when the suite passes", func() {
+		passingReport := types.Report{
+			SuiteDescription: "My Suite",
+			SuiteSucceeded:   true,
+			SpecReports:      types.SpecReports{spec([]string{"Cart", "adds items"}, types.SpecStatePassed, 10)},
+		}
+
+		It("does not post by default", func() {
+			reporter := slack.NewSlackReporter(server.URL)
+			reporter.SuiteDidEnd(passingReport)
+			Ω(posts).Should(BeEmpty())
+			Ω(reporter.Err()).ShouldNot(HaveOccurred())
+		})
+
+		It("posts when configured with PostOnSuccess", func() {
+			slack.NewSlackReporter(server.URL, slack.PostOnSuccess()).SuiteDidEnd(passingReport)
+			Ω(posts).Should(HaveLen(1))
+			Ω(posts[0].Text).Should(Equal("My Suite passed"))
+			Ω(posts[0].Blocks).Should(HaveLen(2))
+		})
+	})
+})
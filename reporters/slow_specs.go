@@ -0,0 +1,35 @@
+package reporters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+ListSlowSpecs returns the It specs in reports whose RunTime exceeds threshold, sorted from slowest to fastest.
+
+Specs with the same RunTime retain the order in which they appear in reports.
+*/
+func ListSlowSpecs(reports []types.Report, threshold time.Duration) []types.SlowSpec {
+	slowSpecs := []types.SlowSpec{}
+	for _, report := range reports {
+		for _, specReport := range report.SpecReports.WithLeafNodeType(types.NodeTypeIt) {
+			if specReport.RunTime <= threshold {
+				continue
+			}
+			slowSpecs = append(slowSpecs, types.SlowSpec{
+				SuiteDescription: report.SuiteDescription,
+				FullText:         specReport.FullText(),
+				LeafNodeLocation: specReport.LeafNodeLocation,
+				State:            specReport.State,
+				RunTime:          specReport.RunTime,
+			})
+		}
+	}
+	sort.SliceStable(slowSpecs, func(i, j int) bool {
+		return slowSpecs[i].RunTime > slowSpecs[j].RunTime
+	})
+	return slowSpecs
+}
@@ -0,0 +1,48 @@
+package reporters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("ListSlowSpecs", func() {
+	var reports []types.Report
+
+	BeforeEach(func() {
+		reports = []types.Report{
+			{
+				SuiteDescription: "Cart Suite",
+				SpecReports: types.SpecReports{
+					S(types.NodeTypeBeforeSuite, types.SpecStatePassed, 10*time.Second),
+					S([]string{"cart"}, "is quick", cl0, types.SpecStatePassed, 100*time.Millisecond),
+					S([]string{"cart"}, "is slow", cl0, types.SpecStatePassed, 2*time.Second),
+					S([]string{"cart"}, "is right at the threshold", cl0, types.SpecStatePassed, time.Second),
+				},
+			},
+			{
+				SuiteDescription: "Checkout Suite",
+				SpecReports: types.SpecReports{
+					S([]string{"checkout"}, "is slowest", cl1, types.SpecStateFailed, 5*time.Second),
+					S([]string{"checkout"}, "is also slow", cl1, types.SpecStatePassed, 2*time.Second),
+				},
+			},
+		}
+	})
+
+	It("returns the It specs whose run time exceeds the threshold, sorted from slowest to fastest", func() {
+		Ω(reporters.ListSlowSpecs(reports, time.Second)).Should(Equal([]types.SlowSpec{
+			{SuiteDescription: "Checkout Suite", FullText: "checkout is slowest", LeafNodeLocation: cl1, State: types.SpecStateFailed, RunTime: 5 * time.Second},
+			{SuiteDescription: "Cart Suite", FullText: "cart is slow", LeafNodeLocation: cl0, State: types.SpecStatePassed, RunTime: 2 * time.Second},
+			{SuiteDescription: "Checkout Suite", FullText: "checkout is also slow", LeafNodeLocation: cl1, State: types.SpecStatePassed, RunTime: 2 * time.Second},
+		}))
+	})
+
+	It("returns nothing when no spec exceeds the threshold", func() {
+		Ω(reporters.ListSlowSpecs(reports, time.Minute)).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,21 @@
+package reporters
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// GenerateSpecManifest writes a JSON-formatted types.SpecManifest listing the specs in report to the passed in destination
+func GenerateSpecManifest(report types.Report, destination string) error {
+	data, err := json.MarshalIndent(types.NewSpecManifest(report), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(destination), 0770); err != nil {
+		return err
+	}
+	return os.WriteFile(destination, append(data, '\n'), 0666)
+}
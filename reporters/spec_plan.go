@@ -0,0 +1,21 @@
+package reporters
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// GenerateSpecPlan writes a JSON-formatted types.SpecPlan listing the specs in report that will run to the passed in destination.  Each spec's estimated duration is taken from report.SuiteConfig.SpecCosts.
+func GenerateSpecPlan(report types.Report, destination string) error {
+	data, err := json.MarshalIndent(types.NewSpecPlan(report, report.SuiteConfig.SpecCosts), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(destination), 0770); err != nil {
+		return err
+	}
+	return os.WriteFile(destination, append(data, '\n'), 0666)
+}
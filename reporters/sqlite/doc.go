@@ -0,0 +1,23 @@
+/*
+Package sqlite registers a pure-Go SQLite driver (modernc.org/sqlite) under reporters.SQLiteDriverName so that the SQLiteReporter can be used without cgo.
+
+So that Ginkgo itself does not depend on a SQLite driver this package is its own module.  To use it add it to your suite's module:
+
+	go get github.com/onsi/ginkgo/v2/reporters/sqlite
+
+and import it alongside the reporters package:
+
+	import (
+		"github.com/onsi/ginkgo/v2/reporters"
+		_ "github.com/onsi/ginkgo/v2/reporters/sqlite"
+	)
+
+	var _ = ReportAfterSuite("sqlite report", func(report Report) {
+		reporter := reporters.NewSQLiteReporter("ginkgo-runs.db")
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.Err()).ShouldNot(HaveOccurred())
+	})
+
+Any other driver that registers itself as "sqlite" (or whose name is assigned to reporters.SQLiteDriverName) can be used instead.
+*/
+package sqlite
@@ -0,0 +1,144 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	_ "github.com/onsi/ginkgo/v2/reporters/sqlite"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SQLiteReporter", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			SuiteSucceeded:   false,
+			SuiteConfig:      types.SuiteConfig{RandomSeed: 17, ParallelTotal: 1},
+			StartTime:        start,
+			EndTime:          start.Add(3 * time.Second),
+			RunTime:          3 * time.Second,
+			SpecReports: types.SpecReports{
+				{
+					ID: "spec-a", ContainerHierarchyTexts: []string{"Cart"}, LeafNodeText: "adds items", LeafNodeType: types.NodeTypeIt, LeafNodeLabels: []string{"fast"},
+					LeafNodeLocation: types.CodeLocation{FileName: "/path/to/cart_test.go", LineNumber: 10},
+					State:            types.SpecStatePassed, NumAttempts: 1, RunTime: time.Second,
+				},
+				{
+					ID: "spec-b", ContainerHierarchyTexts: []string{"Cart"}, LeafNodeText: "removes items", LeafNodeType: types.NodeTypeIt,
+					LeafNodeLocation: types.CodeLocation{FileName: "/path/to/cart_test.go", LineNumber: 20},
+					State:            types.SpecStateFailed, NumAttempts: 2, RunTime: 2 * time.Second,
+					Failure: types.Failure{Message: "boom", Location: types.CodeLocation{FileName: "/path/to/cart_test.go", LineNumber: 22}, FailureNodeContext: types.FailureNodeIsLeafNode},
+				},
+				{
+					ID: "spec-c", LeafNodeText: "is pending", LeafNodeType: types.NodeTypeIt,
+					LeafNodeLocation: types.CodeLocation{FileName: "/path/to/cart_test.go", LineNumber: 30},
+					State:            types.SpecStatePending,
+				},
+			},
+		}
+	})
+
+	Describe("writing into an in-memory database", func() {
+		var db *sql.DB
+
+		BeforeEach(func() {
+			var err error
+			db, err = sql.Open(reporters.SQLiteDriverName, ":memory:")
+			Ω(err).ShouldNot(HaveOccurred())
+			// each connection to :memory: opens a separate database
+			db.SetMaxOpenConns(1)
+			DeferCleanup(db.Close)
+			Ω(reporters.MigrateSQLiteDatabase(db)).Should(Succeed())
+		})
+
+		It("records the run, its specs, and their failures", func() {
+			runID, err := reporters.WriteSQLiteReport(db, report)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			var description, startTime string
+			var succeeded bool
+			var runTime float64
+			Ω(db.QueryRow("SELECT suite_description, succeeded, start_time, run_time FROM runs WHERE id = ?", runID).Scan(&description, &succeeded, &startTime, &runTime)).Should(Succeed())
+			Ω(description).Should(Equal("My Suite"))
+			Ω(succeeded).Should(BeFalse())
+			Ω(startTime).Should(Equal("2024-03-01T12:00:00Z"))
+			Ω(runTime).Should(Equal(3.0))
+
+			rows, err := db.Query("SELECT spec_id, full_text, file_name, line_number, labels, state, num_attempts FROM specs WHERE run_id = ? ORDER BY id", runID)
+			Ω(err).ShouldNot(HaveOccurred())
+			defer rows.Close()
+			type specRow struct {
+				SpecID, FullText, FileName, Labels, State string
+				LineNumber, NumAttempts                   int
+			}
+			specs := []specRow{}
+			for rows.Next() {
+				var row specRow
+				Ω(rows.Scan(&row.SpecID, &row.FullText, &row.FileName, &row.LineNumber, &row.Labels, &row.State, &row.NumAttempts)).Should(Succeed())
+				specs = append(specs, row)
+			}
+			Ω(rows.Err()).ShouldNot(HaveOccurred())
+			Ω(specs).Should(Equal([]specRow{
+				{SpecID: "spec-a", FullText: "Cart adds items", FileName: "/path/to/cart_test.go", LineNumber: 10, Labels: "fast", State: "passed", NumAttempts: 1},
+				{SpecID: "spec-b", FullText: "Cart removes items", FileName: "/path/to/cart_test.go", LineNumber: 20, Labels: "", State: "failed", NumAttempts: 2},
+				{SpecID: "spec-c", FullText: "is pending", FileName: "/path/to/cart_test.go", LineNumber: 30, Labels: "", State: "pending", NumAttempts: 0},
+			}))
+
+			var message, failureNodeType, failedSpec string
+			var line int
+			Ω(db.QueryRow("SELECT failures.message, failures.failure_node_type, failures.line_number, specs.spec_id FROM failures JOIN specs ON specs.id = failures.spec_row_id").Scan(&message, &failureNodeType, &line, &failedSpec)).Should(Succeed())
+			Ω(message).Should(Equal("boom"))
+			Ω(failureNodeType).Should(Equal("It"))
+			Ω(line).Should(Equal(22))
+			Ω(failedSpec).Should(Equal("spec-b"))
+		})
+
+		It("appends each run so that trends can be queried across runs", func() {
+			_, err := reporters.WriteSQLiteReport(db, report)
+			Ω(err).ShouldNot(HaveOccurred())
+			report.SpecReports[1].State = types.SpecStatePassed
+			_, err = reporters.WriteSQLiteReport(db, report)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			var runs int
+			Ω(db.QueryRow("SELECT COUNT(*) FROM runs").Scan(&runs)).Should(Succeed())
+			Ω(runs).Should(Equal(2))
+
+			var flaky string
+			Ω(db.QueryRow("SELECT spec_id FROM specs WHERE state NOT IN ('pending', 'skipped') GROUP BY spec_id HAVING SUM(state != 'passed') > 0 AND SUM(state != 'passed') < COUNT(*)").Scan(&flaky)).Should(Succeed())
+			Ω(flaky).Should(Equal("spec-b"))
+		})
+
+		It("is a no-op to migrate a database that is already up to date", func() {
+			Ω(reporters.MigrateSQLiteDatabase(db)).Should(Succeed())
+			var version int
+			Ω(db.QueryRow("PRAGMA user_version").Scan(&version)).Should(Succeed())
+			Ω(version).Should(Equal(1))
+		})
+	})
+
+	It("creates the database when the suite ends and appends to it on subsequent runs", func() {
+		dbPath := filepath.Join(GinkgoT().TempDir(), "runs.db")
+		reporter := reporters.NewSQLiteReporter(dbPath)
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.Err()).ShouldNot(HaveOccurred())
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.Err()).ShouldNot(HaveOccurred())
+
+		db, err := sql.Open(reporters.SQLiteDriverName, dbPath)
+		Ω(err).ShouldNot(HaveOccurred())
+		defer db.Close()
+		var specs int
+		Ω(db.QueryRow("SELECT COUNT(*) FROM specs").Scan(&specs)).Should(Succeed())
+		Ω(specs).Should(Equal(6))
+	})
+})
@@ -0,0 +1,204 @@
+package reporters
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+SQLiteDriverName is the name of the database/sql driver used to open SQLite databases.
+
+Ginkgo does not depend on a SQLite driver.  To use the SQLiteReporter import a driver that registers itself under SQLiteDriverName - for example, the pure-Go driver registered by the github.com/onsi/ginkgo/v2/reporters/sqlite module.
+*/
+var SQLiteDriverName = "sqlite"
+
+// sqliteMigrations are applied, in order, to bring a database up to date.  The number of migrations that have been applied is stored in the database's user_version.  Never edit a migration once it has shipped - append a new one instead.
+var sqliteMigrations = []string{
+	`CREATE TABLE runs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		suite_description TEXT NOT NULL,
+		suite_path TEXT NOT NULL,
+		suite_labels TEXT NOT NULL,
+		succeeded INTEGER NOT NULL,
+		random_seed INTEGER NOT NULL,
+		parallel_total INTEGER NOT NULL,
+		start_time TEXT NOT NULL,
+		end_time TEXT NOT NULL,
+		run_time REAL NOT NULL
+	);
+	CREATE TABLE specs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		run_id INTEGER NOT NULL REFERENCES runs(id),
+		spec_id TEXT NOT NULL,
+		full_text TEXT NOT NULL,
+		leaf_node_type TEXT NOT NULL,
+		file_name TEXT NOT NULL,
+		line_number INTEGER NOT NULL,
+		labels TEXT NOT NULL,
+		state TEXT NOT NULL,
+		num_attempts INTEGER NOT NULL,
+		start_time TEXT NOT NULL,
+		end_time TEXT NOT NULL,
+		run_time REAL NOT NULL
+	);
+	CREATE INDEX specs_run_id ON specs(run_id);
+	CREATE INDEX specs_spec_id ON specs(spec_id);
+	CREATE TABLE failures (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		spec_row_id INTEGER NOT NULL REFERENCES specs(id),
+		message TEXT NOT NULL,
+		failure_node_type TEXT NOT NULL,
+		file_name TEXT NOT NULL,
+		line_number INTEGER NOT NULL
+	);
+	CREATE INDEX failures_spec_row_id ON failures(spec_row_id);`,
+}
+
+/*
+SQLiteReporter is a Reporter that appends the suite's run to a SQLite database when the suite ends.  Each run adds a row to the runs table, a row to the specs table for every spec in the run, and a row to the failures table for every spec that failed.  Since rows are appended across runs the database can be queried for trends - for example, to find specs that fail intermittently:
+
+	SELECT full_text, SUM(state != 'passed') AS failed, COUNT(*) AS runs FROM specs WHERE state NOT IN ('pending', 'skipped') GROUP BY spec_id HAVING failed > 0 AND failed < runs
+
+The database's schema is created, or migrated, when it is opened.
+
+Writing to the database is best-effort: a SQLiteReporter never fails the suite or changes its exit code.  Any error encountered while writing is available via Err().
+
+Since a SQLiteReporter is a Reporter it only sees the specs that are reported to it.  When attached to a suite via ReporterSet.Reporters it runs in each parallel process and so only records the specs that run on that process.  To record a single run for the whole suite call SuiteDidEnd from a ReportAfterSuite node instead:
+
+	var _ = ReportAfterSuite("sqlite report", func(report Report) {
+		reporter := reporters.NewSQLiteReporter("ginkgo-runs.db")
+		reporter.SuiteDidEnd(report)
+		Ω(reporter.Err()).ShouldNot(HaveOccurred())
+	})
+*/
+type SQLiteReporter struct {
+	NoopReporter
+	dbPath string
+	err    error
+}
+
+// NewSQLiteReporter returns a SQLiteReporter that writes to the SQLite database at dbPath, creating it if necessary
+func NewSQLiteReporter(dbPath string) *SQLiteReporter {
+	return &SQLiteReporter{dbPath: dbPath}
+}
+
+func (r *SQLiteReporter) SuiteDidEnd(report types.Report) {
+	r.err = GenerateSQLiteReport(report, r.dbPath)
+}
+
+// Err returns the error, if any, encountered the last time the SQLiteReporter wrote to the database
+func (r *SQLiteReporter) Err() error {
+	return r.err
+}
+
+// GenerateSQLiteReport appends report to the SQLite database at dbPath, creating and migrating the database as necessary
+func GenerateSQLiteReport(report types.Report, dbPath string) error {
+	db, err := sql.Open(SQLiteDriverName, dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open SQLite database %s: %w", dbPath, err)
+	}
+	defer db.Close()
+	if err := MigrateSQLiteDatabase(db); err != nil {
+		return err
+	}
+	_, err = WriteSQLiteReport(db, report)
+	return err
+}
+
+// MigrateSQLiteDatabase creates the tables the SQLiteReporter writes to, or migrates them to the latest schema
+func MigrateSQLiteDatabase(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("failed to read SQLite schema version: %w", err)
+	}
+	if version > len(sqliteMigrations) {
+		return fmt.Errorf("SQLite database schema version %d is newer than the latest version (%d) supported by this version of Ginkgo", version, len(sqliteMigrations))
+	}
+	for ; version < len(sqliteMigrations); version++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(sqliteMigrations[version]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to migrate SQLite database to schema version %d: %w", version+1, err)
+		}
+		// PRAGMA does not support bound parameters
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", version+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to migrate SQLite database to schema version %d: %w", version+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to migrate SQLite database to schema version %d: %w", version+1, err)
+		}
+	}
+	return nil
+}
+
+// WriteSQLiteReport appends report to db, which must already have been migrated with MigrateSQLiteDatabase, in a single transaction.  It returns the id of the new row in the runs table.
+func WriteSQLiteReport(db *sql.DB, report types.Report) (int64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	runID, err := writeSQLiteRun(tx, report)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to write report to SQLite database: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to write report to SQLite database: %w", err)
+	}
+	return runID, nil
+}
+
+func writeSQLiteRun(tx *sql.Tx, report types.Report) (int64, error) {
+	result, err := tx.Exec(`INSERT INTO runs (suite_description, suite_path, suite_labels, succeeded, random_seed, parallel_total, start_time, end_time, run_time) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		report.SuiteDescription, report.SuitePath, strings.Join(report.SuiteLabels, ","), report.SuiteSucceeded, report.SuiteConfig.RandomSeed, report.SuiteConfig.ParallelTotal,
+		sqliteTime(report.StartTime), sqliteTime(report.EndTime), report.RunTime.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	runID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, spec := range report.SpecReports {
+		result, err := tx.Exec(`INSERT INTO specs (run_id, spec_id, full_text, leaf_node_type, file_name, line_number, labels, state, num_attempts, start_time, end_time, run_time) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			runID, spec.ID, spec.FullText(), spec.LeafNodeType.String(), spec.LeafNodeLocation.FileName, spec.LeafNodeLocation.LineNumber, strings.Join(spec.Labels(), ","),
+			spec.State.String(), spec.NumAttempts, sqliteTime(spec.StartTime), sqliteTime(spec.EndTime), spec.RunTime.Seconds())
+		if err != nil {
+			return 0, err
+		}
+		if !spec.State.Is(types.SpecStateFailureStates) {
+			continue
+		}
+		specRowID, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		failureNodeType := spec.Failure.FailureNodeType
+		if spec.Failure.FailureNodeContext == types.FailureNodeIsLeafNode {
+			failureNodeType = spec.LeafNodeType
+		}
+		_, err = tx.Exec(`INSERT INTO failures (spec_row_id, message, failure_node_type, file_name, line_number) VALUES (?, ?, ?, ?, ?)`,
+			specRowID, spec.Failure.Message, failureNodeType.String(), spec.Failure.Location.FileName, spec.Failure.Location.LineNumber)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return runID, nil
+}
+
+// sqliteTime formats t so that it can be used with SQLite's date and time functions
+func sqliteTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
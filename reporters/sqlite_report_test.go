@@ -0,0 +1,18 @@
+package reporters_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("SQLiteReporter", func() {
+	// Ginkgo does not register a SQLite driver - the reporter's behavior against a real database is covered by the tests in the reporters/sqlite module
+	It("reports an error via Err when no SQLite driver has been registered", func() {
+		reporter := reporters.NewSQLiteReporter("runs.db")
+		reporter.SuiteDidEnd(types.Report{SuiteDescription: "My Suite"})
+		Ω(reporter.Err()).Should(MatchError(ContainSubstring(`unknown driver "sqlite"`)))
+	})
+})
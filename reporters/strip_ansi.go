@@ -0,0 +1,77 @@
+package reporters
+
+import (
+	"github.com/onsi/ginkgo/v2/formatter"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+/*
+StripANSIFromCapturedOutput returns a copy of report in which any ANSI escape sequences have been removed from each spec's CapturedStdOutErr, CapturedStdOut, CapturedStdErr, and CapturedGinkgoWriterOutput (and from the GinkgoWriter output captured by its ProgressReports).
+
+Because the timeline is keyed off of byte offsets into CapturedGinkgoWriterOutput, the TimelineLocation.Offset of every Failure, ReportEntry, ProgressReport, and SpecEvent is remapped to point at the same position in the stripped output.
+
+Ginkgo calls this before generating file-based reports (e.g. --json-report and --junit-report) unless ReporterConfig.KeepANSIInCaptured is set.
+*/
+func StripANSIFromCapturedOutput(report types.Report) types.Report {
+	specReports := make(types.SpecReports, len(report.SpecReports))
+	for i, specReport := range report.SpecReports {
+		specReports[i] = stripANSIFromSpecReport(specReport)
+	}
+	report.SpecReports = specReports
+	return report
+}
+
+func stripANSIFromSpecReport(report types.SpecReport) types.SpecReport {
+	report.CapturedStdOutErr = formatter.StripANSI(report.CapturedStdOutErr)
+	report.CapturedStdOut = formatter.StripANSI(report.CapturedStdOut)
+	report.CapturedStdErr = formatter.StripANSI(report.CapturedStdErr)
+
+	var mapOffset func(int) int
+	report.CapturedGinkgoWriterOutput, mapOffset = formatter.StripANSIWithOffsets(report.CapturedGinkgoWriterOutput)
+	remap := func(tl types.TimelineLocation) types.TimelineLocation {
+		tl.Offset = mapOffset(tl.Offset)
+		return tl
+	}
+
+	report.Failure.TimelineLocation = remap(report.Failure.TimelineLocation)
+	report.Failure.ProgressReport.CapturedGinkgoWriterOutput = formatter.StripANSI(report.Failure.ProgressReport.CapturedGinkgoWriterOutput)
+	if report.Failure.AdditionalFailure != nil {
+		additionalFailure := *report.Failure.AdditionalFailure
+		additionalFailure.Failure.TimelineLocation = remap(additionalFailure.Failure.TimelineLocation)
+		report.Failure.AdditionalFailure = &additionalFailure
+	}
+	if len(report.AdditionalFailures) > 0 {
+		additionalFailures := make([]types.AdditionalFailure, len(report.AdditionalFailures))
+		for i, additionalFailure := range report.AdditionalFailures {
+			additionalFailure.Failure.TimelineLocation = remap(additionalFailure.Failure.TimelineLocation)
+			additionalFailures[i] = additionalFailure
+		}
+		report.AdditionalFailures = additionalFailures
+	}
+	if len(report.ReportEntries) > 0 {
+		reportEntries := make(types.ReportEntries, len(report.ReportEntries))
+		for i, entry := range report.ReportEntries {
+			entry.TimelineLocation = remap(entry.TimelineLocation)
+			reportEntries[i] = entry
+		}
+		report.ReportEntries = reportEntries
+	}
+	if len(report.ProgressReports) > 0 {
+		progressReports := make([]types.ProgressReport, len(report.ProgressReports))
+		for i, progressReport := range report.ProgressReports {
+			progressReport.TimelineLocation = remap(progressReport.TimelineLocation)
+			progressReport.CapturedGinkgoWriterOutput = formatter.StripANSI(progressReport.CapturedGinkgoWriterOutput)
+			progressReports[i] = progressReport
+		}
+		report.ProgressReports = progressReports
+	}
+	if len(report.SpecEvents) > 0 {
+		specEvents := make(types.SpecEvents, len(report.SpecEvents))
+		for i, specEvent := range report.SpecEvents {
+			specEvent.TimelineLocation = remap(specEvent.TimelineLocation)
+			specEvents[i] = specEvent
+		}
+		report.SpecEvents = specEvents
+	}
+	return report
+}
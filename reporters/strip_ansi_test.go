@@ -0,0 +1,109 @@
+package reporters_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("StripANSIFromCapturedOutput", func() {
+	var report types.Report
+	red, reset := "\x1b[38;5;9m", "\x1b[0m"
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SpecReports: types.SpecReports{
+				S(types.NodeTypeIt, "A", cl0, types.SpecStateFailed,
+					STD(red+"stdout"+reset+"\n"),
+					GW(red+"first"+reset+"\n"+red+"second"+reset+"\n"),
+					RE("entry", cl1, TL(red+"first"+reset+"\n")),
+					SE(types.SpecEventByStart, "step", cl1, TL(red+"first"+reset+"\n"+red)),
+					PR("progress", TL(red+"first"+reset+"\n"+red+"second"+reset+"\n")),
+					F("boom", cl2, types.FailureNodeIsLeafNode, types.NodeTypeIt, TL(red+"first"+reset+"\n"+red+"second"+reset+"\n"),
+						AF(types.SpecStateTimedout, "stuck", cl2, types.FailureNodeIsLeafNode, types.NodeTypeIt, TL(red+"first"+reset+"\n"+red+"second"+reset+"\n"))),
+					AF(types.SpecStateFailed, "cleanup", cl3, types.FailureNodeInContainer, types.NodeTypeAfterEach, TL(red+"first"+reset+"\n"+red+"second"+reset+"\n")),
+				),
+			},
+		}
+	})
+
+	It("removes ANSI escape sequences from the captured output", func() {
+		stripped := reporters.StripANSIFromCapturedOutput(report)
+		Ω(stripped.SpecReports[0].CapturedStdOutErr).Should(Equal("stdout\n"))
+		Ω(stripped.SpecReports[0].CapturedGinkgoWriterOutput).Should(Equal("first\nsecond\n"))
+	})
+
+	It("remaps timeline offsets to point at the same position in the stripped output", func() {
+		stripped := reporters.StripANSIFromCapturedOutput(report).SpecReports[0]
+		Ω(stripped.ReportEntries[0].TimelineLocation.Offset).Should(Equal(len("first\n")))
+		Ω(stripped.SpecEvents[0].TimelineLocation.Offset).Should(Equal(len("first\n")))
+		Ω(stripped.ProgressReports[0].TimelineLocation.Offset).Should(Equal(len("first\nsecond\n")))
+		Ω(stripped.Failure.TimelineLocation.Offset).Should(Equal(len("first\nsecond\n")))
+		Ω(stripped.Failure.AdditionalFailure.Failure.TimelineLocation.Offset).Should(Equal(len("first\nsecond\n")))
+		Ω(stripped.AdditionalFailures[0].Failure.TimelineLocation.Offset).Should(Equal(len("first\nsecond\n")))
+	})
+
+	It("does not modify the original report", func() {
+		reporters.StripANSIFromCapturedOutput(report)
+		Ω(report.SpecReports[0].CapturedGinkgoWriterOutput).Should(Equal(red + "first" + reset + "\n" + red + "second" + reset + "\n"))
+		Ω(report.SpecReports[0].ReportEntries[0].TimelineLocation.Offset).Should(Equal(len(red + "first" + reset + "\n")))
+		Ω(report.SpecReports[0].Failure.AdditionalFailure.Failure.TimelineLocation.Offset).Should(Equal(len(red + "first" + reset + "\n" + red + "second" + reset + "\n")))
+	})
+
+	It("yields clean JSON and JUnit reports", func() {
+		dir := GinkgoT().TempDir()
+		stripped := reporters.StripANSIFromCapturedOutput(report)
+		Ω(reporters.GenerateJSONReport(stripped, filepath.Join(dir, "report.json"))).Should(Succeed())
+		Ω(reporters.GenerateJUnitReport(stripped, filepath.Join(dir, "report.xml"))).Should(Succeed())
+
+		data, err := os.ReadFile(filepath.Join(dir, "report.json"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(data)).ShouldNot(ContainSubstring(`\u001b`))
+		var decoded []types.Report
+		Ω(json.Unmarshal(data, &decoded)).Should(Succeed())
+		Ω(decoded[0].SpecReports[0].CapturedGinkgoWriterOutput).Should(Equal("first\nsecond\n"))
+
+		data, err = os.ReadFile(filepath.Join(dir, "report.xml"))
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(string(data)).ShouldNot(ContainSubstring("\x1b"))
+		Ω(string(data)).ShouldNot(ContainSubstring("&#x1B;"))
+		var suites reporters.JUnitTestSuites
+		Ω(xml.Unmarshal(data, &suites)).Should(Succeed())
+		Ω(suites.TestSuites[0].TestCases[0].SystemErr).Should(ContainSubstring("first\n"))
+		Ω(suites.TestSuites[0].TestCases[0].SystemErr).Should(ContainSubstring("second\n"))
+	})
+
+	Describe("the ComboReporter", func() {
+		It("strips the JSON report but leaves the human-readable output alone by default", func() {
+			buf := gbytes.NewBuffer()
+			jsonPath := filepath.Join(GinkgoT().TempDir(), "report.json")
+			reporter := reporters.NewComboReporter(buf, jsonPath, types.ReporterConfig{NoColor: true})
+			reporter.DidRun(report.SpecReports[0])
+			reporter.SuiteDidEnd(report)
+
+			Ω(string(buf.Contents())).Should(ContainSubstring(red + "first" + reset))
+			data, err := os.ReadFile(jsonPath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).ShouldNot(ContainSubstring(`\u001b`))
+		})
+
+		It("leaves the JSON report alone when KeepANSIInCaptured is set", func() {
+			jsonPath := filepath.Join(GinkgoT().TempDir(), "report.json")
+			reporter := reporters.NewComboReporter(gbytes.NewBuffer(), jsonPath, types.ReporterConfig{NoColor: true, KeepANSIInCaptured: true})
+			reporter.SuiteDidEnd(report)
+
+			data, err := os.ReadFile(jsonPath)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(data)).Should(ContainSubstring(`\u001b`))
+		})
+	})
+})
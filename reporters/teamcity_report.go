@@ -27,7 +27,17 @@ func tcEscape(s string) string {
 	return s
 }
 
+// TeamcityReportConfig configures the Teamcity report generated by GenerateTeamcityReportWithConfig
+type TeamcityReportConfig struct {
+	// CodeLocationFormatter, if set, is used to render code locations in failure details and timelines.  See types.ReporterConfig.CodeLocationFormatter
+	CodeLocationFormatter func(types.CodeLocation) string
+}
+
 func GenerateTeamcityReport(report types.Report, dst string) error {
+	return GenerateTeamcityReportWithConfig(report, dst, TeamcityReportConfig{})
+}
+
+func GenerateTeamcityReportWithConfig(report types.Report, dst string, config TeamcityReportConfig) error {
 	if err := os.MkdirAll(path.Dir(dst), 0770); err != nil {
 		return err
 	}
@@ -64,24 +74,24 @@ func GenerateTeamcityReport(report types.Report, dst string) error {
 			}
 			fmt.Fprintf(f, "##teamcity[testIgnored name='%s' message='%s']\n", name, tcEscape(message))
 		case types.SpecStateFailed:
-			details := failureDescriptionForUnstructuredReporters(spec)
+			details := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
 			fmt.Fprintf(f, "##teamcity[testFailed name='%s' message='failed - %s' details='%s']\n", name, tcEscape(spec.Failure.Message), tcEscape(details))
 		case types.SpecStatePanicked:
-			details := failureDescriptionForUnstructuredReporters(spec)
+			details := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
 			fmt.Fprintf(f, "##teamcity[testFailed name='%s' message='panicked - %s' details='%s']\n", name, tcEscape(spec.Failure.ForwardedPanic), tcEscape(details))
 		case types.SpecStateTimedout:
-			details := failureDescriptionForUnstructuredReporters(spec)
+			details := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
 			fmt.Fprintf(f, "##teamcity[testFailed name='%s' message='timedout - %s' details='%s']\n", name, tcEscape(spec.Failure.Message), tcEscape(details))
 		case types.SpecStateInterrupted:
-			details := failureDescriptionForUnstructuredReporters(spec)
+			details := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
 			fmt.Fprintf(f, "##teamcity[testFailed name='%s' message='interrupted - %s' details='%s']\n", name, tcEscape(spec.Failure.Message), tcEscape(details))
 		case types.SpecStateAborted:
-			details := failureDescriptionForUnstructuredReporters(spec)
+			details := failureDescriptionForUnstructuredReporters(spec, config.CodeLocationFormatter)
 			fmt.Fprintf(f, "##teamcity[testFailed name='%s' message='aborted - %s' details='%s']\n", name, tcEscape(spec.Failure.Message), tcEscape(details))
 		}
 
 		fmt.Fprintf(f, "##teamcity[testStdOut name='%s' out='%s']\n", name, tcEscape(systemOutForUnstructuredReporters(spec)))
-		fmt.Fprintf(f, "##teamcity[testStdErr name='%s' out='%s']\n", name, tcEscape(systemErrForUnstructuredReporters(spec)))
+		fmt.Fprintf(f, "##teamcity[testStdErr name='%s' out='%s']\n", name, tcEscape(systemErrForUnstructuredReporters(spec, config.CodeLocationFormatter)))
 		fmt.Fprintf(f, "##teamcity[testFinished name='%s' duration='%d']\n", name, int(spec.RunTime.Seconds()*1000.0))
 	}
 	fmt.Fprintf(f, "##teamcity[testSuiteFinished name='%s']\n", tcEscape(report.SuiteDescription))
@@ -72,4 +72,19 @@ var _ = Describe("TeamCityReport", func() {
 			Ω(err).Should(Succeed(), "Report file should be created")
 		})
 	})
+
+	Describe("when configured with a CodeLocationFormatter", func() {
+		It("uses the formatter when rendering failure details and timelines", func() {
+			fname := fmt.Sprintf("./report-%d", GinkgoParallelProcess())
+			Ω(reporters.GenerateTeamcityReportWithConfig(report, fname, reporters.TeamcityReportConfig{
+				CodeLocationFormatter: func(cl types.CodeLocation) string { return "formatted:" + cl.String() },
+			})).Should(Succeed())
+			DeferCleanup(os.Remove, fname)
+
+			content, err := os.ReadFile(fname)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(string(content)).Should(ContainSubstring("at: formatted:cl3.go:103"))
+			Ω(string(content)).Should(ContainSubstring("formatted:cl0.go:12"))
+		})
+	})
 })
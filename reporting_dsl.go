@@ -34,6 +34,12 @@ func CurrentSpecReport() SpecReport {
 	return global.Suite.CurrentSpecReport()
 }
 
+/*
+ReportEntry represents an entry attached to a SpecReport via AddReportEntry.
+It is documented here: https://pkg.go.dev/github.com/onsi/ginkgo/v2/types#ReportEntry
+*/
+type ReportEntry = types.ReportEntry
+
 /*
 	ReportEntryVisibility governs the visibility of ReportEntries in Ginkgo's console reporter
 
@@ -47,12 +53,42 @@ type ReportEntryVisibility = types.ReportEntryVisibility
 
 const ReportEntryVisibilityAlways, ReportEntryVisibilityFailureOrVerbose, ReportEntryVisibilityNever = types.ReportEntryVisibilityAlways, types.ReportEntryVisibilityFailureOrVerbose, types.ReportEntryVisibilityNever
 
+/*
+	ReportEntrySeverity captures the severity of ReportEntries.  Ginkgo's console reporter colors ReportEntries by severity.
+
+- ReportEntrySeverityInfo: the default severity.
+- ReportEntrySeverityWarning: the ReportEntry is rendered in orange.
+- ReportEntrySeverityError: the ReportEntry is rendered in red.
+
+ReportEntries with a severity below ReporterConfig.MinReportEntrySeverity are not emitted to the console though they appear in any generated machine-readable reports.
+
+You can learn more about Report Entries here: https://onsi.github.io/ginkgo/#attaching-data-to-reports
+*/
+type ReportEntrySeverity = types.ReportEntrySeverity
+
+const ReportEntrySeverityInfo, ReportEntrySeverityWarning, ReportEntrySeverityError = types.ReportEntrySeverityInfo, types.ReportEntrySeverityWarning, types.ReportEntrySeverityError
+
+/*
+	ReportEntryTarget routes ReportEntries into a specific part of Ginkgo's machine-readable reports.
+
+- ReportEntryTargetNone: the default.  The ReportEntry only appears in the JSON report.
+- ReportEntryTargetJUnitProperty: the ReportEntry is also emitted as a <property> of the spec's <testcase> in JUnit reports.  The property is named after the ReportEntry and its value is the ReportEntry's string representation.
+
+You can learn more about Report Entries here: https://onsi.github.io/ginkgo/#attaching-data-to-reports
+*/
+type ReportEntryTarget = types.ReportEntryTarget
+
+const ReportEntryTargetNone, ReportEntryTargetJUnitProperty = types.ReportEntryTargetNone, types.ReportEntryTargetJUnitProperty
+
 /*
 AddReportEntry generates and adds a new ReportEntry to the current spec's SpecReport.
 It can take any of the following arguments:
   - A single arbitrary object to attach as the Value of the ReportEntry.  This object will be included in any generated reports and will be emitted to the console when the report is emitted.
   - A ReportEntryVisibility enum to control the visibility of the ReportEntry
+  - A ReportEntrySeverity enum to control the severity of the ReportEntry
+  - A ReportEntryTarget enum to route the ReportEntry into a specific part of the machine-readable reports
   - An Offset or CodeLocation decoration to control the reported location of the ReportEntry
+  - Any number of nested ReportEntries generated with NestedReportEntry.  These are attached as Children of the ReportEntry.
 
 If the Value object implements `fmt.Stringer`, it's `String()` representation is used when emitting to the console.
 
@@ -72,6 +108,98 @@ func AddReportEntry(name string, args ...interface{}) {
 	}
 }
 
+/*
+NestedReportEntry generates a ReportEntry that can be nested within another ReportEntry by passing it to AddReportEntry (or to another NestedReportEntry):
+
+	AddReportEntry("HTTP Request",
+		NestedReportEntry("request", req.URL.String()),
+		NestedReportEntry("response", NestedReportEntry("status", resp.Status), NestedReportEntry("body", body)),
+	)
+
+NestedReportEntry takes the same arguments as AddReportEntry.  Nested entries are emitted, indented, beneath their parent and are included in machine-readable reports as the parent entry's Children.
+The Visibility of a nested entry is ignored - nested entries are always emitted along with their parent.
+
+You can learn more about Report Entries here: https://onsi.github.io/ginkgo/#attaching-data-to-reports
+*/
+func NestedReportEntry(name string, args ...interface{}) ReportEntry {
+	cl := types.NewCodeLocation(1)
+	reportEntry, err := internal.NewReportEntry(name, cl, args...)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to generate Report Entry:\n%s", err.Error()), 1)
+	}
+	return reportEntry
+}
+
+/*
+CollectValue adds value to the suite-wide collection called name.  Collected values are recorded on the current spec's SpecReport (as SpecReport.CollectedValues) and are aggregated across parallel processes along with the rest of the suite's report.
+
+Use CollectValue along with ExpectUnique to check invariants that span the whole suite - for example, that no two specs used the same port:
+
+	It("serves requests", func() {
+		port := GetFreePort()
+		CollectValue("ports", port)
+		...
+	})
+
+	ReportAfterSuite("ports are unique", func(report Report) {
+		ExpectUnique("ports")
+	})
+
+Values are stored, and compared, as their string representation (as formatted by fmt's %v verb).  If a spec is retried only the values collected by its final attempt are kept.
+
+CollectValue() must be called within a Subject or Setup node - not in a Container node.
+
+You can learn more about checking suite-wide invariants here: https://onsi.github.io/ginkgo/#checking-suite-wide-invariants
+*/
+func CollectValue(name string, value interface{}) {
+	cl := types.NewCodeLocation(1)
+	err := global.Suite.CollectValue(types.CollectedValue{Name: name, Value: fmt.Sprintf("%v", value), Location: cl})
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to collect value:\n%s", err.Error()), 1)
+	}
+}
+
+/*
+ExpectUnique fails the current node if any value was added to the collection called name (via CollectValue) more than once.  The failure lists each duplicated value along with the specs that collected it.
+
+ExpectUnique must be called within a ReportAfterSuite node.  When running in parallel the ReportAfterSuite node runs on process #1 after the other processes have finished so ExpectUnique sees the values collected by every spec in the suite.  Since a failing ReportAfterSuite node fails the suite, ExpectUnique fails the suite if duplicates were collected.
+
+You can learn more about checking suite-wide invariants here: https://onsi.github.io/ginkgo/#checking-suite-wide-invariants
+*/
+func ExpectUnique(name string) {
+	cl := types.NewCodeLocation(1)
+	report, err := global.Suite.ReportAfterSuiteReport(cl)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to check collected values:\n%s", err.Error()), 1)
+	}
+	if err := report.ValidateUniqueCollectedValues(name); err != nil {
+		Fail(err.Error(), 1)
+	}
+}
+
+/*
+MatchGolden compares the GinkgoWriter output the current spec has captured so far against the golden file at path and fails the spec if they differ.  It is typically called in an AfterEach:
+
+	AfterEach(func() {
+		MatchGolden(filepath.Join("testdata", CurrentSpecReport().LeafNodeText+".golden"), `^time=`)
+	})
+
+Line endings are normalized before comparing.  ignorePatterns are regular expressions - lines that match any of them are ignored in both the output and the golden file, which is useful for volatile lines such as timestamps.
+
+When the suite is run with --update-golden MatchGolden writes the captured output to the golden file, creating it if necessary, instead of comparing.
+
+You can learn more about MatchGolden here: https://onsi.github.io/ginkgo/#comparing-output-against-golden-files
+*/
+func MatchGolden(path string, ignorePatterns ...string) {
+	message, err := internal.MatchGolden(global.Suite.CurrentSpecReport().CapturedGinkgoWriterOutput, path, suiteConfig.UpdateGolden, ignorePatterns)
+	if err != nil {
+		Fail(fmt.Sprintf("Failed to match golden file:\n%s", err.Error()), 1)
+	}
+	if message != "" {
+		Fail(message, 1)
+	}
+}
+
 /*
 ReportBeforeEach nodes are run for each spec, even if the spec is skipped or pending.  ReportBeforeEach nodes take a function that
 receives a SpecReport.  They are called before the spec starts.
@@ -143,6 +271,9 @@ func ReportAfterSuite(text string, body func(Report), args ...interface{}) bool
 
 func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.ReporterConfig) {
 	body := func(report Report) {
+		if !reporterConfig.KeepANSIInCaptured {
+			report = reporters.StripANSIFromCapturedOutput(report)
+		}
 		if reporterConfig.JSONReport != "" {
 			err := reporters.GenerateJSONReport(report, reporterConfig.JSONReport)
 			if err != nil {
@@ -150,17 +281,29 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 			}
 		}
 		if reporterConfig.JUnitReport != "" {
-			err := reporters.GenerateJUnitReport(report, reporterConfig.JUnitReport)
+			err := reporters.GenerateJUnitReportWithConfig(report, reporterConfig.JUnitReport, reporters.JunitReportConfig{CodeLocationFormatter: reporterConfig.CodeLocationFormatter})
 			if err != nil {
 				Fail(fmt.Sprintf("Failed to generate JUnit report:\n%s", err.Error()))
 			}
 		}
 		if reporterConfig.TeamcityReport != "" {
-			err := reporters.GenerateTeamcityReport(report, reporterConfig.TeamcityReport)
+			err := reporters.GenerateTeamcityReportWithConfig(report, reporterConfig.TeamcityReport, reporters.TeamcityReportConfig{CodeLocationFormatter: reporterConfig.CodeLocationFormatter})
 			if err != nil {
 				Fail(fmt.Sprintf("Failed to generate Teamcity report:\n%s", err.Error()))
 			}
 		}
+		if reporterConfig.SpecManifest != "" {
+			err := reporters.GenerateSpecManifest(report, reporterConfig.SpecManifest)
+			if err != nil {
+				Fail(fmt.Sprintf("Failed to generate spec manifest:\n%s", err.Error()))
+			}
+		}
+		if reporterConfig.PlanOutput != "" {
+			err := reporters.GenerateSpecPlan(report, reporterConfig.PlanOutput)
+			if err != nil {
+				Fail(fmt.Sprintf("Failed to generate spec plan:\n%s", err.Error()))
+			}
+		}
 	}
 
 	flags := []string{}
@@ -173,6 +316,12 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 	if reporterConfig.TeamcityReport != "" {
 		flags = append(flags, "--teamcity-report")
 	}
+	if reporterConfig.SpecManifest != "" {
+		flags = append(flags, "--emit-spec-manifest")
+	}
+	if reporterConfig.PlanOutput != "" {
+		flags = append(flags, "--plan-output")
+	}
 	pushNode(internal.NewNode(
 		deprecationTracker, types.NodeTypeReportAfterSuite,
 		fmt.Sprintf("Autogenerated ReportAfterSuite for %s", strings.Join(flags, " ")),
@@ -180,3 +329,29 @@ func registerReportAfterSuiteNodeForAutogeneratedReports(reporterConfig types.Re
 		types.NewCustomCodeLocation("autogenerated by Ginkgo"),
 	))
 }
+
+func registerReportAfterSuiteNodeForReporterSet(reporterSet reporters.ReporterSet, keepANSIInCaptured bool) {
+	body := func(report Report) {
+		for _, reporter := range reporterSet.AggregatedReportReporters() {
+			reporter.SuiteDidEndAcrossProcesses(report)
+		}
+		if !keepANSIInCaptured {
+			report = reporters.StripANSIFromCapturedOutput(report)
+		}
+		errors := reporterSet.GenerateReports(report)
+		if len(errors) > 0 {
+			messages := []string{}
+			for _, err := range errors {
+				messages = append(messages, err.Error())
+			}
+			Fail(strings.Join(messages, "\n"))
+		}
+	}
+
+	pushNode(internal.NewNode(
+		deprecationTracker, types.NodeTypeReportAfterSuite,
+		"Autogenerated ReportAfterSuite for reporters.ReporterSet",
+		body,
+		types.NewCustomCodeLocation("autogenerated by Ginkgo"),
+	))
+}
@@ -3,10 +3,12 @@ package ginkgo
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 
 	"github.com/onsi/ginkgo/v2/internal"
+	"github.com/onsi/ginkgo/v2/internal/global"
 	"github.com/onsi/ginkgo/v2/types"
 )
 
@@ -27,6 +29,19 @@ func (ed EntryDescription) render(args ...interface{}) string {
 	return fmt.Sprintf(string(ed), args...)
 }
 
+// renderAndValidate renders the EntryDescription and returns an error if the format string's verbs do not match args.  fmt flags mismatches (missing or extra arguments, verbs that don't suit their argument's type, bad widths) with "%!" so we look for any that weren't already present in the arguments themselves.
+func (ed EntryDescription) renderAndValidate(args []interface{}, cl types.CodeLocation) (string, error) {
+	rendered := ed.render(args...)
+	expected := 0
+	for _, arg := range args {
+		expected += strings.Count(fmt.Sprint(arg), "%!")
+	}
+	if strings.Count(rendered, "%!") > expected {
+		return "", types.GinkgoErrors.MismatchedEntryDescription(string(ed), len(args), rendered, cl)
+	}
+	return rendered, nil
+}
+
 /*
 DescribeTable describes a table-driven spec.
 
@@ -128,6 +143,62 @@ You can mark a particular entry as pending with XEntry.  This is equivalent to X
 */
 var XEntry = PEntry
 
+/*
+TableFromFile loads table entries from a JSON or YAML data file.  It is called at tree construction time and its return value is passed directly to DescribeTable:
+
+	type Case struct {
+	    Input    string
+	    Expected int
+	}
+
+	DescribeTable("parsing",
+	    func(input string, expected int) {
+	        Ω(Parse(input)).Should(Equal(expected))
+	    },
+	    TableFromFile("fixtures/cases.yaml", func(c Case) TableEntry {
+	        return Entry(c.Input, c.Input, c.Expected)
+	    }),
+	)
+
+The file must contain a top-level array (JSON) or sequence (YAML) of records and its format is determined by its extension (.json, .yaml, or .yml).  Each record is decoded into the parameter type of entryFactory which must return a TableEntry.
+The code location of each generated entry points at the data file and the line on which the record begins.
+
+If the file cannot be read or decoded TableFromFile returns no entries and Ginkgo fails tree construction with an error that includes the path of the file.
+
+You can learn more about TableFromFile here: https://onsi.github.io/ginkgo/#loading-table-entries-from-a-file
+*/
+func TableFromFile(path string, entryFactory interface{}) []TableEntry {
+	GinkgoHelper()
+	cl := types.NewCodeLocation(0)
+	factoryType := reflect.TypeOf(entryFactory)
+	if factoryType == nil || factoryType.Kind() != reflect.Func || factoryType.NumIn() != 1 || factoryType.NumOut() != 1 || factoryType.Out(0) != reflect.TypeOf(TableEntry{}) {
+		global.Suite.RecordTreeConstructionError(types.GinkgoErrors.InvalidTableEntryFactory(cl))
+		return []TableEntry{}
+	}
+
+	records, err := internal.LoadTableRecords(path, factoryType.In(0))
+	if err != nil {
+		global.Suite.RecordTreeConstructionError(types.GinkgoErrors.FailedToLoadTableFile(path, err, cl))
+		return []TableEntry{}
+	}
+
+	fileName, err := filepath.Abs(path)
+	if err != nil {
+		fileName = path
+	}
+	entries := []TableEntry{}
+	for i, record := range records {
+		entry := reflect.ValueOf(entryFactory).Call([]reflect.Value{record.Value})[0].Interface().(TableEntry)
+		entry.codeLocation = types.CodeLocation{
+			FileName:      fileName,
+			LineNumber:    record.Line,
+			CustomMessage: fmt.Sprintf("%s:%d (record #%d)", fileName, record.Line, i),
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
 var contextType = reflect.TypeOf(new(context.Context)).Elem()
 var specContextType = reflect.TypeOf(new(SpecContext)).Elem()
 
@@ -162,7 +233,7 @@ func generateTable(description string, args ...interface{}) {
 		case t == reflect.TypeOf([]TableEntry{}):
 			entries = append(entries, arg.([]TableEntry)...)
 		case t == reflect.TypeOf(EntryDescription("")):
-			tableLevelEntryDescription = arg.(EntryDescription).render
+			tableLevelEntryDescription = arg
 		case t.Kind() == reflect.Func && t.NumOut() == 1 && t.Out(0) == reflect.TypeOf(""):
 			tableLevelEntryDescription = arg
 		case t.Kind() == reflect.Func:
@@ -183,12 +254,16 @@ func generateTable(description string, args ...interface{}) {
 			var description string
 			switch t := reflect.TypeOf(entry.description); {
 			case t == nil:
+				if format, ok := tableLevelEntryDescription.(EntryDescription); ok {
+					description, err = format.renderAndValidate(entry.parameters, entry.codeLocation)
+					break
+				}
 				err = validateParameters(tableLevelEntryDescription, entry.parameters, "Entry Description function", entry.codeLocation, false)
 				if err == nil {
 					description = invokeFunction(tableLevelEntryDescription, entry.parameters)[0].String()
 				}
 			case t == reflect.TypeOf(EntryDescription("")):
-				description = entry.description.(EntryDescription).render(entry.parameters...)
+				description, err = entry.description.(EntryDescription).renderAndValidate(entry.parameters, entry.codeLocation)
 			case t == reflect.TypeOf(""):
 				description = entry.description.(string)
 			case t.Kind() == reflect.Func && t.NumOut() == 1 && t.Out(0) == reflect.TypeOf(""):
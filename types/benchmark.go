@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// BenchmarkStats captures the timing statistics computed for the samples collected by a Benchmark spec
+type BenchmarkStats struct {
+	// Iterations is the number of times the benchmark body was run
+	Iterations int
+
+	Mean   time.Duration
+	StdDev time.Duration
+	P95    time.Duration
+	Min    time.Duration
+	Max    time.Duration
+}
+
+// NewBenchmarkStats computes BenchmarkStats for the passed-in samples
+func NewBenchmarkStats(samples []time.Duration) BenchmarkStats {
+	stats := BenchmarkStats{Iterations: len(samples)}
+	if len(samples) == 0 {
+		return stats
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	total := 0.0
+	for _, sample := range sorted {
+		total += float64(sample)
+	}
+	mean := total / float64(len(sorted))
+
+	variance := 0.0
+	for _, sample := range sorted {
+		variance += (float64(sample) - mean) * (float64(sample) - mean)
+	}
+	variance /= float64(len(sorted))
+
+	stats.Mean = time.Duration(mean)
+	stats.StdDev = time.Duration(math.Sqrt(variance))
+	stats.P95 = sorted[int(math.Ceil(0.95*float64(len(sorted))))-1]
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	return stats
+}
+
+func (stats BenchmarkStats) String() string {
+	return fmt.Sprintf("%d iterations: mean %s ± %s, p95 %s, min %s, max %s", stats.Iterations, stats.Mean, stats.StdDev, stats.P95, stats.Min, stats.Max)
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var _ = Describe("BenchmarkStats", func() {
+	It("computes statistics for the passed-in samples", func() {
+		samples := []time.Duration{}
+		for i := 20; i >= 1; i-- {
+			samples = append(samples, time.Duration(i)*time.Millisecond)
+		}
+		stats := types.NewBenchmarkStats(samples)
+		Ω(stats.Iterations).Should(Equal(20))
+		Ω(stats.Mean).Should(Equal(10500 * time.Microsecond))
+		Ω(stats.StdDev).Should(BeNumerically("~", 5766*time.Microsecond, time.Microsecond))
+		Ω(stats.P95).Should(Equal(19 * time.Millisecond))
+		Ω(stats.Min).Should(Equal(time.Millisecond))
+		Ω(stats.Max).Should(Equal(20 * time.Millisecond))
+	})
+
+	It("does not modify the passed-in samples", func() {
+		samples := []time.Duration{3, 1, 2}
+		types.NewBenchmarkStats(samples)
+		Ω(samples).Should(Equal([]time.Duration{3, 1, 2}))
+	})
+
+	It("returns empty stats when there are no samples", func() {
+		Ω(types.NewBenchmarkStats(nil)).Should(Equal(types.BenchmarkStats{}))
+	})
+
+	It("renders the stats", func() {
+		stats := types.NewBenchmarkStats([]time.Duration{time.Millisecond, 3 * time.Millisecond})
+		Ω(stats.String()).Should(Equal("2 iterations: mean 2ms ± 1ms, p95 3ms, min 1ms, max 3ms"))
+	})
+})
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// CapturedOutputSource identifies which stream a CapturedOutputSegment was written to
+type CapturedOutputSource uint
+
+const (
+	CapturedOutputSourceStdOutErr CapturedOutputSource = iota
+	CapturedOutputSourceGinkgoWriter
+)
+
+// CapturedOutputSegment records when a contiguous chunk of captured output was written.  Start and End are byte offsets into SpecReport.CapturedStdOutErr or SpecReport.CapturedGinkgoWriterOutput, depending on Source.
+type CapturedOutputSegment struct {
+	Source CapturedOutputSource
+	Start  int
+	End    int
+	Time   time.Time
+}
+
+/*
+InterleavedOutput merges CapturedStdOutErr and CapturedGinkgoWriterOutput into a single string ordered by when each chunk of output was written.
+
+Ginkgo only records the CapturedOutputSegments needed to do this when running with --interleave-output.  Without them InterleavedOutput falls back to CombinedOutput().
+*/
+func (report SpecReport) InterleavedOutput() string {
+	if len(report.CapturedOutputSegments) == 0 {
+		return report.CombinedOutput()
+	}
+
+	sources := map[CapturedOutputSource]string{
+		CapturedOutputSourceStdOutErr:    report.CapturedStdOutErr,
+		CapturedOutputSourceGinkgoWriter: report.CapturedGinkgoWriterOutput,
+	}
+	consumed := map[CapturedOutputSource]int{}
+
+	segments := make([]CapturedOutputSegment, len(report.CapturedOutputSegments))
+	copy(segments, report.CapturedOutputSegments)
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].Time.Before(segments[j].Time) })
+
+	out := &strings.Builder{}
+	for _, segment := range segments {
+		source := sources[segment.Source]
+		if segment.Start < 0 || segment.Start > segment.End || segment.End > len(source) {
+			continue
+		}
+		out.WriteString(source[segment.Start:segment.End])
+		if segment.End > consumed[segment.Source] {
+			consumed[segment.Source] = segment.End
+		}
+	}
+
+	// anything that was captured without a corresponding segment is emitted at the end
+	for _, source := range []CapturedOutputSource{CapturedOutputSourceStdOutErr, CapturedOutputSourceGinkgoWriter} {
+		out.WriteString(sources[source][consumed[source]:])
+	}
+	return out.String()
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// GINKGO_CI_ENV_VAR is the environment variable that, when set to a boolean (e.g. GINKGO_CI=false), overrides Ginkgo's CI detection
+const GINKGO_CI_ENV_VAR = "GINKGO_CI"
+
+// CIEnvironmentVariables lists the environment variables that Ginkgo takes as a sign that it is running on a CI system
+var CIEnvironmentVariables = []string{"CI", "BUILD_NUMBER", "BUILDKITE", "CIRCLECI", "GITHUB_ACTIONS", "GITLAB_CI", "JENKINS_URL", "TEAMCITY_VERSION", "TF_BUILD", "TRAVIS"}
+
+/*
+DetectCI returns true if the environment, as seen through lookupEnv (typically os.LookupEnv), indicates that Ginkgo is running on a CI system.
+
+If GINKGO_CI_ENV_VAR is set to a boolean its value wins.  Otherwise Ginkgo is on CI if any of the CIEnvironmentVariables is set to a value other than "", "false", or "0".
+*/
+func DetectCI(lookupEnv func(string) (string, bool)) bool {
+	if value, ok := lookupEnv(GINKGO_CI_ENV_VAR); ok {
+		if ci, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+			return ci
+		}
+	}
+	for _, name := range CIEnvironmentVariables {
+		value, ok := lookupEnv(name)
+		value = strings.ToLower(strings.TrimSpace(value))
+		if ok && value != "" && value != "false" && value != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectGitLabCI returns true if DetectCI returns true and the environment, as seen through lookupEnv, indicates that Ginkgo is running on GitLab CI
+func DetectGitLabCI(lookupEnv func(string) (string, bool)) bool {
+	if !DetectCI(lookupEnv) {
+		return false
+	}
+	value, _ := lookupEnv("GITLAB_CI")
+	gitlab, err := strconv.ParseBool(strings.TrimSpace(value))
+	return err == nil && gitlab
+}
+
+/*
+WithCIOverrides returns the effective ReporterConfig.  If ci is false, or CIOverrides is nil, this is rc itself.
+
+Otherwise every field set (i.e. non-zero) in CIOverrides replaces the corresponding field in rc.  Because the verbosity flags are mutually exclusive, setting any of Succinct, Verbose, or VeryVerbose
+in CIOverrides replaces rc's verbosity altogether.  Since a zero value means "not set", CIOverrides cannot turn off a boolean that rc turns on - with the exception of the verbosity flags.
+
+The returned ReporterConfig's CIOverrides is always nil.
+*/
+func (rc ReporterConfig) WithCIOverrides(ci bool) ReporterConfig {
+	overrides := rc.CIOverrides
+	rc.CIOverrides = nil
+	if !ci || overrides == nil {
+		return rc
+	}
+	if overrides.Succinct || overrides.Verbose || overrides.VeryVerbose {
+		rc.Succinct, rc.Verbose, rc.VeryVerbose = false, false, false
+	}
+	out, in := reflect.ValueOf(&rc).Elem(), reflect.ValueOf(*overrides)
+	for i := 0; i < in.NumField(); i++ {
+		if field := in.Field(i); !field.IsZero() {
+			out.Field(i).Set(field)
+		}
+	}
+	// overrides may have carried CIOverrides of its own
+	rc.CIOverrides = nil
+	return rc
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CI detection", func() {
+	env := func(vars map[string]string) func(string) (string, bool) {
+		return func(name string) (string, bool) {
+			value, ok := vars[name]
+			return value, ok
+		}
+	}
+
+	Describe("DetectCI", func() {
+		It("is false when no CI environment variables are set", func() {
+			Ω(types.DetectCI(env(map[string]string{"HOME": "/home/me"}))).Should(BeFalse())
+		})
+
+		It("is true when any of the CI environment variables is set", func() {
+			Ω(types.DetectCI(env(map[string]string{"CI": "true"}))).Should(BeTrue())
+			Ω(types.DetectCI(env(map[string]string{"GITHUB_ACTIONS": "true"}))).Should(BeTrue())
+			Ω(types.DetectCI(env(map[string]string{"JENKINS_URL": "https://jenkins.example.com"}))).Should(BeTrue())
+		})
+
+		It("ignores CI environment variables that are empty or explicitly false", func() {
+			Ω(types.DetectCI(env(map[string]string{"CI": ""}))).Should(BeFalse())
+			Ω(types.DetectCI(env(map[string]string{"CI": "false"}))).Should(BeFalse())
+			Ω(types.DetectCI(env(map[string]string{"CI": "0"}))).Should(BeFalse())
+		})
+
+		It("lets GINKGO_CI override the detection", func() {
+			Ω(types.DetectCI(env(map[string]string{"CI": "true", "GINKGO_CI": "false"}))).Should(BeFalse())
+			Ω(types.DetectCI(env(map[string]string{"GINKGO_CI": "true"}))).Should(BeTrue())
+			Ω(types.DetectCI(env(map[string]string{"CI": "true", "GINKGO_CI": "maybe"}))).Should(BeTrue())
+		})
+	})
+
+	Describe("DetectGitLabCI", func() {
+		It("is true when running on GitLab CI", func() {
+			Ω(types.DetectGitLabCI(env(map[string]string{"GITLAB_CI": "true"}))).Should(BeTrue())
+		})
+
+		It("is false on other CI providers", func() {
+			Ω(types.DetectGitLabCI(env(map[string]string{"CI": "true", "GITHUB_ACTIONS": "true"}))).Should(BeFalse())
+		})
+
+		It("respects GINKGO_CI", func() {
+			Ω(types.DetectGitLabCI(env(map[string]string{"GITLAB_CI": "true", "GINKGO_CI": "false"}))).Should(BeFalse())
+		})
+	})
+
+	Describe("ReporterConfig.WithCIOverrides", func() {
+		var reporterConfig types.ReporterConfig
+
+		BeforeEach(func() {
+			reporterConfig = types.NewDefaultReporterConfig()
+			reporterConfig.Verbose = true
+			reporterConfig.FullTrace = true
+			reporterConfig.JSONReport = "report.json"
+			reporterConfig.CIOverrides = &types.ReporterConfig{
+				Succinct:    true,
+				JUnitReport: "junit.xml",
+			}
+		})
+
+		It("returns the config without its overrides when not running on CI", func() {
+			effective := reporterConfig.WithCIOverrides(types.DetectCI(env(map[string]string{})))
+			Ω(effective.Verbosity()).Should(Equal(types.VerbosityLevelVerbose))
+			Ω(effective.JUnitReport).Should(BeEmpty())
+			Ω(effective.JSONReport).Should(Equal("report.json"))
+			Ω(effective.CIOverrides).Should(BeNil())
+		})
+
+		It("merges the overrides over the config when running on CI", func() {
+			effective := reporterConfig.WithCIOverrides(types.DetectCI(env(map[string]string{"CI": "true"})))
+			Ω(effective.Verbosity()).Should(Equal(types.VerbosityLevelSuccinct))
+			Ω(effective.Verbose).Should(BeFalse())
+			Ω(effective.JUnitReport).Should(Equal("junit.xml"))
+			Ω(effective.JSONReport).Should(Equal("report.json"))
+			Ω(effective.FullTrace).Should(BeTrue())
+			Ω(effective.KeepANSIInCaptured).Should(BeFalse())
+			Ω(effective.CIOverrides).Should(BeNil())
+		})
+
+		It("leaves the verbosity alone if the overrides don't set it", func() {
+			reporterConfig.CIOverrides = &types.ReporterConfig{JUnitReport: "junit.xml"}
+			effective := reporterConfig.WithCIOverrides(true)
+			Ω(effective.Verbosity()).Should(Equal(types.VerbosityLevelVerbose))
+			Ω(effective.JUnitReport).Should(Equal("junit.xml"))
+		})
+
+		It("does nothing when there are no overrides", func() {
+			reporterConfig.CIOverrides = nil
+			Ω(reporterConfig.WithCIOverrides(true)).Should(Equal(reporterConfig))
+		})
+	})
+})
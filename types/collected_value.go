@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+CollectedValue is a value registered via CollectValue.  Collected values are recorded on the SpecReport of the node that collected them and so, when running in parallel, they are aggregated on process #1 along with the rest of the suite's report.
+*/
+type CollectedValue struct {
+	// Name is the name of the collection the value was added to
+	Name string
+
+	// Value is the string representation of the collected value, as formatted by fmt's %v verb.  Values are compared by their representation.
+	Value string
+
+	// Location is the location of the call to CollectValue
+	Location CodeLocation
+}
+
+// CollectedValues returns every value collected under name by the specs in the report
+func (report Report) CollectedValues(name string) []CollectedValue {
+	out := []CollectedValue{}
+	for _, specReport := range report.SpecReports {
+		for _, value := range specReport.CollectedValues {
+			if value.Name == name {
+				out = append(out, value)
+			}
+		}
+	}
+	return out
+}
+
+/*
+ValidateUniqueCollectedValues returns an error if any value was collected under name more than once.  The error lists each duplicated value along with the specs that collected it.
+*/
+func (report Report) ValidateUniqueCollectedValues(name string) error {
+	type collection struct {
+		value string
+		specs []string
+	}
+	collections := []*collection{}
+	lookup := map[string]*collection{}
+	for _, specReport := range report.SpecReports {
+		for _, value := range specReport.CollectedValues {
+			if value.Name != name {
+				continue
+			}
+			if lookup[value.Value] == nil {
+				lookup[value.Value] = &collection{value: value.Value}
+				collections = append(collections, lookup[value.Value])
+			}
+			spec := specReport.FullText()
+			if spec == "" {
+				spec = fmt.Sprintf("[%s]", specReport.LeafNodeType)
+			}
+			lookup[value.Value].specs = append(lookup[value.Value].specs, fmt.Sprintf("%s at %s", spec, value.Location))
+		}
+	}
+
+	out := &strings.Builder{}
+	for _, c := range collections {
+		if len(c.specs) < 2 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s was collected %d times:\n", c.value, len(c.specs))
+		for _, spec := range c.specs {
+			fmt.Fprintf(out, "  %s\n", spec)
+		}
+	}
+	if out.Len() == 0 {
+		return nil
+	}
+	return fmt.Errorf("Expected the values collected under \"%s\" to be unique but found duplicates:%s", name, strings.TrimSuffix(out.String(), "\n"))
+}
@@ -7,9 +7,13 @@ package types
 
 import (
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -23,8 +27,13 @@ type SuiteConfig struct {
 	SkipStrings           []string
 	FocusFiles            []string
 	SkipFiles             []string
+	FocusSpecIDs          []string
 	LabelFilter           string
+	ShardIndex            int
+	ShardCount            int
 	FailOnPending         bool
+	MinSpecsToRun         int
+	FailOnEmptySuite      bool
 	FailFast              bool
 	FlakeAttempts         int
 	MustPassRepeatedly    int
@@ -34,8 +43,70 @@ type SuiteConfig struct {
 	Timeout               time.Duration
 	EmitSpecProgress      bool // this is deprecated but its removal is causing compile issue for some users that were setting it manually
 	OutputInterceptorMode string
+	SeparateStdoutStderr  bool
+	ParallelOutputMode    string
 	SourceRoots           []string
 	GracePeriod           time.Duration
+	CaptureResourceUsage  bool
+	PerSpecCoverage       bool
+	PerSpecLogDir         string
+	// UpdateGolden causes MatchGolden to rewrite golden files with the output it is passed instead of comparing against them.
+	UpdateGolden bool
+
+	// ProgressReportSinks receive every progress report Ginkgo emits (including those generated by the progress poller) in addition to the configured reporters.
+	// When running in parallel each process invokes its sinks locally.  ProgressReportSinks cannot be set via the command line and are not serialized.
+	ProgressReportSinks []ProgressReportSink `json:"-"`
+	// EmitCompletionProgressReports, if set, causes Ginkgo to generate a final progress report (including a snapshot of every running goroutine) when each spec that ran completes.  Completion progress reports
+	// are only sent to ProgressReportSinks - not to the configured reporters - and so do not appear in the console.  Since sinks can only be configured in code there is no corresponding command-line flag.
+	EmitCompletionProgressReports bool
+
+	// SuiteSuccessPredicate, if set, is called with the suite's Report at the end of the run and determines whether the suite succeeded.  It is only consulted when the suite
+	// did not fail for a special reason (e.g. an interrupt or timeout).  When running in parallel the predicate is evaluated once, on process #1, over the report aggregated across all processes.
+	// SuiteSuccessPredicate cannot be set via the command line and is not serialized.
+	SuiteSuccessPredicate func(Report) bool `json:"-"`
+
+	// SpecCosts maps a spec's full text (see SpecReport.FullText()) to its estimated runtime - typically taken from a previous run's report via SpecReports.SpecCosts().  When running in parallel Ginkgo
+	// dispatches parallelizable specs longest-first (Ordered containers are treated as a single unit whose cost is the sum of their specs' costs) to minimize the time spent waiting on stragglers.
+	// Specs without a cost are dispatched after those with a cost.  SpecCosts cannot be set via the command line and is not serialized.
+	SpecCosts map[string]time.Duration `json:"-"`
+
+	// TimingBaseline is the path to a JSON report generated by a previous run (i.e. with --json-report).  If set, and SpecCosts is empty, Ginkgo populates SpecCosts with the runtimes of the
+	// specs the baseline recorded for this suite.
+	TimingBaseline string
+
+	// RandomizeWithinContainers, if set, runs containers (and top-level specs) in the order they are declared but shuffles the specs within each container.  Each container is shuffled with a seed
+	// derived from RandomSeed and the container's position in the spec tree so a given --seed always reproduces the same order.  RandomizeWithinContainers cannot be combined with RandomizeAllSpecs.
+	RandomizeWithinContainers bool
+
+	// FinalOrderHook, if set, receives a SpecReport for every spec that will run, in the order Ginkgo has computed (after randomization and any SpecCosts sorting), and returns the order in which the specs should
+	// actually run.  The hook may reorder specs freely but must return every spec it was given exactly once, must keep the specs in an Ordered container adjacent and in their original relative order and,
+	// when running in parallel, must keep Serial specs after all other specs.  Ginkgo fails the suite without running any specs if the returned order violates these constraints.  When running in parallel
+	// each process calls the hook independently so the hook must be deterministic.  FinalOrderHook cannot be set via the command line and is not serialized.
+	FinalOrderHook func(ordered []SpecReport) []SpecReport `json:"-"`
+
+	FailOnDurationDeviation bool
+	RequireLabelsOnTopLevel bool
+	// LabelTimeoutMultipliers maps labels to the factor by which Ginkgo scales the NodeTimeouts and SpecTimeouts of specs carrying the label - for example {"integration": 3.0}.  Labels are matched case-insensitively and, if several of a spec's labels have a multiplier, the largest applies.
+	// Multipliers must be positive.  Nodes that do not have a NodeTimeout or SpecTimeout are unaffected.  LabelTimeoutMultipliers cannot be set via the command line.
+	LabelTimeoutMultipliers map[string]float64
+	// MaxNestingDepth, if positive, is the deepest that containers may be nested.  Ginkgo fails the suite without running any specs if any container is nested more deeply.
+	MaxNestingDepth int
+
+	FailOnGoroutineLeak     bool
+	GoroutineLeakSettleTime time.Duration
+	GoroutineLeakAllowlist  []string
+
+	// IsolateSpecsInSubprocess, if set, causes the Ginkgo CLI to run each spec in its own invocation of the suite's test binary so that a spec that crashes the process fails without taking down the rest of the suite.
+	// It is only supported by the Ginkgo CLI and cannot be combined with running in parallel.
+	IsolateSpecsInSubprocess bool
+
+	// NetworkProbeAddress is the host:port Ginkgo dials to decide whether specs decorated with RequiresNetwork can run.  If empty, Ginkgo dials proxy.golang.org:443.
+	NetworkProbeAddress string
+	// AssumeOnline disables the network probe.  Specs decorated with RequiresNetwork always run.
+	AssumeOnline bool
+	// NetworkProbe, if set, is called with NetworkProbeAddress in place of Ginkgo's TCP dial and should return an error if the network is unreachable.
+	// NetworkProbe cannot be set via the command line and is not serialized.
+	NetworkProbe func(address string) error `json:"-"`
 
 	ParallelProcess int
 	ParallelTotal   int
@@ -49,9 +120,27 @@ func NewDefaultSuiteConfig() SuiteConfig {
 		ParallelProcess: 1,
 		ParallelTotal:   1,
 		GracePeriod:     30 * time.Second,
+
+		GoroutineLeakSettleTime: 100 * time.Millisecond,
 	}
 }
 
+// The values accepted by SuiteConfig.ParallelOutputMode.  An empty ParallelOutputMode is equivalent to ParallelOutputModeOrdered.
+const (
+	ParallelOutputModeOrdered     = "ordered"
+	ParallelOutputModeAsCompleted = "as-completed"
+)
+
+// EmitsParallelOutputAsCompleted returns true if ParallelOutputMode is set to ParallelOutputModeAsCompleted
+func (sc SuiteConfig) EmitsParallelOutputAsCompleted() bool {
+	return strings.ToLower(sc.ParallelOutputMode) == ParallelOutputModeAsCompleted
+}
+
+// ProgressReportSink receives the progress reports emitted by Ginkgo.  Register ProgressReportSinks via SuiteConfig.ProgressReportSinks
+type ProgressReportSink interface {
+	Emit(ProgressReport)
+}
+
 type VerbosityLevel uint
 
 const (
@@ -84,15 +173,68 @@ func (vl VerbosityLevel) LT(comp VerbosityLevel) bool {
 // Configuration for Ginkgo's reporter
 type ReporterConfig struct {
 	NoColor        bool
+	ForceColor     bool
 	Succinct       bool
 	Verbose        bool
 	VeryVerbose    bool
 	FullTrace      bool
 	ShowNodeEvents bool
+	FailuresOnly   bool
+	EmitSpecStart  bool
+	GitLabSections bool
+
+	InterleaveOutput bool
+
+	// HintOnSilentSetupFailure, if set, causes Ginkgo's console reporter to suggest adding diagnostics when a spec fails in a setup node (e.g. a BeforeEach) and produced no captured output
+	HintOnSilentSetupFailure bool
+
+	SuppressSuiteHeader bool
+
+	SpecCountSummary     bool
+	SpecCountSummaryJSON bool
+	ReportFlakes         bool
+	ShowAttempts         bool
+	ListPendingSpecs     bool
+
+	EmitEnvironmentOnFailure bool
+	EnvironmentAllowlist     []string
+	// PrintReproducibleFlags causes Ginkgo's console reporter to print the Ginkgo CLI flags that reproduce the run (see GenerateReproducibleFlags) when the suite ends.
+	PrintReproducibleFlags bool
+
+	// ColorDurationsByGradient causes Ginkgo's console reporter to color spec run times on a gradient from green (fast) through yellow to red (slow), relative to SlowSpecThreshold.  It has no effect when NoColor is set.
+	ColorDurationsByGradient bool
+	// SlowSpecThreshold is the run time at which ColorDurationsByGradient colors a spec's run time red.  It cannot be set via the command line and defaults to DefaultSlowSpecThreshold when zero.
+	SlowSpecThreshold time.Duration
 
 	JSONReport     string
 	JUnitReport    string
 	TeamcityReport string
+
+	// PartialReportsOnCrash causes Ginkgo to write a partial JSON and JUnit report, identifying the in-progress spec, just before each spec runs so that the reports are not lost if the suite process exits abruptly.  It only applies when running in series.
+	PartialReportsOnCrash bool
+
+	SpecManifest string
+	PlanOutput   string
+
+	// MaxSpecTextLength, if positive, causes Ginkgo's console reporter to truncate each container and spec text longer than this many characters in spec headers and the failure summary.
+	// The full text is always preserved in the SpecReports and in machine-readable reports.
+	MaxSpecTextLength int
+
+	// CodeLocationFormatter, if set, is used by Ginkgo's reporters to render every CodeLocation they emit (e.g. to render paths relative to a repository root or as links).
+	// It cannot be set via the command line and is not serialized.  When nil, CodeLocation.String() is used.
+	CodeLocationFormatter func(CodeLocation) string `json:"-"`
+
+	// MinReportEntrySeverity, if set, causes Ginkgo's console reporter to suppress ReportEntries with a lower Severity.
+	// It cannot be set via the command line.  Suppressed ReportEntries still appear in machine-readable reports.
+	MinReportEntrySeverity ReportEntrySeverity
+
+	// By default Ginkgo removes ANSI escape sequences from captured stdout/stderr and GinkgoWriter output before generating file-based reports (JSON, JUnit, Teamcity).
+	// KeepANSIInCaptured disables this and cannot be set via the command line.  The console reporter always emits captured output unmodified.
+	KeepANSIInCaptured bool
+
+	// CIOverrides, if set, is merged over the rest of the ReporterConfig when Ginkgo detects that it is running on a CI system (see DetectCI and WithCIOverrides).
+	// It cannot be set via the command line.
+	CIOverrides *ReporterConfig
 }
 
 func (rc ReporterConfig) Verbosity() VerbosityLevel {
@@ -106,14 +248,36 @@ func (rc ReporterConfig) Verbosity() VerbosityLevel {
 	return VerbosityLevelNormal
 }
 
+// FormatCodeLocation renders the passed-in CodeLocation using CodeLocationFormatter if it is set, and CodeLocation.String() otherwise
+func (rc ReporterConfig) FormatCodeLocation(cl CodeLocation) string {
+	if rc.CodeLocationFormatter != nil {
+		return rc.CodeLocationFormatter(cl)
+	}
+	return cl.String()
+}
+
+// STDOUT_REPORT_DESTINATION is the report destination (e.g. --json-report=-) that causes Ginkgo to write the report to stdout instead of to a file
+const STDOUT_REPORT_DESTINATION = "-"
+
+// JSONReportToStdout returns true if the JSON report should be written to stdout instead of to a file
+func (rc ReporterConfig) JSONReportToStdout() bool {
+	return rc.JSONReport == STDOUT_REPORT_DESTINATION
+}
+
 func (rc ReporterConfig) WillGenerateReport() bool {
-	return rc.JSONReport != "" || rc.JUnitReport != "" || rc.TeamcityReport != ""
+	return rc.JSONReport != "" || rc.JUnitReport != "" || rc.TeamcityReport != "" || rc.SpecManifest != "" || rc.PlanOutput != ""
 }
 
+// DefaultSlowSpecThreshold is the SlowSpecThreshold used by ColorDurationsByGradient when ReporterConfig.SlowSpecThreshold is not set
+const DefaultSlowSpecThreshold = 5 * time.Second
+
 func NewDefaultReporterConfig() ReporterConfig {
 	return ReporterConfig{}
 }
 
+// PRESETS_FILE is the name of the file, in the directory the Ginkgo CLI is invoked from, that defines named filter presets
+const PRESETS_FILE = ".ginkgo.yaml"
+
 // Configuration for the Ginkgo CLI
 type CLIConfig struct {
 	//for build, run, and watch
@@ -129,6 +293,7 @@ type CLIConfig struct {
 	OutputDir                 string
 	KeepSeparateCoverprofiles bool
 	KeepSeparateReports       bool
+	Preset                    string
 
 	//for run only
 	KeepGoing       bool
@@ -136,6 +301,9 @@ type CLIConfig struct {
 	Repeat          int
 	RandomizeSuites bool
 
+	OnlyPreviouslyFlaked bool
+	Input                string
+
 	//for watch only
 	Depth       int
 	WatchRegExp string
@@ -267,13 +435,31 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "The seed used to randomize the spec suite."},
 	{KeyPath: "S.RandomizeAllSpecs", Name: "randomize-all", SectionKey: "order", DeprecatedName: "randomizeAllSpecs", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will randomize all specs together.  By default, ginkgo only randomizes the top level Describe, Context and When containers."},
+	{KeyPath: "S.RandomizeWithinContainers", Name: "randomize-within-containers", SectionKey: "order",
+		Usage: "If set, ginkgo will run containers in the order they are declared but randomize the specs within each container.  Cannot be combined with --randomize-all."},
+	{KeyPath: "S.TimingBaseline", Name: "timing-baseline", SectionKey: "order", UsageArgument: "filename.json",
+		Usage: "The path to a JSON report generated by a previous run (i.e. with --json-report).  Ginkgo uses the runtimes it recorded to dispatch the longest specs first when running in parallel and to estimate each spec's duration in the plan written by --plan-output."},
 
 	{KeyPath: "S.FailOnPending", Name: "fail-on-pending", SectionKey: "failure", DeprecatedName: "failOnPending", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will mark the test suite as failed if any specs are pending."},
+	{KeyPath: "S.MinSpecsToRun", Name: "min-specs-to-run", SectionKey: "failure", UsageDefaultValue: "0 - no minimum",
+		Usage: "If set, ginkgo will fail the test suite without running any specs if fewer than this many specs remain after filtering.  Use this to catch misconfigured filters that would otherwise produce a falsely-green run."},
+	{KeyPath: "S.FailOnEmptySuite", Name: "fail-on-empty-suite", SectionKey: "failure",
+		Usage: "If set, ginkgo will fail the test suite if it does not define any specs at all.  Unlike --min-specs-to-run this ignores filtering and only catches suites with no specs."},
 	{KeyPath: "S.FailFast", Name: "fail-fast", SectionKey: "failure", DeprecatedName: "failFast", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will stop running a test suite after a failure occurs."},
 	{KeyPath: "S.FlakeAttempts", Name: "flake-attempts", SectionKey: "failure", UsageDefaultValue: "0 - failed tests are not retried", DeprecatedName: "flakeAttempts", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "Make up to this many attempts to run each spec. If any of the attempts succeed, the suite will not be failed."},
+	{KeyPath: "S.FailOnDurationDeviation", Name: "fail-on-duration-deviation", SectionKey: "failure",
+		Usage: "If set, ginkgo will fail any passing spec decorated with ExpectedDuration whose run time deviates from the expected duration by more than a factor of 2."},
+	{KeyPath: "S.FailOnGoroutineLeak", Name: "fail-on-goroutine-leak", SectionKey: "failure",
+		Usage: "If set, ginkgo will fail any spec that leaves behind goroutines that were not running before the spec started."},
+	{KeyPath: "S.GoroutineLeakSettleTime", Name: "goroutine-leak-settle-time", SectionKey: "failure", UsageDefaultValue: "100ms",
+		Usage: "When --fail-on-goroutine-leak is set, ginkgo will give goroutines launched by a spec up to this long to exit before considering them leaked."},
+	{KeyPath: "S.GoroutineLeakAllowlist", Name: "goroutine-leak-allowlist", SectionKey: "failure", UsageArgument: "regexp",
+		Usage: "When --fail-on-goroutine-leak is set, goroutines with a function in their stack that matches this regular expression are not considered leaks. Can be specified multiple times."},
+	{KeyPath: "S.IsolateSpecsInSubprocess", Name: "isolate-specs-in-subprocess", SectionKey: "failure",
+		Usage: "If set, ginkgo will run each spec in its own invocation of the test binary so that a spec that crashes the process is reported as failed and the remaining specs still run.  Specs in Ordered containers run together.  BeforeSuite and AfterSuite run once per spec.  Cannot be combined with -p or --procs."},
 
 	{KeyPath: "S.DryRun", Name: "dry-run", SectionKey: "debug", DeprecatedName: "dryRun", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will walk the test hierarchy without actually running anything.  Best paired with -v."},
@@ -287,11 +473,27 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "Test suite fails if it does not complete within the specified timeout."},
 	{KeyPath: "S.GracePeriod", Name: "grace-period", SectionKey: "debug", UsageDefaultValue: "30s",
 		Usage: "When interrupted, Ginkgo will wait for GracePeriod for the current running node to exit before moving on to the next one."},
+	{KeyPath: "S.CaptureResourceUsage", Name: "capture-resource-usage", SectionKey: "debug",
+		Usage: "If set, ginkgo will record how much each spec grows the peak resident set size (RSS) of the test process.  Only supported on Linux, macOS, and the BSDs.  Note that peak RSS is process-global and monotonic: a spec only records a delta if it pushes the process past its previous peak, so memory retained by earlier specs (or by Ginkgo itself) can mask a spec's usage."},
+	{KeyPath: "S.PerSpecLogDir", Name: "per-spec-log-dir", SectionKey: "debug", UsageArgument: "dir",
+		Usage: "If set, ginkgo will write the stdout/stderr and GinkgoWriter output captured by each spec to its own file in this directory and record the file's path in the spec's report.  Relative paths are resolved relative to the suite's directory."},
+	{KeyPath: "S.PerSpecCoverage", Name: "per-spec-coverage", SectionKey: "debug",
+		Usage: "If set, ginkgo will record which source files each spec covered.  Requires Go 1.20+, specs run from a binary built with go build -cover -covermode=atomic, and --procs=1 (the default)."},
+	{KeyPath: "S.UpdateGolden", Name: "update-golden", SectionKey: "misc",
+		Usage: "If set, MatchGolden will write the GinkgoWriter output captured by each spec to its golden file instead of comparing the output against the file."},
 	{KeyPath: "S.OutputInterceptorMode", Name: "output-interceptor-mode", SectionKey: "debug", UsageArgument: "dup, swap, or none",
 		Usage: "If set, ginkgo will use the specified output interception strategy when running in parallel.  Defaults to dup on unix and swap on windows."},
+	{KeyPath: "S.SeparateStdoutStderr", Name: "separate-stdout-stderr", SectionKey: "debug",
+		Usage: "If set, ginkgo will capture stdout and stderr separately when running in parallel and report them in separate sections.  The combined output is still recorded, though the relative order of stdout and stderr output is then only approximate."},
+	{KeyPath: "S.ParallelOutputMode", Name: "parallel-output-mode", SectionKey: "parallel", UsageArgument: "ordered or as-completed", UsageDefaultValue: "ordered",
+		Usage: "Controls when output from parallel processes is emitted.  With ordered, spec output is held until every process has started running the suite so that it always follows the suite header.  With as-completed, each spec's output is emitted as soon as the spec completes and is tagged with the number of the process that ran it."},
 
 	{KeyPath: "S.LabelFilter", Name: "label-filter", SectionKey: "filter", UsageArgument: "expression",
 		Usage: "If set, ginkgo will only run specs with labels that match the label-filter.  The passed-in expression can include boolean operations (!, &&, ||, ','), groupings via '()', and regular expressions '/regexp/'.  e.g. '(cat || dog) && !fruit'"},
+	{KeyPath: "S.RequireLabelsOnTopLevel", Name: "require-labels-on-top-level", SectionKey: "filter",
+		Usage: "If set, ginkgo will fail the suite without running any specs if any top-level container is not decorated with at least one Label."},
+	{KeyPath: "S.MaxNestingDepth", Name: "max-nesting-depth", SectionKey: "filter", UsageDefaultValue: "0 - no limit",
+		Usage: "If set, ginkgo will fail the suite without running any specs if any container is nested more deeply than this.  Top-level containers have a depth of 1."},
 	{KeyPath: "S.FocusStrings", Name: "focus", SectionKey: "filter",
 		Usage: "If set, ginkgo will only run specs that match this regular expression. Can be specified multiple times, values are ORed."},
 	{KeyPath: "S.SkipStrings", Name: "skip", SectionKey: "filter",
@@ -300,6 +502,16 @@ var SuiteConfigFlags = GinkgoFlags{
 		Usage: "If set, ginkgo will only run specs in matching files. Can be specified multiple times, values are ORed."},
 	{KeyPath: "S.SkipFiles", Name: "skip-file", SectionKey: "filter", UsageArgument: "file (regexp) | file:line | file:lineA-lineB | file:line,line,line",
 		Usage: "If set, ginkgo will skip specs in matching files. Can be specified multiple times, values are ORed."},
+	{KeyPath: "S.FocusSpecIDs", Name: "focus-spec-id", SectionKey: "filter", UsageArgument: "id",
+		Usage: "If set, ginkgo will only run the spec with this ID.  Spec IDs are reported in the ID field of each spec in the JSON report. Can be specified multiple times, values are ORed."},
+	{KeyPath: "S.ShardCount", Name: "shard-count", SectionKey: "filter", UsageDefaultValue: "0 (no sharding)",
+		Usage: "If set to N > 1, ginkgo will split the specs that remain after focus and label filtering into N disjoint shards and only run the shard selected by --shard-index.  Specs in the same Ordered container always land in the same shard."},
+	{KeyPath: "S.ShardIndex", Name: "shard-index", SectionKey: "filter", UsageDefaultValue: "0",
+		Usage: "The index (starting at 0) of the shard to run when --shard-count is set."},
+	{KeyPath: "S.NetworkProbeAddress", Name: "network-probe-address", SectionKey: "filter", UsageArgument: "host:port", UsageDefaultValue: "proxy.golang.org:443",
+		Usage: "The address ginkgo dials, once per suite, to decide whether specs decorated with RequiresNetwork can run.  If the address is unreachable those specs are skipped."},
+	{KeyPath: "S.AssumeOnline", Name: "assume-online", SectionKey: "filter",
+		Usage: "If set, ginkgo will not probe the network and will always run specs decorated with RequiresNetwork."},
 
 	{KeyPath: "D.RegexScansFilePath", DeprecatedName: "regexScansFilePath", DeprecatedDocLink: "removed--regexscansfilepath", DeprecatedVersion: "2.0.0"},
 	{KeyPath: "D.DebugParallel", DeprecatedName: "debug", DeprecatedDocLink: "removed--debug", DeprecatedVersion: "2.0.0"},
@@ -321,23 +533,63 @@ var ParallelConfigFlags = GinkgoFlags{
 var ReporterConfigFlags = GinkgoFlags{
 	{KeyPath: "R.NoColor", Name: "no-color", SectionKey: "output", DeprecatedName: "noColor", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, suppress color output in default reporter."},
+	{KeyPath: "R.ForceColor", Name: "force-color", SectionKey: "output",
+		Usage: "If set, emit color output even when stdout is not a terminal (e.g. on CI systems that render ANSI color codes).  --no-color takes precedence."},
 	{KeyPath: "R.Verbose", Name: "v", SectionKey: "output",
 		Usage: "If set, emits more output including GinkgoWriter contents."},
 	{KeyPath: "R.VeryVerbose", Name: "vv", SectionKey: "output",
 		Usage: "If set, emits with maximal verbosity - includes skipped and pending tests."},
 	{KeyPath: "R.Succinct", Name: "succinct", SectionKey: "output",
 		Usage: "If set, default reporter prints out a very succinct report"},
+	{KeyPath: "R.FailuresOnly", Name: "failures-only", SectionKey: "output",
+		Usage: "If set, default reporter only prints out failed specs followed by the end-of-suite summary.  Nothing is printed for passing, pending, or skipped specs.  Useful for keeping CI logs small."},
+	{KeyPath: "R.EmitSpecStart", Name: "emit-spec-start", SectionKey: "output",
+		Usage: "If set, default reporter prints a minimal marker with the spec's text before each spec runs, even when not running verbosely.  Useful for identifying a hung spec from CI logs.  Has no effect with -v or -vv (which already print a header before each spec) or when running in parallel."},
+	{KeyPath: "R.GitLabSections", Name: "gitlab-sections", SectionKey: "output",
+		Usage: "If set, default reporter wraps the output of each spec in GitLab CI section markers so that it can be collapsed in the job log.  Enabled automatically when running on GitLab CI."},
+	{KeyPath: "R.SuppressSuiteHeader", Name: "suppress-suite-header", SectionKey: "output",
+		Usage: "If set, default reporter does not print the suite header (the suite description, random seed, and number of specs that will run) when the suite begins.  The end-of-suite summary is unaffected."},
 	{KeyPath: "R.FullTrace", Name: "trace", SectionKey: "output",
 		Usage: "If set, default reporter prints out the full stack trace when a failure occurs"},
 	{KeyPath: "R.ShowNodeEvents", Name: "show-node-events", SectionKey: "output",
 		Usage: "If set, default reporter prints node > Enter and < Exit events when specs fail"},
+	{KeyPath: "R.MaxSpecTextLength", Name: "max-spec-text-length", SectionKey: "output", UsageDefaultValue: "0 - no truncation",
+		Usage: "If set, default reporter truncates container and spec texts longer than this many characters (with an ellipsis) in spec headers and the failure summary.  Machine-readable reports always include the full text."},
+	{KeyPath: "R.InterleaveOutput", Name: "interleave-output", SectionKey: "output",
+		Usage: "If set, default reporter emits captured stdout/stderr and GinkgoWriter output as a single block, ordered by when each chunk of output was written, instead of as two separate sections."},
+	{KeyPath: "R.HintOnSilentSetupFailure", Name: "hint-on-silent-setup-failure", SectionKey: "output",
+		Usage: "If set, default reporter adds a hint to the report of any spec that fails in a setup node (BeforeEach, AfterEach, BeforeAll, etc.) without producing any GinkgoWriter or stdout/stderr output, suggesting that you add diagnostics."},
+	{KeyPath: "R.SpecCountSummary", Name: "spec-count-summary", SectionKey: "output",
+		Usage: "If set, default reporter prints out a breakdown of spec counts by top-level container and by label at the end of the run.  Pair with --dry-run to get the breakdown without running any specs."},
+	{KeyPath: "R.SpecCountSummaryJSON", Name: "spec-count-summary-json", SectionKey: "output",
+		Usage: "If set alongside --spec-count-summary, default reporter emits the breakdown as a single line of JSON instead of a table."},
+	{KeyPath: "R.ReportFlakes", Name: "report-flakes", SectionKey: "output",
+		Usage: "If set, default reporter prints out a summary of every spec that only passed after being retried with --flake-attempts or the FlakeAttempts decorator, along with the failures recorded by each failed attempt."},
+	{KeyPath: "R.ShowAttempts", Name: "show-attempts", SectionKey: "output",
+		Usage: "If set alongside -v or -vv, default reporter prints a summary of each failed attempt of a spec retried with --flake-attempts or the FlakeAttempts decorator as soon as the attempt fails.  Only applies when running in series."},
+	{KeyPath: "R.ListPendingSpecs", Name: "list-pending-specs", SectionKey: "output",
+		Usage: "If set, default reporter prints the full text and location of every pending spec at the end of the suite.  Use --fail-on-pending to fail the suite if any pending specs remain."},
+	{KeyPath: "R.EmitEnvironmentOnFailure", Name: "emit-environment-on-failure", SectionKey: "output",
+		Usage: "If set, default reporter prints a block of diagnostics describing the environment the suite ran in (Go version, GOMAXPROCS, number of CPUs, available memory, allowlisted environment variables, and the resolved configuration) when the suite fails.  The diagnostics are also recorded in the Environment field of the suite's report."},
+	{KeyPath: "R.EnvironmentAllowlist", Name: "environment-allowlist", SectionKey: "output", UsageArgument: "name", UsageDefaultValue: "CI, GOFLAGS, GODEBUG, GOGC, GOMAXPROCS, GOMEMLIMIT",
+		Usage: "The name of an environment variable to include in the diagnostics printed by --emit-environment-on-failure.  Can be specified multiple times.  Only allowlisted environment variables are included so that secrets are not leaked into CI logs."},
+	{KeyPath: "R.PrintReproducibleFlags", Name: "print-reproducible-flags", SectionKey: "output",
+		Usage: "If set, default reporter prints the ginkgo flags that reproduce the run - including the random seed, parallelism, and any filters - when the suite ends.  Useful for bug reports."},
+	{KeyPath: "R.ColorDurationsByGradient", Name: "color-durations-by-gradient", SectionKey: "output",
+		Usage: "If set, default reporter colors spec run times on a gradient from green (fast) through yellow to red (5s or slower) so that slow specs stand out.  Has no effect with --no-color."},
 
 	{KeyPath: "R.JSONReport", Name: "json-report", UsageArgument: "filename.json", SectionKey: "output",
-		Usage: "If set, Ginkgo will generate a JSON-formatted test report at the specified location."},
+		Usage: "If set, Ginkgo will generate a JSON-formatted test report at the specified location.  Use - to write the report to stdout; all other output will be sent to stderr."},
 	{KeyPath: "R.JUnitReport", Name: "junit-report", UsageArgument: "filename.xml", SectionKey: "output", DeprecatedName: "reportFile", DeprecatedDocLink: "improved-reporting-infrastructure",
 		Usage: "If set, Ginkgo will generate a conformant junit test report in the specified file."},
 	{KeyPath: "R.TeamcityReport", Name: "teamcity-report", UsageArgument: "filename", SectionKey: "output",
 		Usage: "If set, Ginkgo will generate a Teamcity-formatted test report at the specified location."},
+	{KeyPath: "R.PartialReportsOnCrash", Name: "partial-reports-on-crash", SectionKey: "output",
+		Usage: "If set alongside --json-report or --junit-report, Ginkgo writes a partial report that identifies the running spec just before each spec runs so that, if the suite process exits abruptly (e.g. because a spec calls os.Exit), the report records the spec that brought the suite down.  Only applies when running in series."},
+	{KeyPath: "R.SpecManifest", Name: "emit-spec-manifest", UsageArgument: "filename.json", SectionKey: "output",
+		Usage: "If set alongside --dry-run, Ginkgo will write a JSON manifest of every spec in the suite (including table-generated specs) to the specified location.  Useful for tools that need to discover specs without running them."},
+	{KeyPath: "R.PlanOutput", Name: "plan-output", UsageArgument: "filename.json", SectionKey: "output",
+		Usage: "If set alongside --dry-run, Ginkgo will write a JSON plan listing the specs that will run, in the order they will run, to the specified location.  Pass --timing-baseline to include each spec's estimated duration.  Useful for tools that shard suites across machines."},
 
 	{KeyPath: "D.SlowSpecThresholdWithFLoatUnits", DeprecatedName: "slowSpecThreshold", DeprecatedDocLink: "changed--slowspecthreshold",
 		Usage: "use --slow-spec-threshold instead and pass in a duration string (e.g. '5s', not '5.0')"},
@@ -380,6 +632,43 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		errors = append(errors, GinkgoErrors.InvalidGoFlagParallel())
 	}
 
+	return append(errors, validateConfig(suiteConfig, reporterConfig)...)
+}
+
+// ConfigValidationError is returned by ValidateConfig and enumerates every problem found with the configuration
+type ConfigValidationError struct {
+	Errors []error
+}
+
+func (e ConfigValidationError) Error() string {
+	out := ""
+	for _, err := range e.Errors {
+		out += err.Error()
+	}
+	return out
+}
+
+// Unwrap allows errors.Is and errors.As to match any of the enumerated errors
+func (e ConfigValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+/*
+ValidateConfig validates that suiteConfig and reporterConfig are sound.  It returns nil if they are, or a ConfigValidationError enumerating the problems it found.
+
+Unlike RunSpecs, which prints any configuration errors and exits, ValidateConfig lets programs that embed Ginkgo inspect configuration problems and handle them as they see fit.
+*/
+func ValidateConfig(suiteConfig SuiteConfig, reporterConfig ReporterConfig) error {
+	errors := validateConfig(suiteConfig, reporterConfig)
+	if len(errors) == 0 {
+		return nil
+	}
+	return ConfigValidationError{Errors: errors}
+}
+
+func validateConfig(suiteConfig SuiteConfig, reporterConfig ReporterConfig) []error {
+	errors := []error{}
+
 	if suiteConfig.ParallelTotal < 1 {
 		errors = append(errors, GinkgoErrors.InvalidParallelTotalConfiguration())
 	}
@@ -396,6 +685,27 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		errors = append(errors, GinkgoErrors.DryRunInParallelConfiguration())
 	}
 
+	if suiteConfig.PerSpecCoverage && suiteConfig.ParallelTotal > 1 {
+		errors = append(errors, GinkgoErrors.PerSpecCoverageInParallelConfiguration())
+	}
+
+	if suiteConfig.IsolateSpecsInSubprocess {
+		// the Ginkgo CLI isolates specs by running the test binary without this flag - if the suite sees it the suite is not being run by the CLI
+		errors = append(errors, GinkgoErrors.IsolateSpecsInSubprocessRequiresCLI())
+	}
+
+	if reporterConfig.SpecManifest != "" && !suiteConfig.DryRun {
+		errors = append(errors, GinkgoErrors.SpecManifestRequiresDryRun())
+	}
+
+	if reporterConfig.PlanOutput != "" && !suiteConfig.DryRun {
+		errors = append(errors, GinkgoErrors.PlanOutputRequiresDryRun())
+	}
+
+	if suiteConfig.RandomizeWithinContainers && suiteConfig.RandomizeAllSpecs {
+		errors = append(errors, GinkgoErrors.RandomizeWithinContainersAndRandomizeAll())
+	}
+
 	if suiteConfig.GracePeriod <= 0 {
 		errors = append(errors, GinkgoErrors.GracePeriodCannotBeZero())
 	}
@@ -414,6 +724,10 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		}
 	}
 
+	if suiteConfig.ShardCount < 0 || suiteConfig.ShardIndex < 0 || (suiteConfig.ShardCount == 0 && suiteConfig.ShardIndex != 0) || (suiteConfig.ShardCount > 0 && suiteConfig.ShardIndex >= suiteConfig.ShardCount) {
+		errors = append(errors, GinkgoErrors.InvalidShardConfiguration(suiteConfig.ShardIndex, suiteConfig.ShardCount))
+	}
+
 	if suiteConfig.LabelFilter != "" {
 		_, err := ParseLabelFilter(suiteConfig.LabelFilter)
 		if err != nil {
@@ -421,14 +735,37 @@ func VetConfig(flagSet GinkgoFlagSet, suiteConfig SuiteConfig, reporterConfig Re
 		}
 	}
 
+	for _, pattern := range suiteConfig.GoroutineLeakAllowlist {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errors = append(errors, GinkgoErrors.InvalidGoroutineLeakAllowlistEntry(pattern, err))
+		}
+	}
+
 	switch strings.ToLower(suiteConfig.OutputInterceptorMode) {
 	case "", "dup", "swap", "none":
 	default:
 		errors = append(errors, GinkgoErrors.InvalidOutputInterceptorModeConfiguration(suiteConfig.OutputInterceptorMode))
 	}
 
+	switch strings.ToLower(suiteConfig.ParallelOutputMode) {
+	case "", ParallelOutputModeOrdered, ParallelOutputModeAsCompleted:
+	default:
+		errors = append(errors, GinkgoErrors.InvalidParallelOutputModeConfiguration(suiteConfig.ParallelOutputMode))
+	}
+
+	labels := []string{}
+	for label := range suiteConfig.LabelTimeoutMultipliers {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		if multiplier := suiteConfig.LabelTimeoutMultipliers[label]; !(multiplier > 0) {
+			errors = append(errors, GinkgoErrors.InvalidLabelTimeoutMultiplier(label, multiplier))
+		}
+	}
+
 	numVerbosity := 0
-	for _, v := range []bool{reporterConfig.Succinct, reporterConfig.Verbose, reporterConfig.VeryVerbose} {
+	for _, v := range []bool{reporterConfig.Succinct, reporterConfig.Verbose, reporterConfig.VeryVerbose, reporterConfig.FailuresOnly} {
 		if v {
 			numVerbosity++
 		}
@@ -469,6 +806,8 @@ var GinkgoCLIRunAndWatchFlags = GinkgoFlags{
 		Usage: "If set, Ginkgo does not merge coverprofiles into one monolithic coverprofile.  The coverprofiles will remain in their respective package directories or in -output-dir if set."},
 	{KeyPath: "C.KeepSeparateReports", Name: "keep-separate-reports", SectionKey: "output",
 		Usage: "If set, Ginkgo does not merge per-suite reports (e.g. -json-report) into one monolithic report for the entire testrun.  The reports will remain in their respective package directories or in -output-dir if set."},
+	{KeyPath: "C.Preset", Name: "preset", SectionKey: "filter", UsageArgument: "name",
+		Usage: "If set, ginkgo applies the filters (label-filter, focus, skip, focus-file, skip-file) defined by the named preset in " + PRESETS_FILE + ".  Filters passed explicitly on the command line take precedence over the preset."},
 
 	{KeyPath: "D.Stream", DeprecatedName: "stream", DeprecatedDocLink: "removed--stream", DeprecatedVersion: "2.0.0"},
 	{KeyPath: "D.Notify", DeprecatedName: "notify", DeprecatedDocLink: "removed--notify", DeprecatedVersion: "2.0.0"},
@@ -484,6 +823,10 @@ var GinkgoCLIRunFlags = GinkgoFlags{
 		Usage: "The number of times to re-run a test-suite.  Useful for debugging flaky tests.  If set to N the suite will be run N+1 times and will be required to pass each time."},
 	{KeyPath: "C.RandomizeSuites", Name: "randomize-suites", SectionKey: "order", DeprecatedName: "randomizeSuites", DeprecatedDocLink: "changed-command-line-flags",
 		Usage: "If set, ginkgo will randomize the order in which test suites run."},
+	{KeyPath: "C.OnlyPreviouslyFlaked", Name: "only-previously-flaked", SectionKey: "filter",
+		Usage: "If set, ginkgo will only run the specs that needed more than one attempt in the run recorded by the JSON report passed to --input."},
+	{KeyPath: "C.Input", Name: "input", SectionKey: "filter", UsageArgument: "filename.json",
+		Usage: "The JSON report (generated by --json-report) of a previous run.  Used by --only-previously-flaked."},
 }
 
 // GinkgoCLIRunFlags provides flags for Ginkgo CLI's watch command that aren't shared by any other commands
@@ -582,6 +925,10 @@ func VetAndInitializeCLIAndGoConfig(cliConfig CLIConfig, goFlagsConfig GoFlagsCo
 		errors = append(errors, GinkgoErrors.BothRepeatAndUntilItFails())
 	}
 
+	if cliConfig.OnlyPreviouslyFlaked && cliConfig.Input == "" {
+		errors = append(errors, GinkgoErrors.OnlyPreviouslyFlakedWithoutInput())
+	}
+
 	//initialize the output directory
 	if cliConfig.OutputDir != "" {
 		err := os.MkdirAll(cliConfig.OutputDir, 0777)
@@ -671,6 +1018,60 @@ func GenerateGoTestRunArgs(goFlagsConfig GoFlagsConfig) ([]string, error) {
 	return args, nil
 }
 
+/*
+GenerateReproducibleFlags returns the Ginkgo CLI flags that reproduce suiteConfig and reporterConfig - for example, to include in a bug report.
+
+Only settings that differ from their defaults are included, with the exception of the random seed which is always included.  When suiteConfig describes one of several parallel processes --procs is included in place of the low-level parallel settings.  Parsing the returned flags with the flag set built by BuildRunCommandFlagSet reproduces every setting that can be configured via the command line.
+*/
+func GenerateReproducibleFlags(suiteConfig SuiteConfig, reporterConfig ReporterConfig) ([]string, error) {
+	defaultSuiteConfig, defaultReporterConfig := NewDefaultSuiteConfig(), NewDefaultReporterConfig()
+	bindings := map[string]interface{}{"S": &suiteConfig, "R": &reporterConfig}
+	defaults := map[string]interface{}{"S": &defaultSuiteConfig, "R": &defaultReporterConfig}
+
+	result := []string{fmt.Sprintf("--seed=%d", suiteConfig.RandomSeed)}
+	if suiteConfig.ParallelTotal > 1 {
+		result = append(result, fmt.Sprintf("--procs=%d", suiteConfig.ParallelTotal))
+	}
+	for _, flag := range SuiteConfigFlags.CopyAppend(ReporterConfigFlags...) {
+		if flag.Name == "" || flag.KeyPath == "S.RandomSeed" {
+			continue
+		}
+		value, ok := valueAtKeyPath(bindings, flag.KeyPath)
+		if !ok {
+			return []string{}, fmt.Errorf("could not load KeyPath: %s", flag.KeyPath)
+		}
+		defaultValue, _ := valueAtKeyPath(defaults, flag.KeyPath)
+		if reflect.DeepEqual(value.Interface(), defaultValue.Interface()) {
+			continue
+		}
+
+		switch v := value.Interface().(type) {
+		case string:
+			result = append(result, fmt.Sprintf("--%s=%s", flag.Name, v))
+		case int, int64:
+			result = append(result, fmt.Sprintf("--%s=%d", flag.Name, v))
+		case float64:
+			result = append(result, fmt.Sprintf("--%s=%s", flag.Name, strconv.FormatFloat(v, 'g', -1, 64)))
+		case bool:
+			if v {
+				result = append(result, fmt.Sprintf("--%s", flag.Name))
+			} else {
+				// settings that default to on can only be turned off explicitly
+				result = append(result, fmt.Sprintf("--%s=false", flag.Name))
+			}
+		case time.Duration:
+			result = append(result, fmt.Sprintf("--%s=%s", flag.Name, v))
+		case []string:
+			for _, s := range v {
+				result = append(result, fmt.Sprintf("--%s=%s", flag.Name, s))
+			}
+		default:
+			return []string{}, fmt.Errorf("unsupported type %T", v)
+		}
+	}
+	return result, nil
+}
+
 // BuildRunCommandFlagSet builds the FlagSet for the `ginkgo run` command
 func BuildRunCommandFlagSet(suiteConfig *SuiteConfig, reporterConfig *ReporterConfig, cliConfig *CLIConfig, goFlagsConfig *GoFlagsConfig) (GinkgoFlagSet, error) {
 	flags := SuiteConfigFlags
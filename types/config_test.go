@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"errors"
 	"flag"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/ginkgo/v2/types"
@@ -196,6 +198,33 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("randomization errors", func() {
+			It("errors if both --randomize-within-containers and --randomize-all are set", func() {
+				suiteConf.RandomizeWithinContainers = true
+				Ω(types.VetConfig(flagSet, suiteConf, repConf)).Should(BeEmpty())
+
+				suiteConf.RandomizeAllSpecs = true
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.RandomizeWithinContainersAndRandomizeAll()))
+			})
+		})
+
+		Describe("subprocess isolation errors", func() {
+			It("errors if the suite is asked to isolate specs in subprocesses as only the Ginkgo CLI can do that", func() {
+				suiteConf.IsolateSpecsInSubprocess = true
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.IsolateSpecsInSubprocessRequiresCLI()))
+			})
+		})
+
+		Describe("label timeout multiplier errors", func() {
+			It("errors if any multiplier is not positive", func() {
+				suiteConf.LabelTimeoutMultipliers = map[string]float64{"integration": 3, "unit": 0, "e2e": -1}
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(Equal([]error{types.GinkgoErrors.InvalidLabelTimeoutMultiplier("e2e", -1), types.GinkgoErrors.InvalidLabelTimeoutMultiplier("unit", 0)}))
+			})
+		})
+
 		Describe("file filter errors", func() {
 			Context("with an invalid --focus-file and/or --skip-file", func() {
 				BeforeEach(func() {
@@ -210,6 +239,22 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("validating sharding configuration", func() {
+			It("errors if the shard index and count are inconsistent", func() {
+				for _, shard := range [][]int{{0, -1}, {-1, 2}, {2, 2}, {1, 0}} {
+					suiteConf.ShardIndex, suiteConf.ShardCount = shard[0], shard[1]
+					errors := types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidShardConfiguration(shard[0], shard[1])))
+				}
+
+				for _, shard := range [][]int{{0, 0}, {0, 1}, {1, 2}} {
+					suiteConf.ShardIndex, suiteConf.ShardCount = shard[0], shard[1]
+					errors := types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(BeEmpty())
+				}
+			})
+		})
+
 		Describe("validating --output-interceptor-mode", func() {
 			It("errors if an invalid output interceptor mode is specified", func() {
 				suiteConf.OutputInterceptorMode = "DURP"
@@ -224,6 +269,29 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("validating --parallel-output-mode", func() {
+			It("errors if an invalid parallel output mode is specified", func() {
+				suiteConf.ParallelOutputMode = "DURP"
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.InvalidParallelOutputModeConfiguration("DURP")))
+
+				for _, value := range []string{"", "ordered", "ORDERED", "as-completed", "AS-COMPLETED"} {
+					suiteConf.ParallelOutputMode = value
+					errors = types.VetConfig(flagSet, suiteConf, repConf)
+					Ω(errors).Should(BeEmpty())
+				}
+			})
+		})
+
+		Describe("validating --goroutine-leak-allowlist", func() {
+			It("errors if an allowlist entry is not a valid regular expression", func() {
+				suiteConf.GoroutineLeakAllowlist = []string{"valid\\.func", "invalid("}
+				errors := types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(HaveLen(1))
+				Ω(errors[0].Error()).Should(ContainSubstring("invalid("))
+			})
+		})
+
 		Context("when more than one verbosity flag is set", func() {
 			It("errors", func() {
 				repConf.Succinct, repConf.Verbose, repConf.VeryVerbose = true, true, false
@@ -241,7 +309,101 @@ var _ = Describe("Config", func() {
 				repConf.Succinct, repConf.Verbose, repConf.VeryVerbose = true, true, true
 				errors = types.VetConfig(flagSet, suiteConf, repConf)
 				Ω(errors).Should(ConsistOf(types.GinkgoErrors.ConflictingVerbosityConfiguration()))
+
+				repConf.Succinct, repConf.Verbose, repConf.VeryVerbose, repConf.FailuresOnly = false, true, false, true
+				errors = types.VetConfig(flagSet, suiteConf, repConf)
+				Ω(errors).Should(ConsistOf(types.GinkgoErrors.ConflictingVerbosityConfiguration()))
 			})
 		})
 	})
+
+	Describe("ValidateConfig", func() {
+		var suiteConf types.SuiteConfig
+		var repConf types.ReporterConfig
+
+		BeforeEach(func() {
+			suiteConf = types.NewDefaultSuiteConfig()
+			repConf = types.NewDefaultReporterConfig()
+		})
+
+		It("returns nil when the configuration is sound", func() {
+			Ω(types.ValidateConfig(suiteConf, repConf)).Should(Succeed())
+		})
+
+		It("returns a ConfigValidationError enumerating every problem", func() {
+			repConf.Succinct, repConf.VeryVerbose = true, true
+			suiteConf.LabelFilter = "(cat"
+			err := types.ValidateConfig(suiteConf, repConf)
+
+			var validationError types.ConfigValidationError
+			Ω(errors.As(err, &validationError)).Should(BeTrue())
+			Ω(validationError.Errors).Should(HaveLen(2))
+			Ω(validationError.Errors).Should(ContainElement(types.GinkgoErrors.ConflictingVerbosityConfiguration()))
+			Ω(err.Error()).Should(ContainSubstring(types.GinkgoErrors.ConflictingVerbosityConfiguration().Error()))
+		})
+
+		It("does not validate go test flags", func() {
+			suiteConf.ParallelTotal = 0
+			err := types.ValidateConfig(suiteConf, repConf)
+			Ω(err).Should(Equal(types.ConfigValidationError{Errors: []error{types.GinkgoErrors.InvalidParallelTotalConfiguration(), types.GinkgoErrors.InvalidParallelProcessConfiguration()}}))
+		})
+	})
+
+	Describe("GenerateReproducibleFlags", func() {
+		var suiteConf types.SuiteConfig
+		var repConf types.ReporterConfig
+
+		BeforeEach(func() {
+			suiteConf = types.NewDefaultSuiteConfig()
+			repConf = types.NewDefaultReporterConfig()
+		})
+
+		parse := func(args []string) (types.SuiteConfig, types.ReporterConfig, types.CLIConfig) {
+			parsedSuiteConf, parsedRepConf := types.NewDefaultSuiteConfig(), types.NewDefaultReporterConfig()
+			cliConf, goFlagsConf := types.NewDefaultCLIConfig(), types.NewDefaultGoFlagsConfig()
+			flagSet, err := types.BuildRunCommandFlagSet(&parsedSuiteConf, &parsedRepConf, &cliConf, &goFlagsConf)
+			Ω(err).ShouldNot(HaveOccurred())
+			remaining, err := flagSet.Parse(args)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(remaining).Should(BeEmpty())
+			return parsedSuiteConf, parsedRepConf, cliConf
+		}
+
+		It("only includes the seed when everything else is at its default", func() {
+			suiteConf.RandomSeed = 17
+			Ω(types.GenerateReproducibleFlags(suiteConf, repConf)).Should(Equal([]string{"--seed=17"}))
+		})
+
+		It("produces flags that, when parsed, reproduce the configuration", func() {
+			suiteConf.RandomSeed = 1138
+			suiteConf.ParallelProcess, suiteConf.ParallelTotal, suiteConf.ParallelHost = 2, 4, "127.0.0.1:9000"
+			suiteConf.LabelFilter = "integration && !slow"
+			suiteConf.FocusStrings = []string{"cat", "dog food"}
+			suiteConf.SkipStrings = []string{"fish"}
+			suiteConf.FocusFiles = []string{"foo_test.go:12"}
+			suiteConf.RandomizeAllSpecs = true
+			suiteConf.FailFast = true
+			suiteConf.FlakeAttempts = 3
+			suiteConf.Timeout = 90 * time.Minute
+			suiteConf.GracePeriod = 0
+			suiteConf.PollProgressAfter = 1500 * time.Millisecond
+			suiteConf.FailOnDurationDeviation = true
+			suiteConf.ShardIndex, suiteConf.ShardCount = 1, 3
+			repConf.NoColor = true
+			repConf.VeryVerbose = true
+			repConf.MaxSpecTextLength = 40
+			repConf.JSONReport = "out.json"
+			repConf.EnvironmentAllowlist = []string{"HOME"}
+
+			flags, err := types.GenerateReproducibleFlags(suiteConf, repConf)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(flags).Should(ContainElements("--seed=1138", "--procs=4", "--label-filter=integration && !slow", "--focus=cat", "--focus=dog food", "--randomize-all", "--fail-fast", "--grace-period=0s"))
+
+			parsedSuiteConf, parsedRepConf, cliConf := parse(flags)
+			Ω(cliConf.Procs).Should(Equal(4))
+			parsedSuiteConf.ParallelProcess, parsedSuiteConf.ParallelTotal, parsedSuiteConf.ParallelHost = suiteConf.ParallelProcess, suiteConf.ParallelTotal, suiteConf.ParallelHost
+			Ω(parsedSuiteConf).Should(Equal(suiteConf))
+			Ω(parsedRepConf).Should(Equal(repConf))
+		})
+	})
 })
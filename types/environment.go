@@ -0,0 +1,19 @@
+package types
+
+// DefaultEnvironmentAllowlist lists the environment variables recorded in a SuiteEnvironment when ReporterConfig.EnvironmentAllowlist is empty
+var DefaultEnvironmentAllowlist = []string{"CI", "GOFLAGS", "GODEBUG", "GOGC", "GOMAXPROCS", "GOMEMLIMIT"}
+
+// SuiteEnvironment captures facts about the environment a suite ran in that can help reproduce a failure.  Ginkgo only records it when ReporterConfig.EmitEnvironmentOnFailure is set.
+type SuiteEnvironment struct {
+	GoVersion  string
+	GOOS       string
+	GOARCH     string
+	GOMAXPROCS int
+	NumCPU     int
+
+	// AvailableMemory is the memory available on the machine, in bytes, when the suite ended.  It is zero if Ginkgo can't determine the available memory on this platform.
+	AvailableMemory uint64 `json:",omitempty"`
+
+	// EnvironmentVariables holds the values of the allowlisted environment variables that were set when the suite ended
+	EnvironmentVariables map[string]string `json:",omitempty"`
+}
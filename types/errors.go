@@ -171,6 +171,24 @@ func (g ginkgoErrors) InvalidDecoratorForNodeType(cl CodeLocation, nodeType Node
 	}
 }
 
+func (g ginkgoErrors) InvalidWarnAtFraction(cl CodeLocation, fraction float64) error {
+	return GinkgoError{
+		Heading:      "Invalid WarnAt Fraction",
+		Message:      formatter.F(`WarnAt must be a fraction of the node's timeout between 0 and 1 (exclusive).  Got %g.`, fraction),
+		CodeLocation: cl,
+		DocLink:      "warning-before-a-timeout-with-warnat",
+	}
+}
+
+func (g ginkgoErrors) InvalidDeclarationOfOrderBeforeAndOrderAfter(cl CodeLocation, nodeType NodeType) error {
+	return GinkgoError{
+		Heading:      "Invalid Combination of Decorators: OrderBefore and OrderAfter",
+		Message:      formatter.F(`[%s] node was decorated with both OrderBefore and OrderAfter.  At most one is allowed.`, nodeType),
+		CodeLocation: cl,
+		DocLink:      "adjusting-the-order-of-setup-nodes",
+	}
+}
+
 func (g ginkgoErrors) InvalidDeclarationOfFocusedAndPending(cl CodeLocation, nodeType NodeType) error {
 	return GinkgoError{
 		Heading:      "Invalid Combination of Decorators: Focused and Pending",
@@ -189,6 +207,15 @@ func (g ginkgoErrors) InvalidDeclarationOfFlakeAttemptsAndMustPassRepeatedly(cl
 	}
 }
 
+func (g ginkgoErrors) InvalidDeclarationOfRepeatWithFlakeAttemptsOrMustPassRepeatedly(cl CodeLocation, nodeType NodeType) error {
+	return GinkgoError{
+		Heading:      "Invalid Combination of Decorators: Repeat with FlakeAttempts or MustPassRepeatedly",
+		Message:      formatter.F(`[%s] node was decorated with Repeat and with FlakeAttempts or MustPassRepeatedly. Repeat always runs the spec a fixed number of times and cannot be combined with either.`, nodeType),
+		CodeLocation: cl,
+		DocLink:      "the-repeat-decorator",
+	}
+}
+
 func (g ginkgoErrors) UnknownDecorator(cl CodeLocation, nodeType NodeType, decorator interface{}) error {
 	return GinkgoError{
 		Heading:      "Unknown Decorator",
@@ -307,6 +334,33 @@ func (g ginkgoErrors) InvalidContinueOnFailureDecoration(cl CodeLocation) error
 	}
 }
 
+func (g ginkgoErrors) SpecDependencyInOrderedContainer(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "DependsOn in an Ordered Container",
+		Message:      "Specs in an Ordered container already run in order and are skipped if an earlier spec fails.  DependsOn cannot be used in an Ordered container.",
+		CodeLocation: cl,
+		DocLink:      "declaring-spec-dependencies",
+	}
+}
+
+func (g ginkgoErrors) UnknownSpecDependency(cl CodeLocation, dependency string) error {
+	return GinkgoError{
+		Heading:      "Unknown Spec Dependency",
+		Message:      fmt.Sprintf("This spec depends on \"%s\" but there is no spec with that text in the same container.  DependsOn can only refer to specs that share the spec's immediate container.", dependency),
+		CodeLocation: cl,
+		DocLink:      "declaring-spec-dependencies",
+	}
+}
+
+func (g ginkgoErrors) CyclicSpecDependencies(cl CodeLocation, cycle []string) error {
+	return GinkgoError{
+		Heading:      "Cyclic Spec Dependencies",
+		Message:      fmt.Sprintf("The dependencies declared with DependsOn form a cycle:\n\t%s", strings.Join(cycle, " -> ")),
+		CodeLocation: cl,
+		DocLink:      "declaring-spec-dependencies",
+	}
+}
+
 /* DeferCleanup errors */
 func (g ginkgoErrors) DeferCleanupInvalidFunction(cl CodeLocation) error {
 	return GinkgoError{
@@ -354,6 +408,33 @@ func (g ginkgoErrors) TooManyReportEntryValues(cl CodeLocation, arg interface{})
 	}
 }
 
+func (g ginkgoErrors) FailSuiteNotDuringRunPhase(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Ginkgo detected an issue with your spec structure",
+		Message:      formatter.F(`It looks like you are calling {{bold}}FailSuite{{/}} outside of a running suite.  Make sure you call {{bold}}FailSuite{{/}} inside a runnable node such as AfterSuite or ReportAfterSuite and not inside the body of a container such as Describe or Context.`),
+		CodeLocation: cl,
+		DocLink:      "failing-the-suite-with-failsuite",
+	}
+}
+
+func (g ginkgoErrors) CollectValueNotDuringRunPhase(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Ginkgo detected an issue with your spec structure",
+		Message:      formatter.F(`It looks like you are calling {{bold}}CollectValue{{/}} outside of a running spec.  Make sure you call {{bold}}CollectValue{{/}} inside a runnable node such as It or BeforeEach and not inside the body of a container such as Describe or Context.`),
+		CodeLocation: cl,
+		DocLink:      "checking-suite-wide-invariants",
+	}
+}
+
+func (g ginkgoErrors) ExpectUniqueOutsideReportAfterSuite(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Ginkgo detected an issue with your spec structure",
+		Message:      formatter.F(`It looks like you are calling {{bold}}ExpectUnique{{/}} outside of a ReportAfterSuite node.  Values collected by specs running on other parallel processes are only available once the suite has finished running - make sure you call {{bold}}ExpectUnique{{/}} inside a {{bold}}ReportAfterSuite{{/}} node.`),
+		CodeLocation: cl,
+		DocLink:      "checking-suite-wide-invariants",
+	}
+}
+
 func (g ginkgoErrors) AddReportEntryNotDuringRunPhase(cl CodeLocation) error {
 	return GinkgoError{
 		Heading:      "Ginkgo detected an issue with your spec structure",
@@ -423,6 +504,32 @@ func (g ginkgoErrors) InvalidLabel(label string, cl CodeLocation) error {
 	}
 }
 
+func (g ginkgoErrors) MaxNestingDepthExceeded(text string, cl CodeLocation, depth int, limit int) error {
+	return GinkgoError{
+		Heading:      "Containers are nested too deeply",
+		Message:      fmt.Sprintf("\"%s\" is nested %d containers deep but --max-nesting-depth is %d.  Consider flattening the container hierarchy.", text, depth, limit),
+		CodeLocation: cl,
+		DocLink:      "limiting-container-nesting-depth",
+	}
+}
+
+func (g ginkgoErrors) TopLevelContainersMissingLabels(texts []string, cls []CodeLocation) error {
+	offenders := &strings.Builder{}
+	cl := CodeLocation{}
+	for i := range texts {
+		fmt.Fprintf(offenders, "\n  \"%s\" at %s", texts[i], cls[i])
+		if i == 0 {
+			cl = cls[i]
+		}
+	}
+	return GinkgoError{
+		Heading:      "Top-level containers are missing labels",
+		Message:      fmt.Sprintf("Every top-level container must be decorated with at least one Label when --require-labels-on-top-level is set.  These top-level containers have no labels:\n%s", offenders.String()),
+		CodeLocation: cl,
+		DocLink:      "requiring-labels-on-top-level-containers",
+	}
+}
+
 func (g ginkgoErrors) InvalidEmptyLabel(cl CodeLocation) error {
 	return GinkgoError{
 		Heading:      "Invalid Empty Label",
@@ -451,6 +558,33 @@ func (g ginkgoErrors) InvalidEntryDescription(cl CodeLocation) error {
 	}
 }
 
+func (g ginkgoErrors) MismatchedEntryDescription(format string, numParameters int, rendered string, cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "EntryDescription does not match the Entry's parameters",
+		Message:      fmt.Sprintf("The EntryDescription format string %q could not be applied to the %d parameter(s) passed in to the Entry - it rendered as:\n\n%s\n\nMake sure the format string has one verb for each parameter and that each verb suits its parameter's type.", format, numParameters, rendered),
+		CodeLocation: cl,
+		DocLink:      "generating-entry-descriptions",
+	}
+}
+
+func (g ginkgoErrors) FailedToLoadTableFile(path string, err error, cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Failed to load table entries from file",
+		Message:      fmt.Sprintf("TableFromFile could not load table entries from %s:\n%s", path, err),
+		CodeLocation: cl,
+		DocLink:      "loading-table-entries-from-a-file",
+	}
+}
+
+func (g ginkgoErrors) InvalidTableEntryFactory(cl CodeLocation) error {
+	return GinkgoError{
+		Heading:      "Invalid TableFromFile entry factory",
+		Message:      "The entry factory passed to TableFromFile must be a function that accepts a single record and returns a TableEntry.",
+		CodeLocation: cl,
+		DocLink:      "loading-table-entries-from-a-file",
+	}
+}
+
 func (g ginkgoErrors) MissingParametersForTableFunction(cl CodeLocation) error {
 	return GinkgoError{
 		Heading:      "No parameters have been passed to the Table Function",
@@ -533,7 +667,15 @@ func (g ginkgoErrors) SynchronizedBeforeSuiteDisappearedOnProc1() error {
 func (g ginkgoErrors) UnknownTypePassedToRunSpecs(value interface{}) error {
 	return GinkgoError{
 		Heading: "Unknown Type passed to RunSpecs",
-		Message: fmt.Sprintf("RunSpecs() accepts labels, and configuration of type types.SuiteConfig and/or types.ReporterConfig.\n You passed in: %v", value),
+		Message: fmt.Sprintf("RunSpecs() accepts labels, configuration of type types.SuiteConfig and/or types.ReporterConfig, and reporters.ReporterSet.\n You passed in: %v", value),
+	}
+}
+
+func (g ginkgoErrors) DuplicateReportOutputPath(path string) error {
+	return GinkgoError{
+		Heading: "Duplicate Report Output Path",
+		Message: fmt.Sprintf("More than one report has been configured to be written to %s.  Each report must be written to its own file.", path),
+		DocLink: "generating-machine-readable-reports",
 	}
 }
 
@@ -578,6 +720,69 @@ func (g ginkgoErrors) DryRunInParallelConfiguration() error {
 	}
 }
 
+func (g ginkgoErrors) PerSpecCoverageInParallelConfiguration() error {
+	return GinkgoError{
+		Heading: "Ginkgo only records per-spec coverage in serial mode.",
+		Message: "Please try running ginkgo --per-spec-coverage again, but without -p or -procs to ensure the suite is running in series.",
+	}
+}
+
+func (g ginkgoErrors) IsolateSpecsInSubprocessRequiresCLI() error {
+	return GinkgoError{
+		Heading: "--isolate-specs-in-subprocess requires the Ginkgo CLI",
+		Message: "The Ginkgo CLI isolates specs by running the suite's test binary once for each spec.  Please run the suite with ginkgo --isolate-specs-in-subprocess instead of go test (and don't set SuiteConfig.IsolateSpecsInSubprocess in the suite).",
+		DocLink: "isolating-specs-in-subprocesses",
+	}
+}
+
+func (g ginkgoErrors) IsolateSpecsInSubprocessInParallel() error {
+	return GinkgoError{
+		Heading: "Ginkgo only isolates specs in subprocesses in serial mode.",
+		Message: "Please try running ginkgo --isolate-specs-in-subprocess again, but without -p or -procs to ensure the suite is running in series.",
+		DocLink: "isolating-specs-in-subprocesses",
+	}
+}
+
+func (g ginkgoErrors) SpecManifestRequiresDryRun() error {
+	return GinkgoError{
+		Heading: "--emit-spec-manifest requires --dry-run",
+		Message: "Ginkgo only emits a spec manifest when discovering specs without running them.  Please run ginkgo --dry-run --emit-spec-manifest=PATH.",
+		DocLink: "generating-a-spec-manifest",
+	}
+}
+
+func (g ginkgoErrors) PlanOutputRequiresDryRun() error {
+	return GinkgoError{
+		Heading: "--plan-output requires --dry-run",
+		Message: "Ginkgo only emits a spec plan when discovering specs without running them.  Please run ginkgo --dry-run --plan-output=PATH.",
+		DocLink: "generating-a-spec-plan",
+	}
+}
+
+func (g ginkgoErrors) RandomizeWithinContainersAndRandomizeAll() error {
+	return GinkgoError{
+		Heading: "Conflicting randomization flags",
+		Message: "--randomize-within-containers keeps containers in declaration order while --randomize-all shuffles every spec.  Please set only one of them.",
+		DocLink: "randomizing-specs-within-containers",
+	}
+}
+
+func (g ginkgoErrors) FailedToLoadTimingBaseline(path string, err error) error {
+	return GinkgoError{
+		Heading: "Failed to load timing baseline",
+		Message: fmt.Sprintf("Ginkgo was asked to use --timing-baseline but failed to load the JSON report at %s:\n%s", path, err),
+		DocLink: "generating-a-spec-plan",
+	}
+}
+
+func (g ginkgoErrors) InvalidShardConfiguration(shardIndex int, shardCount int) error {
+	return GinkgoError{
+		Heading: "Invalid sharding configuration",
+		Message: fmt.Sprintf("Ginkgo was asked to run shard %d of %d.  When sharding, --shard-count must be positive and --shard-index must be between 0 and --shard-count - 1.", shardIndex, shardCount),
+		DocLink: "sharding-specs-across-ci-jobs",
+	}
+}
+
 func (g ginkgoErrors) GracePeriodCannotBeZero() error {
 	return GinkgoError{
 		Heading: "Ginkgo requires a positive --grace-period.",
@@ -588,7 +793,7 @@ func (g ginkgoErrors) GracePeriodCannotBeZero() error {
 func (g ginkgoErrors) ConflictingVerbosityConfiguration() error {
 	return GinkgoError{
 		Heading: "Conflicting reporter verbosity settings.",
-		Message: "You can't set more than one of -v, -vv and --succinct.  Please pick one!",
+		Message: "You can't set more than one of -v, -vv, --succinct and --failures-only.  Please pick one!",
 	}
 }
 
@@ -599,6 +804,29 @@ func (g ginkgoErrors) InvalidOutputInterceptorModeConfiguration(value string) er
 	}
 }
 
+func (g ginkgoErrors) InvalidParallelOutputModeConfiguration(value string) error {
+	return GinkgoError{
+		Heading: fmt.Sprintf("Invalid value '%s' for --parallel-output-mode.", value),
+		Message: "You must choose one of 'ordered' or 'as-completed'.",
+	}
+}
+
+func (g ginkgoErrors) InvalidLabelTimeoutMultiplier(label string, multiplier float64) error {
+	return GinkgoError{
+		Heading: "Invalid LabelTimeoutMultipliers entry",
+		Message: fmt.Sprintf("The timeout multiplier for label %q is %v.  Timeout multipliers must be positive.", label, multiplier),
+		DocLink: "scaling-timeouts-by-label",
+	}
+}
+
+func (g ginkgoErrors) InvalidGoroutineLeakAllowlistEntry(pattern string, err error) error {
+	return GinkgoError{
+		Heading: "Invalid --goroutine-leak-allowlist entry",
+		Message: fmt.Sprintf("The --goroutine-leak-allowlist entry %s is not a valid regular expression.  regexp.Compile error: %s", pattern, err),
+		DocLink: "detecting-leaked-goroutines",
+	}
+}
+
 func (g ginkgoErrors) InvalidGoFlagCount() error {
 	return GinkgoError{
 		Heading: "Use of go test -count",
@@ -613,6 +841,38 @@ func (g ginkgoErrors) InvalidGoFlagParallel() error {
 	}
 }
 
+func (g ginkgoErrors) FailedToLoadPresets(path string, err error) error {
+	return GinkgoError{
+		Heading: "Failed to load presets",
+		Message: fmt.Sprintf("Ginkgo was asked to apply a --preset but failed to load the presets defined in %s:\n%s", path, err),
+		DocLink: "running-named-presets",
+	}
+}
+
+func (g ginkgoErrors) UnknownPreset(name string, path string, available []string) error {
+	return GinkgoError{
+		Heading: fmt.Sprintf("Unknown preset \"%s\"", name),
+		Message: fmt.Sprintf("%s does not define a preset named \"%s\".  Available presets: %s", path, name, strings.Join(available, ", ")),
+		DocLink: "running-named-presets",
+	}
+}
+
+func (g ginkgoErrors) FailedToLoadPreviousReport(path string, err error) error {
+	return GinkgoError{
+		Heading: "Failed to load previous report",
+		Message: fmt.Sprintf("Ginkgo was asked to run --only-previously-flaked but failed to load the JSON report at %s:\n%s", path, err),
+		DocLink: "rerunning-previously-flaked-specs",
+	}
+}
+
+func (g ginkgoErrors) OnlyPreviouslyFlakedWithoutInput() error {
+	return GinkgoError{
+		Heading: "--only-previously-flaked requires --input",
+		Message: "--only-previously-flaked selects the specs that flaked in a previous run.  Use --input to point Ginkgo at the JSON report generated by that run (e.g. --input=report.json).",
+		DocLink: "rerunning-previously-flaked-specs",
+	}
+}
+
 func (g ginkgoErrors) BothRepeatAndUntilItFails() error {
 	return GinkgoError{
 		Heading: "--repeat and --until-it-fails are both set",
@@ -620,6 +880,14 @@ func (g ginkgoErrors) BothRepeatAndUntilItFails() error {
 	}
 }
 
+func (g ginkgoErrors) JSONReportToStdoutWithKeepSeparateReports() error {
+	return GinkgoError{
+		Heading: "--json-report=- and --keep-separate-reports are both set",
+		Message: "--json-report=- directs Ginkgo to write a single merged JSON report to stdout.  --keep-separate-reports directs Ginkgo to write a separate report for each suite.  You can't set both... which would you like?",
+		DocLink: "writing-the-json-report-to-stdout",
+	}
+}
+
 /* Stack-Trace parsing errors */
 
 func (g ginkgoErrors) FailedToParseStackTrace(message string) error {
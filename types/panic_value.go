@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PanicValue captures structured information about the value a spec panicked with.  It complements Failure.ForwardedPanic, which only captures the value's string representation.
+type PanicValue struct {
+	// Type is the name of the recovered value's type, e.g. "*books.ValidationError"
+	Type string
+
+	// Message is the value's error message if it implements error, and its string representation otherwise
+	Message string
+
+	// JSON is the value encoded as JSON.  It is empty if the value could not be encoded.
+	JSON json.RawMessage `json:",omitempty"`
+
+	// ErrorChain is populated if the value implements error.  It contains the errors the value wraps (as returned by Unwrap()), in the order errors.Is would visit them.  The value itself is not included.
+	ErrorChain []PanicValueError `json:",omitempty"`
+}
+
+// PanicValueError describes an error in a PanicValue's ErrorChain
+type PanicValueError struct {
+	Type    string
+	Message string
+}
+
+// NewPanicValue returns a PanicValue describing value, as recovered from a panic
+func NewPanicValue(value interface{}) *PanicValue {
+	pv := &PanicValue{
+		Type:    fmt.Sprintf("%T", value),
+		Message: fmt.Sprintf("%v", value),
+		JSON:    panicValueJSON(value),
+	}
+	if err, ok := value.(error); ok {
+		pv.Message = err.Error()
+		pv.ErrorChain = unwrapErrorChain(err)
+	}
+	return pv
+}
+
+func panicValueJSON(value interface{}) (encoded json.RawMessage) {
+	// a value's MarshalJSON method could itself panic - in which case we simply go without
+	defer func() {
+		if e := recover(); e != nil {
+			encoded = nil
+		}
+	}()
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+func unwrapErrorChain(err error) []PanicValueError {
+	var chain []PanicValueError
+	var visit func(err error)
+	visit = func(err error) {
+		var wrapped []error
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			if unwrapped := x.Unwrap(); unwrapped != nil {
+				wrapped = []error{unwrapped}
+			}
+		case interface{ Unwrap() []error }:
+			wrapped = x.Unwrap()
+		}
+		for _, e := range wrapped {
+			if e == nil {
+				continue
+			}
+			chain = append(chain, PanicValueError{Type: fmt.Sprintf("%T", e), Message: e.Error()})
+			visit(e)
+		}
+	}
+	visit(err)
+	return chain
+}
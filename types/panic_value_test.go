@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+type joinedErrors []error
+
+func (j joinedErrors) Error() string   { return "joined" }
+func (j joinedErrors) Unwrap() []error { return j }
+
+type explosiveValue struct{}
+
+func (explosiveValue) MarshalJSON() ([]byte, error) { panic("kaboom") }
+
+var _ = Describe("PanicValue", func() {
+	It("describes non-error values", func() {
+		Ω(types.NewPanicValue(map[string]int{"a": 1})).Should(Equal(&types.PanicValue{
+			Type:    "map[string]int",
+			Message: "map[a:1]",
+			JSON:    json.RawMessage(`{"a":1}`),
+		}))
+	})
+
+	It("walks the full chain of wrapped errors, including errors that wrap multiple errors", func() {
+		base := errors.New("base")
+		err := fmt.Errorf("outer: %w", joinedErrors{fmt.Errorf("middle: %w", base), errors.New("sibling")})
+		pv := types.NewPanicValue(err)
+		Ω(pv.Message).Should(Equal("outer: joined"))
+		Ω(pv.ErrorChain).Should(Equal([]types.PanicValueError{
+			{Type: "types_test.joinedErrors", Message: "joined"},
+			{Type: "*fmt.wrapError", Message: "middle: base"},
+			{Type: "*errors.errorString", Message: "base"},
+			{Type: "*errors.errorString", Message: "sibling"},
+		}))
+	})
+
+	It("goes without JSON if encoding the value panics", func() {
+		pv := types.NewPanicValue(explosiveValue{})
+		Ω(pv.Type).Should(Equal("types_test.explosiveValue"))
+		Ω(pv.JSON).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+)
+
+// ThatNeededMultipleAttempts returns the SpecReports for specs that were run more than once because they were retried with FlakeAttempts
+func (reports SpecReports) ThatNeededMultipleAttempts() SpecReports {
+	out := SpecReports{}
+	for i := range reports {
+		if reports[i].NumAttempts > 1 && reports[i].MaxMustPassRepeatedly <= 1 && reports[i].MaxRepeat == 0 {
+			out = append(out, reports[i])
+		}
+	}
+	return out
+}
+
+/*
+LoadPreviouslyFlakedFocusStrings reads the JSON report at path (i.e. one generated by --json-report) and returns a focus string for every spec that needed more than one attempt to run.
+
+Each focus string matches exactly one spec: the spec's suite description followed by its full text.  Specs that have since been renamed or removed simply won't match.
+*/
+func LoadPreviouslyFlakedFocusStrings(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, GinkgoErrors.FailedToLoadPreviousReport(path, err)
+	}
+	reports := []Report{}
+	if err := json.Unmarshal(content, &reports); err != nil {
+		return nil, GinkgoErrors.FailedToLoadPreviousReport(path, err)
+	}
+	focusStrings := []string{}
+	for _, report := range reports {
+		for _, specReport := range report.SpecReports.ThatNeededMultipleAttempts() {
+			focusStrings = append(focusStrings, "^"+regexp.QuoteMeta(report.SuiteDescription+" "+specReport.FullText())+"$")
+		}
+	}
+	return focusStrings, nil
+}
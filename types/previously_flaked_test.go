@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Previously flaked specs", func() {
+	var reportPath string
+
+	BeforeEach(func() {
+		reportPath = filepath.Join(GinkgoT().TempDir(), "report.json")
+		reports := []types.Report{{
+			SuiteDescription: "My Suite",
+			SpecReports: types.SpecReports{
+				{ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: "passes", NumAttempts: 1, State: types.SpecStatePassed},
+				{ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: "flakes (sometimes)", NumAttempts: 2, MaxFlakeAttempts: 3, State: types.SpecStatePassed},
+				{ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: "always fails", NumAttempts: 3, MaxFlakeAttempts: 3, State: types.SpecStateFailed},
+				{ContainerHierarchyTexts: []string{"cart"}, LeafNodeText: "must pass repeatedly", NumAttempts: 3, MaxMustPassRepeatedly: 3, State: types.SpecStatePassed},
+			},
+		}}
+		data, err := json.Marshal(reports)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(os.WriteFile(reportPath, data, 0644)).Should(Succeed())
+	})
+
+	It("returns a focus string matching exactly each spec that needed more than one attempt", func() {
+		focusStrings, err := types.LoadPreviouslyFlakedFocusStrings(reportPath)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(focusStrings).Should(HaveLen(2))
+
+		matches := func(text string) bool {
+			for _, focusString := range focusStrings {
+				if regexp.MustCompile(focusString).MatchString(text) {
+					return true
+				}
+			}
+			return false
+		}
+		Ω(matches("My Suite cart flakes (sometimes)")).Should(BeTrue())
+		Ω(matches("My Suite cart always fails")).Should(BeTrue())
+		Ω(matches("My Suite cart passes")).Should(BeFalse())
+		Ω(matches("My Suite cart must pass repeatedly")).Should(BeFalse())
+		Ω(matches("My Suite cart flakes (sometimes) and then some")).Should(BeFalse())
+	})
+
+	It("errors when the report can't be loaded", func() {
+		_, err := types.LoadPreviouslyFlakedFocusStrings(filepath.Join(filepath.Dir(reportPath), "missing.json"))
+		Ω(err).Should(HaveOccurred())
+		Ω(err.Error()).Should(ContainSubstring("Failed to load previous report"))
+
+		Ω(os.WriteFile(reportPath, []byte("{not json"), 0644)).Should(Succeed())
+		_, err = types.LoadPreviouslyFlakedFocusStrings(reportPath)
+		Ω(err).Should(HaveOccurred())
+		Ω(err.Error()).Should(ContainSubstring("Failed to load previous report"))
+	})
+})
@@ -0,0 +1,41 @@
+package types
+
+import "time"
+
+// SpecDiff describes how a single spec changed between two reports.  Old* fields are zero for specs that were added and New* fields are zero for specs that were removed.
+type SpecDiff struct {
+	// FullText is the spec's full text - it is used to match specs across the two reports
+	FullText         string
+	LeafNodeLocation CodeLocation
+
+	OldState   SpecState
+	NewState   SpecState
+	OldRunTime time.Duration
+	NewRunTime time.Duration
+}
+
+// RunTimeChange returns the difference between the spec's new and old RunTime
+func (diff SpecDiff) RunTimeChange() time.Duration {
+	return diff.NewRunTime - diff.OldRunTime
+}
+
+// ReportDiff captures the differences between two reports of the same suite.  It is generated by reporters.DiffReports
+type ReportDiff struct {
+	SuiteDescription string
+
+	// NewlyFailing contains specs that failed in the new report but did not fail in the old report
+	NewlyFailing []SpecDiff
+	// NewlyPassing contains specs that passed in the new report but failed in the old report
+	NewlyPassing []SpecDiff
+	// Added contains specs that only appear in the new report
+	Added []SpecDiff
+	// Removed contains specs that only appear in the old report
+	Removed []SpecDiff
+	// TimingChanged contains specs that ran in both reports and whose RunTime changed by more than the configured threshold
+	TimingChanged []SpecDiff
+}
+
+// HasChanges returns true if any spec appears in any of the diff's categories
+func (diff ReportDiff) HasChanges() bool {
+	return len(diff.NewlyFailing)+len(diff.NewlyPassing)+len(diff.Added)+len(diff.Removed)+len(diff.TimingChanged) > 0
+}
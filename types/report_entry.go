@@ -85,6 +85,10 @@ func (rev *ReportEntryValue) GobDecode(data []byte) error {
 type ReportEntry struct {
 	// Visibility captures the visibility policy for this ReportEntry
 	Visibility ReportEntryVisibility
+	// Severity captures the severity of this ReportEntry - Ginkgo's console reporter colors entries by severity
+	Severity ReportEntrySeverity
+	// Target routes this ReportEntry into a specific part of Ginkgo's machine-readable reports (e.g. JUnit testcase properties)
+	Target ReportEntryTarget `json:",omitempty"`
 	// Location captures the location of the AddReportEntry call
 	Location CodeLocation
 
@@ -97,6 +101,9 @@ type ReportEntry struct {
 	// anything the user wants.  The value passed to AddReportEntry is wrapped in a ReportEntryValue to make
 	// encoding/decoding the value easier.  To access the raw value call entry.GetRawValue()
 	Value ReportEntryValue
+
+	// Children captures any nested ReportEntries passed into AddReportEntry (see NestedReportEntry)
+	Children ReportEntries `json:",omitempty"`
 }
 
 // ColorableStringer is an interface that ReportEntry values can satisfy.  If they do then ColorableString() is used to generate their representation.
@@ -137,6 +144,30 @@ func (re ReportEntries) HasVisibility(visibilities ...ReportEntryVisibility) boo
 	return false
 }
 
+func (re ReportEntries) WithMinSeverity(severity ReportEntrySeverity) ReportEntries {
+	out := ReportEntries{}
+
+	for _, entry := range re {
+		if entry.Severity >= severity {
+			out = append(out, entry)
+		}
+	}
+
+	return out
+}
+
+func (re ReportEntries) WithTarget(target ReportEntryTarget) ReportEntries {
+	out := ReportEntries{}
+
+	for _, entry := range re {
+		if entry.Target == target {
+			out = append(out, entry)
+		}
+	}
+
+	return out
+}
+
 func (re ReportEntries) WithVisibility(visibilities ...ReportEntryVisibility) ReportEntries {
 	out := ReportEntries{}
 
@@ -188,3 +219,60 @@ func (v ReportEntryVisibility) Is(visibilities ...ReportEntryVisibility) bool {
 
 	return false
 }
+
+// ReportEntrySeverity captures the severity of a ReportEntry.  Ginkgo's console reporter colors ReportEntries by severity and suppresses entries below ReporterConfig.MinReportEntrySeverity
+type ReportEntrySeverity uint
+
+const (
+	// The default severity - an informational ReportEntry
+	ReportEntrySeverityInfo ReportEntrySeverity = iota
+	// A ReportEntry that warrants attention; rendered in orange
+	ReportEntrySeverityWarning
+	// A ReportEntry that captures an error; rendered in red
+	ReportEntrySeverityError
+)
+
+var resEnumSupport = NewEnumSupport(map[uint]string{
+	uint(ReportEntrySeverityInfo):    "info",
+	uint(ReportEntrySeverityWarning): "warning",
+	uint(ReportEntrySeverityError):   "error",
+})
+
+func (res ReportEntrySeverity) String() string {
+	return resEnumSupport.String(uint(res))
+}
+func (res *ReportEntrySeverity) UnmarshalJSON(b []byte) error {
+	out, err := resEnumSupport.UnmarshJSON(b)
+	*res = ReportEntrySeverity(out)
+	return err
+}
+func (res ReportEntrySeverity) MarshalJSON() ([]byte, error) {
+	return resEnumSupport.MarshJSON(uint(res))
+}
+
+// ReportEntryTarget routes a ReportEntry into a specific part of Ginkgo's machine-readable reports.  ReportEntries always appear in the JSON report regardless of their target.
+type ReportEntryTarget uint
+
+const (
+	// The default target - the ReportEntry is not routed anywhere in particular
+	ReportEntryTargetNone ReportEntryTarget = iota
+	// The ReportEntry is emitted as a <property> of the spec's <testcase> in JUnit reports
+	ReportEntryTargetJUnitProperty
+)
+
+var retEnumSupport = NewEnumSupport(map[uint]string{
+	uint(ReportEntryTargetNone):          "none",
+	uint(ReportEntryTargetJUnitProperty): "junit-property",
+})
+
+func (ret ReportEntryTarget) String() string {
+	return retEnumSupport.String(uint(ret))
+}
+func (ret *ReportEntryTarget) UnmarshalJSON(b []byte) error {
+	out, err := retEnumSupport.UnmarshJSON(b)
+	*ret = ReportEntryTarget(out)
+	return err
+}
+func (ret ReportEntryTarget) MarshalJSON() ([]byte, error) {
+	return retEnumSupport.MarshJSON(uint(ret))
+}
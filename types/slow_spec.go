@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+// SlowSpec identifies a spec whose RunTime exceeded a threshold.  SlowSpecs are generated by reporters.ListSlowSpecs
+type SlowSpec struct {
+	SuiteDescription string
+	FullText         string
+	LeafNodeLocation CodeLocation
+	State            SpecState
+	RunTime          time.Duration
+}
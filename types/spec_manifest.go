@@ -0,0 +1,77 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// SpecManifest lists every spec in a suite.  It is generated from the suite's runtime spec tree by running the suite with --dry-run --emit-spec-manifest=PATH
+// and is intended for tools (e.g. IDEs and test explorers) that need to discover specs without running them.
+type SpecManifest struct {
+	SuiteDescription string
+	SuitePath        string
+	Specs            []SpecManifestEntry
+}
+
+// SpecManifestEntry describes a single spec in a SpecManifest
+type SpecManifestEntry struct {
+	// ID identifies the spec.  It is derived from the spec's full text and location and so is stable across runs, regardless of spec order.
+	ID string
+
+	FullText                string
+	ContainerHierarchyTexts []string
+	LeafNodeText            string
+	Labels                  []string
+	Location                CodeLocation
+
+	// Focused is true if the spec, or one of its containers, is programmatically focused
+	Focused bool
+	// Pending is true if the spec is marked Pending
+	Pending bool
+	// WillRun is true if the spec would run given the filters the manifest was generated with
+	WillRun bool
+}
+
+// NewSpecManifest generates a SpecManifest from the specs in report.  Entries are sorted by location so that the manifest does not depend on the order the specs ran in.
+func NewSpecManifest(report Report) SpecManifest {
+	manifest := SpecManifest{
+		SuiteDescription: report.SuiteDescription,
+		SuitePath:        report.SuitePath,
+		Specs:            []SpecManifestEntry{},
+	}
+	for _, spec := range report.SpecReports.WithLeafNodeType(NodeTypeIt) {
+		manifest.Specs = append(manifest.Specs, SpecManifestEntry{
+			FullText:                spec.FullText(),
+			ContainerHierarchyTexts: spec.ContainerHierarchyTexts,
+			LeafNodeText:            spec.LeafNodeText,
+			Labels:                  spec.Labels(),
+			Location:                CodeLocation{FileName: spec.LeafNodeLocation.FileName, LineNumber: spec.LeafNodeLocation.LineNumber},
+			Focused:                 spec.IsFocused,
+			Pending:                 spec.State.Is(SpecStatePending),
+			WillRun:                 !spec.State.Is(SpecStatePending | SpecStateSkipped),
+		})
+	}
+	sort.SliceStable(manifest.Specs, func(i, j int) bool {
+		a, b := manifest.Specs[i], manifest.Specs[j]
+		if a.Location.FileName != b.Location.FileName {
+			return a.Location.FileName < b.Location.FileName
+		}
+		if a.Location.LineNumber != b.Location.LineNumber {
+			return a.Location.LineNumber < b.Location.LineNumber
+		}
+		return a.FullText < b.FullText
+	})
+	seen := map[string]int{}
+	for i := range manifest.Specs {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s", manifest.Specs[i].Location, manifest.Specs[i].FullText)))
+		id := hex.EncodeToString(hash[:8])
+		seen[id] += 1
+		if seen[id] > 1 {
+			id = fmt.Sprintf("%s-%d", id, seen[id])
+		}
+		manifest.Specs[i].ID = id
+	}
+	return manifest
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SpecManifest", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			SpecReports: types.SpecReports{
+				{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed},
+				{ContainerHierarchyTexts: []string{"books"}, LeafNodeText: "can be read", LeafNodeLabels: []string{"slow"}, LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "b_test.go", LineNumber: 3}, State: types.SpecStatePassed},
+				{ContainerHierarchyTexts: []string{"books"}, ContainerHierarchyLabels: [][]string{{"library"}}, LeafNodeText: "can be written", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 10}, State: types.SpecStatePending},
+				{LeafNodeText: "is focused", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 2, FullStackTrace: "stack"}, State: types.SpecStatePassed, IsFocused: true},
+				{LeafNodeText: "is filtered out", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 5}, State: types.SpecStateSkipped},
+			},
+		}
+	})
+
+	It("lists each spec, sorted by location", func() {
+		manifest := types.NewSpecManifest(report)
+		Ω(manifest.SuiteDescription).Should(Equal("My Suite"))
+		Ω(manifest.SuitePath).Should(Equal("/path/to/suite"))
+
+		texts := []string{}
+		for _, spec := range manifest.Specs {
+			texts = append(texts, spec.FullText)
+		}
+		Ω(texts).Should(Equal([]string{"is focused", "is filtered out", "books can be written", "books can be read"}))
+
+		Ω(manifest.Specs[0].Focused).Should(BeTrue())
+		Ω(manifest.Specs[0].WillRun).Should(BeTrue())
+		Ω(manifest.Specs[0].Location).Should(Equal(types.CodeLocation{FileName: "a_test.go", LineNumber: 2}))
+		Ω(manifest.Specs[1].WillRun).Should(BeFalse())
+		Ω(manifest.Specs[2].Pending).Should(BeTrue())
+		Ω(manifest.Specs[2].WillRun).Should(BeFalse())
+		Ω(manifest.Specs[2].Labels).Should(Equal([]string{"library"}))
+		Ω(manifest.Specs[3].Labels).Should(Equal([]string{"slow"}))
+		Ω(manifest.Specs[3].ContainerHierarchyTexts).Should(Equal([]string{"books"}))
+		Ω(manifest.Specs[3].LeafNodeText).Should(Equal("can be read"))
+	})
+
+	It("generates IDs that do not depend on the order of the specs", func() {
+		manifest := types.NewSpecManifest(report)
+		report.SpecReports[1], report.SpecReports[4] = report.SpecReports[4], report.SpecReports[1]
+		Ω(types.NewSpecManifest(report)).Should(Equal(manifest))
+
+		ids := map[string]bool{}
+		for _, spec := range manifest.Specs {
+			Ω(spec.ID).Should(HaveLen(16))
+			ids[spec.ID] = true
+		}
+		Ω(ids).Should(HaveLen(4))
+	})
+
+	It("disambiguates specs with identical texts and locations", func() {
+		report.SpecReports = append(report.SpecReports, report.SpecReports[1])
+		manifest := types.NewSpecManifest(report)
+		Ω(manifest.Specs).Should(HaveLen(5))
+		Ω(manifest.Specs[4].ID).Should(Equal(manifest.Specs[3].ID + "-2"))
+	})
+})
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// SpecPlan lists the specs that will run, in the order they will run.  It is generated from the suite's runtime spec tree by running the suite with --dry-run --plan-output=PATH
+// and is intended for tools that shard suites across machines.
+type SpecPlan struct {
+	SuiteDescription string
+	SuitePath        string
+
+	// EstimatedDuration is the sum of the EstimatedDuration of every planned spec
+	EstimatedDuration time.Duration
+	Specs             []SpecPlanEntry
+}
+
+// SpecPlanEntry describes a single spec in a SpecPlan
+type SpecPlanEntry struct {
+	// ID is the spec's ID (see SpecReport.ID).  Pass it to --focus-spec-id to run the spec.
+	ID string
+
+	FullText string
+	Labels   []string
+	Location CodeLocation
+
+	// IsSerial is true if the spec is decorated with Serial
+	IsSerial bool
+	// IsInOrderedContainer is true if the spec appears in an Ordered container.  The specs in an Ordered container must run together, in order, on the same process.
+	IsInOrderedContainer bool
+	// OrderedContainerID identifies the Ordered container the spec appears in (see SpecReport.OrderedContainerID)
+	OrderedContainerID uint `json:",omitempty"`
+
+	// EstimatedDuration is the spec's runtime in the timing baseline.  It is zero if no baseline was provided or if the baseline does not include the spec.
+	EstimatedDuration time.Duration `json:",omitempty"`
+}
+
+// NewSpecPlan generates a SpecPlan from the specs in report that will run, in the order they appear in the report.  costs (see SuiteConfig.SpecCosts) provides each spec's estimated duration and may be nil.
+func NewSpecPlan(report Report, costs map[string]time.Duration) SpecPlan {
+	plan := SpecPlan{
+		SuiteDescription: report.SuiteDescription,
+		SuitePath:        report.SuitePath,
+		Specs:            []SpecPlanEntry{},
+	}
+	for _, spec := range report.SpecReports.WithLeafNodeType(NodeTypeIt) {
+		if spec.State.Is(SpecStatePending | SpecStateSkipped) {
+			continue
+		}
+		entry := SpecPlanEntry{
+			ID:                   spec.ID,
+			FullText:             spec.FullText(),
+			Labels:               spec.Labels(),
+			Location:             CodeLocation{FileName: spec.LeafNodeLocation.FileName, LineNumber: spec.LeafNodeLocation.LineNumber},
+			IsSerial:             spec.IsSerial,
+			IsInOrderedContainer: spec.IsInOrderedContainer,
+			OrderedContainerID:   spec.OrderedContainerID,
+			EstimatedDuration:    costs[spec.FullText()],
+		}
+		plan.EstimatedDuration += entry.EstimatedDuration
+		plan.Specs = append(plan.Specs, entry)
+	}
+	return plan
+}
+
+// LoadSpecCosts reads the JSON report at path (i.e. one generated by --json-report) and returns the SpecCosts (see SpecReports.SpecCosts) recorded for the suite with the passed-in description
+func LoadSpecCosts(path string, suiteDescription string) (map[string]time.Duration, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, GinkgoErrors.FailedToLoadTimingBaseline(path, err)
+	}
+	reports := []Report{}
+	if err := json.Unmarshal(content, &reports); err != nil {
+		return nil, GinkgoErrors.FailedToLoadTimingBaseline(path, err)
+	}
+	costs := map[string]time.Duration{}
+	for _, report := range reports {
+		if report.SuiteDescription != suiteDescription {
+			continue
+		}
+		for text, cost := range report.SpecReports.SpecCosts() {
+			costs[text] = cost
+		}
+	}
+	return costs, nil
+}
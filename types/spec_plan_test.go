@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SpecPlan", func() {
+	var report types.Report
+
+	BeforeEach(func() {
+		report = types.Report{
+			SuiteDescription: "My Suite",
+			SuitePath:        "/path/to/suite",
+			SpecReports: types.SpecReports{
+				{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed},
+				{ID: "b", ContainerHierarchyTexts: []string{"books"}, LeafNodeText: "can be read", LeafNodeLabels: []string{"slow"}, LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "b_test.go", LineNumber: 3}, State: types.SpecStatePassed, IsSerial: true},
+				{ID: "p", ContainerHierarchyTexts: []string{"books"}, LeafNodeText: "can be written", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 10}, State: types.SpecStatePending},
+				{ID: "a", LeafNodeText: "is ordered", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 2, FullStackTrace: "stack"}, State: types.SpecStatePassed, IsInOrderedContainer: true, OrderedContainerID: 7},
+				{ID: "s", LeafNodeText: "is filtered out", LeafNodeType: types.NodeTypeIt, LeafNodeLocation: types.CodeLocation{FileName: "a_test.go", LineNumber: 5}, State: types.SpecStateSkipped},
+			},
+		}
+	})
+
+	It("lists the specs that will run, in the order they appear in the report", func() {
+		plan := types.NewSpecPlan(report, nil)
+		Ω(plan.SuiteDescription).Should(Equal("My Suite"))
+		Ω(plan.SuitePath).Should(Equal("/path/to/suite"))
+		Ω(plan.EstimatedDuration).Should(BeZero())
+		Ω(plan.Specs).Should(Equal([]types.SpecPlanEntry{
+			{ID: "b", FullText: "books can be read", Labels: []string{"slow"}, Location: types.CodeLocation{FileName: "b_test.go", LineNumber: 3}, IsSerial: true},
+			{ID: "a", FullText: "is ordered", Labels: []string{}, Location: types.CodeLocation{FileName: "a_test.go", LineNumber: 2}, IsInOrderedContainer: true, OrderedContainerID: 7},
+		}))
+	})
+
+	It("includes the estimated duration of each spec that has a cost", func() {
+		plan := types.NewSpecPlan(report, map[string]time.Duration{"books can be read": time.Second, "is filtered out": time.Minute})
+		Ω(plan.Specs[0].EstimatedDuration).Should(Equal(time.Second))
+		Ω(plan.Specs[1].EstimatedDuration).Should(BeZero())
+		Ω(plan.EstimatedDuration).Should(Equal(time.Second))
+	})
+
+	Describe("LoadSpecCosts", func() {
+		var baselinePath string
+
+		BeforeEach(func() {
+			baselinePath = filepath.Join(GinkgoT().TempDir(), "baseline.json")
+			data, err := json.Marshal([]types.Report{
+				{SuiteDescription: "My Suite", SpecReports: types.SpecReports{
+					{LeafNodeText: "A", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, RunTime: time.Second},
+				}},
+				{SuiteDescription: "Other Suite", SpecReports: types.SpecReports{
+					{LeafNodeText: "B", LeafNodeType: types.NodeTypeIt, State: types.SpecStatePassed, RunTime: time.Minute},
+				}},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(os.WriteFile(baselinePath, data, 0644)).Should(Succeed())
+		})
+
+		It("returns the costs recorded for the matching suite", func() {
+			costs, err := types.LoadSpecCosts(baselinePath, "My Suite")
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(costs).Should(Equal(map[string]time.Duration{"A": time.Second}))
+		})
+
+		It("errors when the baseline cannot be loaded", func() {
+			_, err := types.LoadSpecCosts(filepath.Join(filepath.Dir(baselinePath), "missing.json"), "My Suite")
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("Failed to load timing baseline"))
+
+			Ω(os.WriteFile(baselinePath, []byte("{"), 0644)).Should(Succeed())
+			_, err = types.LoadSpecCosts(baselinePath, "My Suite")
+			Ω(err.Error()).Should(ContainSubstring("Failed to load timing baseline"))
+		})
+	})
+})
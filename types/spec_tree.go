@@ -0,0 +1,33 @@
+package types
+
+/*
+SpecTreeNode describes a container or subject node in the static structure of a suite.  Report.SpecTree holds the top-level nodes and each container lists its nested containers and subject nodes, in the order they were defined, in Children.
+
+The tree includes every container and spec defined in the suite - including those that did not run because they were pending or did not match the suite's focus and filters.
+*/
+type SpecTreeNode struct {
+	// NodeType is NodeTypeContainer for containers (Describe, Context, When, DescribeTable, etc.) and NodeTypeIt for subject nodes (It, Specify, Entry, etc.)
+	NodeType     NodeType
+	Text         string
+	CodeLocation CodeLocation
+
+	// Labels are the labels applied directly to this node - they do not include labels inherited from the node's containers
+	Labels []string `json:",omitempty"`
+
+	// SpecID is the ID of the spec generated by a subject node (see SpecReport.ID).  It is empty for containers.
+	SpecID string `json:",omitempty"`
+
+	// Pending is true if the node, or one of its containers, is marked pending
+	Pending bool `json:",omitempty"`
+
+	// Skipped is true if the spec was skipped by the suite's focus, filters, or sharding (for containers that are not marked pending: if none of the specs in the container will run).
+	// Pending specs are never run but are reported as Pending and not as Skipped.
+	Skipped bool `json:",omitempty"`
+
+	Children []SpecTreeNode `json:",omitempty"`
+}
+
+// WillRun returns true if the spec (or, for containers, at least one spec in the container) was selected to run
+func (node SpecTreeNode) WillRun() bool {
+	return !node.Pending && !node.Skipped
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -45,6 +47,12 @@ type Report struct {
 	//and how many it intends to run (PreRunStats.SpecsThatWillRun) after applying any relevant focus or skip filters.
 	PreRunStats PreRunStats
 
+	//TotalSpecsToRun is the number of specs Ginkgo will report on via DidRun - this includes specs that will be skipped or are pending.
+	//Fewer specs are reported if the suite ends early (for example, if a BeforeSuite fails).
+	//When running in series the spec whose SpecReport.Index equals TotalSpecsToRun is the last spec in the suite.
+	//When running in parallel each process indexes its own specs so reporters must instead count the specs they've received.
+	TotalSpecsToRun int `json:",omitempty"`
+
 	//StartTime and EndTime capture the start and end time of the test run
 	StartTime time.Time
 	EndTime   time.Time
@@ -57,23 +65,61 @@ type Report struct {
 	//such as the random seed and any filters applied during the test run
 	SuiteConfig SuiteConfig
 
+	//ReporterConfig captures the reporter configuration in effect for this test run - i.e. after command-line flags, presets, and any CIOverrides have been applied
+	ReporterConfig ReporterConfig
+
 	//SpecReports is a list of all SpecReports generated by this test run
 	//It is empty when the SuiteReport is provided to ReportBeforeSuite
 	SpecReports SpecReports
+
+	//FlakyReports summarizes the specs that only passed after being retried via FlakeAttempts
+	//It is populated at the end of the test run and is empty when the SuiteReport is provided to ReportBeforeSuite
+	FlakyReports []FlakyReport `json:",omitempty"`
+
+	//ParallelEfficiency summarizes how well the available parallel processes were utilized
+	//It is populated at the end of parallel test runs and is nil for serial test runs
+	ParallelEfficiency *ParallelEfficiency `json:",omitempty"`
+
+	//SuiteOutcomeDeterminedByPredicate is true if SuiteConfig.SuiteSuccessPredicate changed the value of SuiteSucceeded
+	SuiteOutcomeDeterminedByPredicate bool `json:",omitempty"`
+
+	//Environment captures facts about the environment the suite ran in that can help reproduce a failure
+	//It is only populated, at the end of the test run, when ReporterConfig.EmitEnvironmentOnFailure is set
+	Environment *SuiteEnvironment `json:",omitempty"`
+
+	//SpecTree captures the static structure of the suite: every container and spec, nested as they were defined, whether or not they ran
+	//It is populated before the test run begins and is, therefore, available to ReportBeforeSuite
+	SpecTree []SpecTreeNode `json:",omitempty"`
+
+	//MaxNestingDepth is the depth of the most deeply nested container in the suite - top-level containers have a depth of 1
+	//Like SpecTree it is computed from the static structure of the suite and so includes containers that did not run
+	MaxNestingDepth int `json:",omitempty"`
 }
 
 // PreRunStats contains a set of stats captured before the test run begins.  This is primarily used
 // by Ginkgo's reporter to tell the user how many specs are in the current suite (PreRunStats.TotalSpecs)
 // and how many it intends to run (PreRunStats.SpecsThatWillRun) after applying any relevant focus or skip filters.
+//
+// A "spec" is a single subject node (an It, Specify, or Benchmark) together with the containers and setup nodes that
+// surround it.  Each table Entry generates exactly one spec.  Containers, setup nodes, and suite-level nodes such as
+// BeforeSuite are never counted as specs - they are counted separately by TotalContainers and TotalSetupNodes.
 type PreRunStats struct {
+	// TotalSpecs is the number of specs in the suite.  SpecsThatWillRun is the number that remain after applying filters and pending decorators.
 	TotalSpecs       int
 	SpecsThatWillRun int
+
+	// TotalContainers is the number of distinct container nodes (Describe, Context, When, DescribeTable) that contain at least one spec
+	TotalContainers int
+
+	// TotalSetupNodes is the number of distinct BeforeEach, JustBeforeEach, AfterEach, JustAfterEach, BeforeAll, and AfterAll nodes that apply to at least one spec
+	TotalSetupNodes int
 }
 
 // Add is used by Ginkgo's parallel aggregation mechanisms to combine test run reports form individual parallel processes
 // to form a complete final report.
 func (report Report) Add(other Report) Report {
 	report.SuiteSucceeded = report.SuiteSucceeded && other.SuiteSucceeded
+	report.SuiteOutcomeDeterminedByPredicate = report.SuiteOutcomeDeterminedByPredicate || other.SuiteOutcomeDeterminedByPredicate
 
 	if other.StartTime.Before(report.StartTime) {
 		report.StartTime = other.StartTime
@@ -104,6 +150,21 @@ func (report Report) Add(other Report) Report {
 	}
 
 	report.SpecReports = reports
+	if len(other.FlakyReports) > 0 {
+		report.FlakyReports = append(append([]FlakyReport{}, report.FlakyReports...), other.FlakyReports...)
+	}
+	if report.ParallelEfficiency != nil || other.ParallelEfficiency != nil {
+		report.ParallelEfficiency = report.ParallelEfficiency.add(other.ParallelEfficiency, report.RunTime)
+	}
+	if report.Environment == nil {
+		report.Environment = other.Environment
+	}
+	if report.SpecTree == nil {
+		report.SpecTree = other.SpecTree
+	}
+	if other.MaxNestingDepth > report.MaxNestingDepth {
+		report.MaxNestingDepth = other.MaxNestingDepth
+	}
 	return report
 }
 
@@ -138,6 +199,18 @@ type SpecReport struct {
 	// IsInOrderedContainer captures whether the spec appears in an Ordered container
 	IsInOrderedContainer bool
 
+	// OrderedContainerID identifies the outermost Ordered container the spec appears in.  It is zero if the spec is not in an Ordered container.
+	OrderedContainerID uint `json:",omitempty"`
+
+	// IsFocused captures whether the spec, or one of its containers, is programmatically focused (e.g. with FIt or FDescribe)
+	IsFocused bool `json:",omitempty"`
+
+	// IsInformational captures whether the spec, or one of its containers, has the Informational decorator.  The outcome of an informational spec is recorded but does not affect whether the suite succeeds.
+	IsInformational bool `json:",omitempty"`
+
+	// IsVerbose captures whether the spec, or one of its containers, has the VerboseOutput decorator.  Reporters render verbose specs as though -v had been set, regardless of the configured verbosity.
+	IsVerbose bool `json:",omitempty"`
+
 	// StartTime and EndTime capture the start and end time of the spec
 	StartTime time.Time
 	EndTime   time.Time
@@ -148,6 +221,14 @@ type SpecReport struct {
 	// ParallelProcess captures the parallel process that this spec ran on
 	ParallelProcess int
 
+	// Index captures the position, starting at 1, of this spec in the order in which the specs ran on ParallelProcess.
+	// It is zero for reports generated by suite-level nodes like BeforeSuite.  See Report.TotalSpecsToRun to identify the last spec.
+	Index int
+
+	// ID is a stable identifier for the spec computed from the texts and code locations of the spec's containers and subject.  Pass it to SuiteConfig.FocusSpecIDs (i.e. --focus-spec-id) to rerun just this spec.
+	// It is empty for reports generated by suite-level nodes like BeforeSuite.
+	ID string
+
 	// RunningInParallel captures whether this spec is part of a suite that ran in parallel
 	RunningInParallel bool
 
@@ -166,6 +247,15 @@ type SpecReport struct {
 	// MaxMustPassRepeatedly captures whether the spec has the MustPassRepeatedly decorator
 	MaxMustPassRepeatedly int
 
+	// MaxRepeat captures whether the spec has the Repeat decorator
+	MaxRepeat int
+
+	// RepeatStates captures the outcome of each iteration of a spec decorated with Repeat, in the order the iterations ran
+	RepeatStates []SpecState
+
+	// ExpectedDuration captures how long the spec was expected to take, as declared with the ExpectedDuration decorator.  It is zero if no expectation was declared.
+	ExpectedDuration time.Duration
+
 	// CapturedGinkgoWriterOutput contains text printed to the GinkgoWriter
 	CapturedGinkgoWriterOutput string
 
@@ -174,6 +264,15 @@ type SpecReport struct {
 	// It is used internally by Ginkgo's reporter
 	CapturedStdOutErr string
 
+	// CapturedStdOut and CapturedStdErr contain the text printed to stdout and to stderr, respectively, when running in parallel with --separate-stdout-stderr.
+	// CapturedStdOutErr is still populated with both when they are.
+	CapturedStdOut string
+	CapturedStdErr string
+
+	// CapturedOutputSegments records when each chunk of CapturedStdOutErr and CapturedGinkgoWriterOutput was written so that the two can be interleaved chronologically (see InterleavedOutput()).
+	// It is only populated when running with --interleave-output.
+	CapturedOutputSegments []CapturedOutputSegment
+
 	// ReportEntries contains any reports added via `AddReportEntry`
 	ReportEntries ReportEntries
 
@@ -185,6 +284,27 @@ type SpecReport struct {
 
 	// SpecEvents capture additional events that occur during the spec run
 	SpecEvents SpecEvents
+
+	// PeakRSSDelta captures how much the peak resident set size of the process grew, in bytes, while the spec ran.
+	// It is only populated when running with --capture-resource-usage and is set to -1 on platforms where resource usage cannot be captured.
+	PeakRSSDelta int64
+
+	// CoveredFiles lists the source files (identified by import path, e.g. github.com/org/pkg/file.go) that the spec exercised.
+	// It is only populated when running with --per-spec-coverage.
+	CoveredFiles []string
+
+	// LogFilePath is the path to the file the spec's captured stdout/stderr and GinkgoWriter output were written to.
+	// It is only populated when running with --per-spec-log-dir and the spec captured some output.
+	LogFilePath string
+
+	// BenchmarkStats captures the timing statistics computed by a Benchmark spec.  It is nil for all other specs.
+	BenchmarkStats *BenchmarkStats
+
+	// SlowSpecStack captures the spec's goroutine at the moment a spec decorated with CaptureStackIfSlowerThan exceeded its threshold.  It is nil for all other specs.
+	SlowSpecStack *SlowSpecStack
+
+	// CollectedValues contains any values collected via `CollectValue`.  Only the values collected by the final attempt of a retried spec are kept.
+	CollectedValues []CollectedValue
 }
 
 func (report SpecReport) MarshalJSON() ([]byte, error) {
@@ -202,16 +322,30 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		EndTime                     time.Time
 		RunTime                     time.Duration
 		ParallelProcess             int
+		Index                       int      `json:",omitempty"`
+		ID                          string   `json:",omitempty"`
 		Failure                     *Failure `json:",omitempty"`
 		NumAttempts                 int
 		MaxFlakeAttempts            int
 		MaxMustPassRepeatedly       int
-		CapturedGinkgoWriterOutput  string              `json:",omitempty"`
-		CapturedStdOutErr           string              `json:",omitempty"`
-		ReportEntries               ReportEntries       `json:",omitempty"`
-		ProgressReports             []ProgressReport    `json:",omitempty"`
-		AdditionalFailures          []AdditionalFailure `json:",omitempty"`
-		SpecEvents                  SpecEvents          `json:",omitempty"`
+		MaxRepeat                   int                     `json:",omitempty"`
+		RepeatStates                []SpecState             `json:",omitempty"`
+		ExpectedDuration            time.Duration           `json:",omitempty"`
+		CapturedGinkgoWriterOutput  string                  `json:",omitempty"`
+		CapturedStdOutErr           string                  `json:",omitempty"`
+		CapturedStdOut              string                  `json:",omitempty"`
+		CapturedStdErr              string                  `json:",omitempty"`
+		CapturedOutputSegments      []CapturedOutputSegment `json:",omitempty"`
+		ReportEntries               ReportEntries           `json:",omitempty"`
+		ProgressReports             []ProgressReport        `json:",omitempty"`
+		AdditionalFailures          []AdditionalFailure     `json:",omitempty"`
+		SpecEvents                  SpecEvents              `json:",omitempty"`
+		PeakRSSDelta                int64                   `json:",omitempty"`
+		CoveredFiles                []string                `json:",omitempty"`
+		LogFilePath                 string                  `json:",omitempty"`
+		BenchmarkStats              *BenchmarkStats         `json:",omitempty"`
+		SlowSpecStack               *SlowSpecStack          `json:",omitempty"`
+		CollectedValues             []CollectedValue        `json:",omitempty"`
 	}{
 		ContainerHierarchyTexts:     report.ContainerHierarchyTexts,
 		ContainerHierarchyLocations: report.ContainerHierarchyLocations,
@@ -225,13 +359,27 @@ func (report SpecReport) MarshalJSON() ([]byte, error) {
 		EndTime:                     report.EndTime,
 		RunTime:                     report.RunTime,
 		ParallelProcess:             report.ParallelProcess,
+		Index:                       report.Index,
+		ID:                          report.ID,
 		Failure:                     nil,
 		ReportEntries:               nil,
 		NumAttempts:                 report.NumAttempts,
 		MaxFlakeAttempts:            report.MaxFlakeAttempts,
 		MaxMustPassRepeatedly:       report.MaxMustPassRepeatedly,
+		MaxRepeat:                   report.MaxRepeat,
+		RepeatStates:                report.RepeatStates,
+		ExpectedDuration:            report.ExpectedDuration,
 		CapturedGinkgoWriterOutput:  report.CapturedGinkgoWriterOutput,
 		CapturedStdOutErr:           report.CapturedStdOutErr,
+		CapturedStdOut:              report.CapturedStdOut,
+		CapturedStdErr:              report.CapturedStdErr,
+		CapturedOutputSegments:      report.CapturedOutputSegments,
+		PeakRSSDelta:                report.PeakRSSDelta,
+		CoveredFiles:                report.CoveredFiles,
+		LogFilePath:                 report.LogFilePath,
+		BenchmarkStats:              report.BenchmarkStats,
+		SlowSpecStack:               report.SlowSpecStack,
+		CollectedValues:             report.CollectedValues,
 	}
 
 	if !report.Failure.IsZero() {
@@ -266,6 +414,42 @@ func (report SpecReport) CombinedOutput() string {
 	return report.CapturedStdOutErr + "\n" + report.CapturedGinkgoWriterOutput
 }
 
+// RepeatCounts returns the number of iterations of a spec decorated with Repeat that passed and that failed
+func (report SpecReport) RepeatCounts() (passed int, failed int) {
+	for _, state := range report.RepeatStates {
+		if state.Is(SpecStatePassed) {
+			passed += 1
+		} else if state.Is(SpecStateFailureStates) {
+			failed += 1
+		}
+	}
+	return passed, failed
+}
+
+// ExpectedDurationTolerance is the factor by which a spec's RunTime must exceed (or fall short of) its ExpectedDuration for Ginkgo to flag the deviation
+const ExpectedDurationTolerance = 2.0
+
+// DeviatesFromExpectedDuration returns true if the spec declared an ExpectedDuration and its RunTime was at least ExpectedDurationTolerance times longer, or shorter, than expected
+func (report SpecReport) DeviatesFromExpectedDuration() bool {
+	if report.ExpectedDuration <= 0 || report.RunTime <= 0 {
+		return false
+	}
+	ratio := float64(report.RunTime) / float64(report.ExpectedDuration)
+	return ratio >= ExpectedDurationTolerance || ratio <= 1/ExpectedDurationTolerance
+}
+
+// DurationDeviation describes how far the spec's RunTime deviated from its ExpectedDuration - e.g. "2.5x slower" or "4x faster".  It returns an empty string if the spec does not deviate from its ExpectedDuration.
+func (report SpecReport) DurationDeviation() string {
+	if !report.DeviatesFromExpectedDuration() {
+		return ""
+	}
+	ratio, direction := float64(report.RunTime)/float64(report.ExpectedDuration), "slower"
+	if ratio < 1 {
+		ratio, direction = 1/ratio, "faster"
+	}
+	return fmt.Sprintf("%sx %s", strconv.FormatFloat(math.Round(ratio*10)/10, 'f', -1, 64), direction)
+}
+
 // Failed returns true if report.State is one of the SpecStateFailureStates
 // (SpecStateFailed, SpecStatePanicked, SpecStateinterrupted, SpecStateAborted)
 func (report SpecReport) Failed() bool {
@@ -399,6 +583,28 @@ func (reports SpecReports) WithState(states SpecState) SpecReports {
 	return out
 }
 
+// Informational returns the subset of SpecReports for specs decorated with Informational
+func (reports SpecReports) Informational() SpecReports {
+	out := SpecReports{}
+	for i := range reports {
+		if reports[i].IsInformational {
+			out = append(out, reports[i])
+		}
+	}
+	return out
+}
+
+// WithoutInformational returns the subset of SpecReports for specs that are not decorated with Informational
+func (reports SpecReports) WithoutInformational() SpecReports {
+	out := SpecReports{}
+	for i := range reports {
+		if !reports[i].IsInformational {
+			out = append(out, reports[i])
+		}
+	}
+	return out
+}
+
 // CountWithState returns the number of SpecReports with State matching one of the requested SpecStates
 func (reports SpecReports) CountWithState(states SpecState) int {
 	n := 0
@@ -421,6 +627,40 @@ func (reports SpecReports) CountOfFlakedSpecs() int {
 	return n
 }
 
+// FlakyReports returns a FlakyReport for every SpecReport that passed after multiple attempts
+func (reports SpecReports) FlakyReports() []FlakyReport {
+	var flakyReports []FlakyReport
+	for i := range reports {
+		if !(reports[i].MaxFlakeAttempts > 1 && reports[i].State.Is(SpecStatePassed) && reports[i].NumAttempts > 1) {
+			continue
+		}
+		flakyReport := FlakyReport{
+			ContainerHierarchyTexts: reports[i].ContainerHierarchyTexts,
+			LeafNodeText:            reports[i].LeafNodeText,
+			LeafNodeLocation:        reports[i].LeafNodeLocation,
+			NumAttempts:             reports[i].NumAttempts,
+		}
+		for _, additionalFailure := range reports[i].AdditionalFailures {
+			if additionalFailure.Attempt > 0 {
+				flakyReport.AttemptFailures = append(flakyReport.AttemptFailures, additionalFailure)
+			}
+		}
+		flakyReports = append(flakyReports, flakyReport)
+	}
+	return flakyReports
+}
+
+// SpecCosts returns the RunTime of every It spec that ran, keyed by the spec's FullText().  Pass the result (typically loaded from a previous run's JSON report) to SuiteConfig.SpecCosts to balance parallel runs.
+func (reports SpecReports) SpecCosts() map[string]time.Duration {
+	costs := map[string]time.Duration{}
+	for i := range reports {
+		if reports[i].LeafNodeType == NodeTypeIt && reports[i].State.Is(SpecStatePassed|SpecStateFailureStates) {
+			costs[reports[i].FullText()] = reports[i].RunTime
+		}
+	}
+	return costs
+}
+
 // If the Spec fails, CountOfRepeatedSpecs returns the number of SpecReports that passed after multiple attempts
 func (reports SpecReports) CountOfRepeatedSpecs() int {
 	n := 0
@@ -432,6 +672,82 @@ func (reports SpecReports) CountOfRepeatedSpecs() int {
 	return n
 }
 
+// SpecCounts captures the number of specs in a particular grouping (e.g. a top-level container or a label) broken down by whether or not they will run.
+// Focused counts the programmatically focused specs and overlaps with the other counts.
+type SpecCounts struct {
+	Name    string
+	Total   int
+	WillRun int
+	Pending int
+	Skipped int
+	Focused int
+}
+
+func (sc *SpecCounts) add(report SpecReport) {
+	sc.Total += 1
+	if report.IsFocused {
+		sc.Focused += 1
+	}
+	switch {
+	case report.State.Is(SpecStatePending):
+		sc.Pending += 1
+	case report.State.Is(SpecStateSkipped):
+		sc.Skipped += 1
+	default:
+		sc.WillRun += 1
+	}
+}
+
+// SpecCountSummary captures a breakdown of the specs in a suite by top-level container and by label.
+// It is used by Ginkgo's default reporter to implement --spec-count-summary
+type SpecCountSummary struct {
+	//Total captures counts for all specs in the suite
+	Total SpecCounts
+
+	//ByContainer captures counts for each top-level container, sorted by container name.
+	//Specs that are not in a container are grouped under an entry with an empty Name
+	ByContainer []SpecCounts
+
+	//ByLabel captures counts for each label, sorted by label.  A spec with multiple labels is counted once under each label.
+	ByLabel []SpecCounts
+}
+
+// CountSummary computes a SpecCountSummary for the It specs in SpecReports.  Specs that are neither pending nor skipped are counted as WillRun.
+func (reports SpecReports) CountSummary() SpecCountSummary {
+	summary := SpecCountSummary{}
+	byContainer, byLabel := map[string]*SpecCounts{}, map[string]*SpecCounts{}
+	for _, report := range reports.WithLeafNodeType(NodeTypeIt) {
+		summary.Total.add(report)
+		container := ""
+		if len(report.ContainerHierarchyTexts) > 0 {
+			container = report.ContainerHierarchyTexts[0]
+		}
+		if byContainer[container] == nil {
+			byContainer[container] = &SpecCounts{Name: container}
+		}
+		byContainer[container].add(report)
+		for _, label := range report.Labels() {
+			if byLabel[label] == nil {
+				byLabel[label] = &SpecCounts{Name: label}
+			}
+			byLabel[label].add(report)
+		}
+	}
+
+	summary.ByContainer = sortedSpecCounts(byContainer)
+	summary.ByLabel = sortedSpecCounts(byLabel)
+	return summary
+}
+
+func sortedSpecCounts(counts map[string]*SpecCounts) []SpecCounts {
+	out := []SpecCounts{}
+	for _, count := range counts {
+		out = append(out, *count)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
 // TimelineLocation captures the location of an event in the spec's timeline
 type TimelineLocation struct {
 	//Offset is the offset (in bytes) of the event relative to the GinkgoWriter stream
@@ -468,6 +784,17 @@ func (t Timeline) WithoutHiddenReportEntries() Timeline {
 	return out
 }
 
+func (t Timeline) WithoutReportEntriesBelowSeverity(severity ReportEntrySeverity) Timeline {
+	out := Timeline{}
+	for _, event := range t {
+		if reportEntry, isReportEntry := event.(ReportEntry); isReportEntry && reportEntry.Severity < severity {
+			continue
+		}
+		out = append(out, event)
+	}
+	return out
+}
+
 func (t Timeline) WithoutVeryVerboseSpecEvents() Timeline {
 	out := Timeline{}
 	for _, event := range t {
@@ -497,6 +824,10 @@ type Failure struct {
 	// then ForwardedPanic will be populated with a string representation of the captured panic.
 	ForwardedPanic string `json:",omitempty"`
 
+	// PanicValue - if the failure represents a captured panic then PanicValue will be populated with structured information about the captured value:
+	// its type, its message, its JSON representation (if it can be encoded) and, if it is an error, the chain of errors it wraps.
+	PanicValue *PanicValue `json:",omitempty"`
+
 	// FailureNodeContext - one of three contexts describing the node in which the failure occurred:
 	// FailureNodeIsLeafNode means the failure occurred in the leaf node of the associated SpecReport. None of the other FailureNode fields will be populated
 	// FailureNodeAtTopLevel means the failure occurred in a non-leaf node that is defined at the top-level of the spec (i.e. not in a container). FailureNodeType and FailureNodeLocation will be populated.
@@ -518,6 +849,25 @@ type Failure struct {
 
 	//AdditionalFailure is non-nil if a follow-on failure occurred within the same node after the primary failure.  This only happens when a node has timed out or been interrupted.  In such cases the AdditionalFailure can include information about where/why the spec was stuck.
 	AdditionalFailure *AdditionalFailure `json:",omitempty"`
+
+	//TimeoutDetails is populated if the spec timed out or was interrupted.  It records the time budget that was in play, how much of it had elapsed, and which node was running.
+	TimeoutDetails *TimeoutDetails `json:",omitempty"`
+}
+
+// TimeoutDetails attributes a timeout or interrupt to the time budget that was in play and the node that was running when it occurred
+type TimeoutDetails struct {
+	// Timeout identifies the timeout that was in play: "node", "spec", "suite", or "grace period".  It is empty if no timeout was in play (e.g. when a node without a timeout is interrupted).
+	Timeout string `json:",omitempty"`
+
+	// Budget is the duration of the timeout that was in play (e.g. the NodeTimeout or SpecTimeout).  It is zero if no timeout was in play.
+	Budget time.Duration `json:",omitempty"`
+
+	// Elapsed is how much time had elapsed when the timeout occurred or the interrupt was received.  It is measured from the start of the budget (e.g. the start of the node for a NodeTimeout or of the spec for a SpecTimeout) or, if no timeout was in play, from the start of the running node.
+	Elapsed time.Duration
+
+	// NodeType and NodeText identify the node that was running
+	NodeType NodeType
+	NodeText string `json:",omitempty"`
 }
 
 func (f Failure) IsZero() bool {
@@ -564,6 +914,98 @@ func (fnc FailureNodeContext) MarshalJSON() ([]byte, error) {
 type AdditionalFailure struct {
 	State   SpecState
 	Failure Failure
+
+	// Attempt is the attempt (starting at 1) that recorded this failure.  It is only set for failures recorded by attempts that were then retried because of FlakeAttempts.
+	Attempt int `json:",omitempty"`
+}
+
+// FlakyReport summarizes a spec that only passed after being retried via FlakeAttempts
+type FlakyReport struct {
+	ContainerHierarchyTexts []string
+	LeafNodeText            string
+	LeafNodeLocation        CodeLocation
+
+	// NumAttempts is the number of attempts it took for the spec to pass
+	NumAttempts int
+
+	// AttemptFailures captures the failures recorded by each failed attempt, in order
+	AttemptFailures []AdditionalFailure
+}
+
+// FullText returns a concatenation of all the report.ContainerHierarchyTexts and report.LeafNodeText
+func (report FlakyReport) FullText() string {
+	texts := []string{}
+	texts = append(texts, report.ContainerHierarchyTexts...)
+	if report.LeafNodeText != "" {
+		texts = append(texts, report.LeafNodeText)
+	}
+	return strings.Join(texts, " ")
+}
+
+// ParallelEfficiency summarizes how well a parallel test run utilized its parallel processes
+type ParallelEfficiency struct {
+	// TotalSpecTime is the sum of the run times of every spec (including suite setup nodes) across all processes
+	TotalSpecTime time.Duration
+
+	// WallTime is the wall-clock duration of the test run
+	WallTime time.Duration
+
+	// NumProcesses is the number of parallel processes
+	NumProcesses int
+
+	// ProcessBusyTime maps each parallel process (starting at 1) to the total time it spent running specs
+	ProcessBusyTime map[int]time.Duration
+}
+
+// NewParallelEfficiency computes the ParallelEfficiency of report from the run times and parallel process assignments of its SpecReports
+func NewParallelEfficiency(report Report) ParallelEfficiency {
+	efficiency := ParallelEfficiency{
+		WallTime:        report.RunTime,
+		NumProcesses:    report.SuiteConfig.ParallelTotal,
+		ProcessBusyTime: map[int]time.Duration{},
+	}
+	for _, spec := range report.SpecReports {
+		efficiency.TotalSpecTime += spec.RunTime
+		efficiency.ProcessBusyTime[spec.ParallelProcess] += spec.RunTime
+	}
+	return efficiency
+}
+
+// Efficiency returns the fraction (between 0 and 1) of the available process time that was spent running specs
+func (efficiency ParallelEfficiency) Efficiency() float64 {
+	if efficiency.WallTime <= 0 || efficiency.NumProcesses <= 0 {
+		return 0
+	}
+	return float64(efficiency.TotalSpecTime) / (float64(efficiency.WallTime) * float64(efficiency.NumProcesses))
+}
+
+// IdleTime returns the time the passed-in parallel process spent not running specs
+func (efficiency ParallelEfficiency) IdleTime(process int) time.Duration {
+	idle := efficiency.WallTime - efficiency.ProcessBusyTime[process]
+	if idle < 0 {
+		return 0
+	}
+	return idle
+}
+
+func (efficiency *ParallelEfficiency) add(other *ParallelEfficiency, wallTime time.Duration) *ParallelEfficiency {
+	combined := &ParallelEfficiency{
+		WallTime:        wallTime,
+		ProcessBusyTime: map[int]time.Duration{},
+	}
+	for _, e := range []*ParallelEfficiency{efficiency, other} {
+		if e == nil {
+			continue
+		}
+		combined.TotalSpecTime += e.TotalSpecTime
+		if e.NumProcesses > combined.NumProcesses {
+			combined.NumProcesses = e.NumProcesses
+		}
+		for process, busy := range e.ProcessBusyTime {
+			combined.ProcessBusyTime[process] += busy
+		}
+	}
+	return combined
 }
 
 func (f AdditionalFailure) GetTimelineLocation() TimelineLocation {
@@ -709,6 +1151,20 @@ func (pr ProgressReport) GetTimelineLocation() TimelineLocation {
 	return pr.TimelineLocation
 }
 
+// SlowSpecStack is captured when a spec decorated with CaptureStackIfSlowerThan runs for longer than its threshold.  It describes what the spec was doing at the moment the threshold elapsed.
+type SlowSpecStack struct {
+	// Threshold is the duration passed to CaptureStackIfSlowerThan
+	Threshold time.Duration
+
+	// CurrentNodeType, CurrentNodeText, and CurrentNodeLocation describe the node that was running when the threshold elapsed
+	CurrentNodeType     NodeType
+	CurrentNodeText     string `json:",omitempty"`
+	CurrentNodeLocation CodeLocation
+
+	// Goroutine is the spec's goroutine, as captured when the threshold elapsed
+	Goroutine Goroutine
+}
+
 type Goroutine struct {
 	ID              uint64
 	State           string
@@ -770,11 +1226,13 @@ const (
 	NodeTypeCleanupAfterEach
 	NodeTypeCleanupAfterAll
 	NodeTypeCleanupAfterSuite
+
+	NodeTypeAfterAllProcesses
 )
 
 var NodeTypesForContainerAndIt = NodeTypeContainer | NodeTypeIt
-var NodeTypesForSuiteLevelNodes = NodeTypeBeforeSuite | NodeTypeSynchronizedBeforeSuite | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeReportBeforeSuite | NodeTypeReportAfterSuite | NodeTypeCleanupAfterSuite
-var NodeTypesAllowedDuringCleanupInterrupt = NodeTypeAfterEach | NodeTypeJustAfterEach | NodeTypeAfterAll | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeCleanupAfterEach | NodeTypeCleanupAfterAll | NodeTypeCleanupAfterSuite
+var NodeTypesForSuiteLevelNodes = NodeTypeBeforeSuite | NodeTypeSynchronizedBeforeSuite | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeReportBeforeSuite | NodeTypeReportAfterSuite | NodeTypeCleanupAfterSuite | NodeTypeAfterAllProcesses
+var NodeTypesAllowedDuringCleanupInterrupt = NodeTypeAfterEach | NodeTypeJustAfterEach | NodeTypeAfterAll | NodeTypeAfterSuite | NodeTypeSynchronizedAfterSuite | NodeTypeCleanupAfterEach | NodeTypeCleanupAfterAll | NodeTypeCleanupAfterSuite | NodeTypeAfterAllProcesses
 var NodeTypesAllowedDuringReportInterrupt = NodeTypeReportBeforeEach | NodeTypeReportAfterEach | NodeTypeReportBeforeSuite | NodeTypeReportAfterSuite
 
 var ntEnumSupport = NewEnumSupport(map[uint]string{
@@ -799,6 +1257,7 @@ var ntEnumSupport = NewEnumSupport(map[uint]string{
 	uint(NodeTypeCleanupAfterEach):        "DeferCleanup (Each)",
 	uint(NodeTypeCleanupAfterAll):         "DeferCleanup (All)",
 	uint(NodeTypeCleanupAfterSuite):       "DeferCleanup (Suite)",
+	uint(NodeTypeAfterAllProcesses):       "AfterAllProcesses",
 })
 
 func (nt NodeType) String() string {
@@ -885,16 +1344,18 @@ const (
 	SpecEventNodeEnd
 	SpecEventSpecRepeat
 	SpecEventSpecRetry
+	SpecEventSpecIteration
 )
 
 var seEnumSupport = NewEnumSupport(map[uint]string{
-	uint(SpecEventInvalid):    "INVALID SPEC EVENT",
-	uint(SpecEventByStart):    "By",
-	uint(SpecEventByEnd):      "By (End)",
-	uint(SpecEventNodeStart):  "Node",
-	uint(SpecEventNodeEnd):    "Node (End)",
-	uint(SpecEventSpecRepeat): "Repeat",
-	uint(SpecEventSpecRetry):  "Retry",
+	uint(SpecEventInvalid):       "INVALID SPEC EVENT",
+	uint(SpecEventByStart):       "By",
+	uint(SpecEventByEnd):         "By (End)",
+	uint(SpecEventNodeStart):     "Node",
+	uint(SpecEventNodeEnd):       "Node (End)",
+	uint(SpecEventSpecRepeat):    "Repeat",
+	uint(SpecEventSpecRetry):     "Retry",
+	uint(SpecEventSpecIteration): "Iteration",
 })
 
 func (se SpecEventType) String() string {
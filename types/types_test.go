@@ -14,7 +14,7 @@ import (
 var _ = Describe("Types", func() {
 	Describe("Report", func() {
 		Describe("Add", func() {
-			It("concatenates spec reports, combines success, and computes a new RunTime", func() {
+			It("concatenates spec reports and flaky reports, combines success, and computes a new RunTime", func() {
 				t := time.Now()
 				reportA := types.Report{
 					SuitePath:                  "foo",
@@ -26,6 +26,7 @@ var _ = Describe("Types", func() {
 						types.SpecReport{NumAttempts: 3},
 						types.SpecReport{NumAttempts: 4},
 					},
+					FlakyReports: []types.FlakyReport{{LeafNodeText: "A", NumAttempts: 3}},
 				}
 
 				reportB := types.Report{
@@ -38,6 +39,7 @@ var _ = Describe("Types", func() {
 						types.SpecReport{NumAttempts: 5},
 						types.SpecReport{NumAttempts: 6},
 					},
+					FlakyReports: []types.FlakyReport{{LeafNodeText: "B", NumAttempts: 5}},
 				}
 
 				composite := reportA.Add(reportB)
@@ -54,12 +56,76 @@ var _ = Describe("Types", func() {
 						types.SpecReport{NumAttempts: 5},
 						types.SpecReport{NumAttempts: 6},
 					},
+					FlakyReports: []types.FlakyReport{{LeafNodeText: "A", NumAttempts: 3}, {LeafNodeText: "B", NumAttempts: 5}},
 				}))
 
 			})
 		})
 	})
 
+	Describe("ParallelEfficiency", func() {
+		var reportA, reportB types.Report
+		t := time.Now()
+
+		BeforeEach(func() {
+			reportA = types.Report{
+				StartTime:   t,
+				EndTime:     t.Add(10 * time.Second),
+				RunTime:     10 * time.Second,
+				SuiteConfig: types.SuiteConfig{ParallelTotal: 3},
+				SpecReports: types.SpecReports{
+					{ParallelProcess: 1, RunTime: time.Second},
+					{ParallelProcess: 1, RunTime: 8 * time.Second},
+				},
+			}
+			reportB = types.Report{
+				StartTime:   t,
+				EndTime:     t.Add(12 * time.Second),
+				RunTime:     12 * time.Second,
+				SuiteConfig: types.SuiteConfig{ParallelTotal: 3},
+				SpecReports: types.SpecReports{
+					{ParallelProcess: 2, RunTime: 6 * time.Second},
+					{ParallelProcess: 2, RunTime: 3 * time.Second},
+				},
+			}
+		})
+
+		It("computes the total spec time, wall time, and per-process busy time from the report", func() {
+			efficiency := types.NewParallelEfficiency(reportA)
+			Ω(efficiency).Should(Equal(types.ParallelEfficiency{
+				TotalSpecTime:   9 * time.Second,
+				WallTime:        10 * time.Second,
+				NumProcesses:    3,
+				ProcessBusyTime: map[int]time.Duration{1: 9 * time.Second},
+			}))
+		})
+
+		It("combines the efficiencies of multiple processes when reports are added together", func() {
+			efficiencyA, efficiencyB := types.NewParallelEfficiency(reportA), types.NewParallelEfficiency(reportB)
+			reportA.ParallelEfficiency, reportB.ParallelEfficiency = &efficiencyA, &efficiencyB
+
+			efficiency := *reportA.Add(reportB).ParallelEfficiency
+			Ω(efficiency).Should(Equal(types.ParallelEfficiency{
+				TotalSpecTime:   18 * time.Second,
+				WallTime:        12 * time.Second,
+				NumProcesses:    3,
+				ProcessBusyTime: map[int]time.Duration{1: 9 * time.Second, 2: 9 * time.Second},
+			}))
+			Ω(efficiency.Efficiency()).Should(BeNumerically("~", 0.5))
+			Ω(efficiency.IdleTime(1)).Should(Equal(3 * time.Second))
+			Ω(efficiency.IdleTime(2)).Should(Equal(3 * time.Second))
+			Ω(efficiency.IdleTime(3)).Should(Equal(12 * time.Second))
+		})
+
+		It("leaves the efficiency unset when neither report has one", func() {
+			Ω(reportA.Add(reportB).ParallelEfficiency).Should(BeNil())
+		})
+
+		It("returns zero efficiency when there is no wall time", func() {
+			Ω(types.ParallelEfficiency{TotalSpecTime: time.Second, NumProcesses: 2}.Efficiency()).Should(BeZero())
+		})
+	})
+
 	Describe("ProgressReport", func() {
 		It("can return the correct subset of Goroutines when asked", func() {
 			specGoroutine := types.Goroutine{ID: 7, IsSpecGoroutine: true, Stack: []types.FunctionCall{{Highlight: true}}}
@@ -139,6 +205,7 @@ var _ = Describe("Types", func() {
 			Entry(nil, types.NodeTypeCleanupAfterEach, "DeferCleanup (Each)"),
 			Entry(nil, types.NodeTypeCleanupAfterAll, "DeferCleanup (All)"),
 			Entry(nil, types.NodeTypeCleanupAfterSuite, "DeferCleanup (Suite)"),
+			Entry(nil, types.NodeTypeAfterAllProcesses, "AfterAllProcesses"),
 			Entry(nil, types.NodeTypeInvalid, "INVALID NODE TYPE"),
 		)
 	})
@@ -213,6 +280,80 @@ var _ = Describe("Types", func() {
 			})
 		})
 
+		Describe("DeviatesFromExpectedDuration and DurationDeviation", func() {
+			It("does not flag specs without an expected duration", func() {
+				report := types.SpecReport{RunTime: time.Hour}
+				Ω(report.DeviatesFromExpectedDuration()).Should(BeFalse())
+				Ω(report.DurationDeviation()).Should(BeEmpty())
+			})
+
+			It("does not flag specs that are within tolerance", func() {
+				for _, runTime := range []time.Duration{600 * time.Millisecond, time.Second, 1900 * time.Millisecond} {
+					report := types.SpecReport{ExpectedDuration: time.Second, RunTime: runTime}
+					Ω(report.DeviatesFromExpectedDuration()).Should(BeFalse())
+					Ω(report.DurationDeviation()).Should(BeEmpty())
+				}
+			})
+
+			It("flags specs that are slower than expected", func() {
+				report := types.SpecReport{ExpectedDuration: 500 * time.Millisecond, RunTime: 1250 * time.Millisecond}
+				Ω(report.DeviatesFromExpectedDuration()).Should(BeTrue())
+				Ω(report.DurationDeviation()).Should(Equal("2.5x slower"))
+
+				report.RunTime = time.Second
+				Ω(report.DurationDeviation()).Should(Equal("2x slower"))
+			})
+
+			It("flags specs that are faster than expected", func() {
+				report := types.SpecReport{ExpectedDuration: time.Second, RunTime: 250 * time.Millisecond}
+				Ω(report.DeviatesFromExpectedDuration()).Should(BeTrue())
+				Ω(report.DurationDeviation()).Should(Equal("4x faster"))
+			})
+
+			It("does not flag specs that did not run", func() {
+				report := types.SpecReport{ExpectedDuration: time.Second, State: types.SpecStateSkipped}
+				Ω(report.DeviatesFromExpectedDuration()).Should(BeFalse())
+			})
+		})
+
+		Describe("InterleavedOutput", func() {
+			var report types.SpecReport
+			var t time.Time
+			BeforeEach(func() {
+				t = time.Now()
+				report = types.SpecReport{
+					CapturedStdOutErr:          "std 1\nstd 2\n",
+					CapturedGinkgoWriterOutput: "gw 1\ngw 2\n",
+				}
+			})
+
+			Context("without any CapturedOutputSegments", func() {
+				It("falls back to CombinedOutput", func() {
+					Ω(report.InterleavedOutput()).Should(Equal(report.CombinedOutput()))
+				})
+			})
+
+			Context("with CapturedOutputSegments", func() {
+				It("merges the output chronologically", func() {
+					report.CapturedOutputSegments = []types.CapturedOutputSegment{
+						{Source: types.CapturedOutputSourceStdOutErr, Start: 0, End: 6, Time: t},
+						{Source: types.CapturedOutputSourceStdOutErr, Start: 6, End: 12, Time: t.Add(2 * time.Millisecond)},
+						{Source: types.CapturedOutputSourceGinkgoWriter, Start: 0, End: 5, Time: t.Add(time.Millisecond)},
+						{Source: types.CapturedOutputSourceGinkgoWriter, Start: 5, End: 10, Time: t.Add(3 * time.Millisecond)},
+					}
+					Ω(report.InterleavedOutput()).Should(Equal("std 1\ngw 1\nstd 2\ngw 2\n"))
+				})
+
+				It("appends any output not covered by a segment", func() {
+					report.CapturedOutputSegments = []types.CapturedOutputSegment{
+						{Source: types.CapturedOutputSourceGinkgoWriter, Start: 0, End: 5, Time: t},
+						{Source: types.CapturedOutputSourceStdOutErr, Start: 0, End: 6, Time: t.Add(time.Millisecond)},
+					}
+					Ω(report.InterleavedOutput()).Should(Equal("gw 1\nstd 1\nstd 2\ngw 2\n"))
+				})
+			})
+		})
+
 		Describe("Labels", Label("TestA", "TestB"), func() {
 			It("returns a concatenated, deduped, set of labels", Label("TestB", "TestC"), func() {
 				Ω(CurrentSpecReport().Labels()).Should(Equal([]string{"TestA", "TestB", "TestC"}))
@@ -399,6 +540,20 @@ var _ = Describe("Types", func() {
 			})
 		})
 
+		Describe("SpecCosts", func() {
+			It("returns the runtime of each It that ran, keyed by its full text", func() {
+				reports := types.SpecReports{
+					{LeafNodeType: types.NodeTypeIt, ContainerHierarchyTexts: []string{"A"}, LeafNodeText: "B", State: types.SpecStatePassed, RunTime: time.Second},
+					{LeafNodeType: types.NodeTypeIt, LeafNodeText: "C", State: types.SpecStateFailed, RunTime: time.Minute},
+					{LeafNodeType: types.NodeTypeIt, LeafNodeText: "D", State: types.SpecStateSkipped},
+					{LeafNodeType: types.NodeTypeIt, LeafNodeText: "E", State: types.SpecStatePending},
+					{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed, RunTime: time.Hour},
+				}
+
+				Ω(reports.SpecCosts()).Should(Equal(map[string]time.Duration{"A B": time.Second, "C": time.Minute}))
+			})
+		})
+
 		Describe("CountOfRepeatedSpecs", func() {
 			It("returns the number of failed specs with NumAttempts > 1", func() {
 				reports := types.SpecReports{